@@ -1,6 +1,7 @@
 package wire
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
@@ -88,3 +89,24 @@ func TestActualAmountPaidTagError(t *testing.T) {
 
 	require.EqualError(t, err, fieldError("tag", ErrValidTagForType, aap.tag).Error())
 }
+
+// TestRemittanceAmountMoney converts a RemittanceAmount to and from Money
+func TestRemittanceAmountMoney(t *testing.T) {
+	aap := mockActualAmountPaid()
+	m, err := aap.RemittanceAmount.Money()
+	require.NoError(t, err)
+	require.Equal(t, NewMoney("USD", 123456), m)
+
+	require.NoError(t, aap.RemittanceAmount.SetMoney(Money{Currency: "USD", Amount: Decimal{Units: 123456789, Scale: 5}}))
+	require.Equal(t, "1234.56789", aap.RemittanceAmount.Amount)
+	require.NoError(t, aap.RemittanceAmount.SetMoney(NewMoney("JPY", 1500)))
+	require.Equal(t, "1500", aap.RemittanceAmount.Amount)
+	require.NoError(t, aap.RemittanceAmount.SetMoney(Money{Currency: "USD", Amount: Decimal{Units: 5, Scale: 0}}))
+	require.Equal(t, "5.00", aap.RemittanceAmount.Amount)
+	require.NoError(t, aap.Validate())
+
+	err = aap.RemittanceAmount.SetMoney(Money{Currency: "USD", Amount: Decimal{Units: 1234567, Scale: 6}})
+	require.True(t, errors.Is(err, ErrMoneyPrecision))
+	require.True(t, errors.Is(aap.RemittanceAmount.SetMoney(NewMoney("XZP", 1)), ErrNonCurrencyCode))
+	require.True(t, errors.Is(aap.RemittanceAmount.SetMoney(NewMoney("USD", -1)), ErrMoneyRange))
+}
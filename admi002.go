@@ -0,0 +1,105 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/xml"
+)
+
+// Admi002Namespace is the XML namespace of the supported admi.002 MessageReject version
+const Admi002Namespace = "urn:iso:std:iso:20022:tech:xsd:admi.002.001.01"
+
+// Admi002Document is an ISO 20022 admi.002 MessageReject document
+type Admi002Document struct {
+	XMLName       xml.Name             `xml:"urn:iso:std:iso:20022:tech:xsd:admi.002.001.01 Document"`
+	MessageReject Admi002MessageReject `xml:"admi.002.001.01"`
+}
+
+// Admi002MessageReject is the message of an admi.002 document
+type Admi002MessageReject struct {
+	RelatedReference Admi002MessageReference `xml:"RltdRef"`
+	Reason           Admi002RejectionReason  `xml:"Rsn"`
+}
+
+// Admi002MessageReference is the RltdRef of an admi.002 document, which identifies the rejected message
+type Admi002MessageReference struct {
+	Reference string `xml:"Ref"`
+}
+
+// Admi002RejectionReason is the Rsn of an admi.002 document
+type Admi002RejectionReason struct {
+	RejectingPartyReason string `xml:"RjctgPtyRsn"`
+	RejectionDateTime    string `xml:"RjctnDtTm,omitempty"`
+	ErrorLocation        string `xml:"ErrLctn,omitempty"`
+	ReasonDescription    string `xml:"RsnDesc,omitempty"`
+	AdditionalData       string `xml:"AddtlData,omitempty"`
+}
+
+// Admi002FromXML reads an admi.002 document
+func Admi002FromXML(bs []byte) (*Admi002Document, error) {
+	doc := &Admi002Document{}
+	if err := isoFromXML(bs, doc, "admi.002"); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// XML returns the admi.002 document as XML
+func (doc *Admi002Document) XML() ([]byte, error) {
+	return isoXML(doc)
+}
+
+// ToAdmi002 converts a message rejected by {1130} ErrorWire into an admi.002 MessageReject.
+//
+// {1520} IMAD is the related reference. The error category and code are the rejecting party reason and the error
+// description the reason description. {1120} OutputMessageAccountabilityData is the additional data, its output date
+// and time the rejection date time.
+func (fwm *FEDWireMessage) ToAdmi002() (*Admi002Document, error) {
+	if fwm.ErrorWire == nil {
+		return nil, fieldError("ErrorWire", ErrFieldRequired)
+	}
+	reason := isoErrorWireReason(fwm.ErrorWire)
+	doc := &Admi002Document{
+		MessageReject: Admi002MessageReject{
+			RelatedReference: Admi002MessageReference{Reference: fwm.isoMessageID()},
+			Reason: Admi002RejectionReason{
+				RejectingPartyReason: reason.Reason.Proprietary,
+				RejectionDateTime:    fwm.isoOutputDateTime(),
+				AdditionalData:       fwm.isoOutputMessageID(),
+			},
+		},
+	}
+	if len(reason.AdditionalInfo) > 0 {
+		doc.MessageReject.Reason.ReasonDescription = reason.AdditionalInfo[0]
+	}
+	return doc, nil
+}
+
+// FEDWireMessageFromAdmi002 converts an admi.002 MessageReject into the Fed-appended tags of the rejected message.
+//
+// The returned message holds {1520} IMAD of the rejected message along with {1100} MessageDisposition, {1120}
+// OutputMessageAccountabilityData and {1130} ErrorWire; callers copy these tags onto the message they sent.
+func FEDWireMessageFromAdmi002(doc *Admi002Document) (*FEDWireMessage, error) {
+	if doc == nil {
+		return nil, fieldError("Document", ErrISODocument)
+	}
+	rjct := doc.MessageReject
+	if rjct.Reason.RejectingPartyReason == "" {
+		return nil, fieldError("RjctgPtyRsn", ErrISODocument)
+	}
+
+	fwm := &FEDWireMessage{}
+	fwm.setIMADFromISO(rjct.RelatedReference.Reference, "")
+	fwm.MessageDisposition = NewMessageDisposition()
+	fwm.MessageDisposition.MessageStatusIndicator = "3"
+	fwm.OutputMessageAccountabilityData = outputMessageAccountabilityDataFromISO(rjct.Reason.AdditionalData, rjct.Reason.RejectionDateTime)
+
+	reason := ISOReasonInfo{Reason: &ISOCode{Proprietary: rjct.Reason.RejectingPartyReason}}
+	if rjct.Reason.ReasonDescription != "" {
+		reason.AdditionalInfo = []string{rjct.Reason.ReasonDescription}
+	}
+	fwm.ErrorWire = errorWireFromISOReason(reason)
+	return fwm, nil
+}
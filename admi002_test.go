@@ -0,0 +1,54 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestAdmi002_Reject converts a message rejected by the Fed into admi.002 and back
+func TestAdmi002_Reject(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-Reject.txt")
+
+	doc, err := fwm.ToAdmi002()
+	require.NoError(t, err)
+	rsn := doc.MessageReject.Reason
+	require.Equal(t, "20210902MMQFMC2U000001", doc.MessageReject.RelatedReference.Reference)
+	require.Equal(t, "1XYZ", rsn.RejectingPartyReason)
+	require.Equal(t, fwm.ErrorWire.ErrorDescription, rsn.ReasonDescription)
+
+	bs, err := doc.XML()
+	require.NoError(t, err)
+	read, err := Admi002FromXML(bs)
+	require.NoError(t, err)
+
+	out, err := FEDWireMessageFromAdmi002(read)
+	require.NoError(t, err)
+	require.Equal(t, fwm.InputMessageAccountabilityData.String(), out.InputMessageAccountabilityData.String())
+	require.Equal(t, fwm.ErrorWire.String(), out.ErrorWire.String())
+	require.Equal(t, fwm.OutputMessageAccountabilityData.String(), out.OutputMessageAccountabilityData.String())
+	require.Equal(t, "3", out.MessageDisposition.MessageStatusIndicator)
+}
+
+// TestAdmi002_DataError ensures data errors are reported with admi.002
+func TestAdmi002_DataError(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-Reject.txt")
+	fwm.ErrorWire.ErrorCategory = "E"
+
+	report, err := fwm.ToISOStatusReport()
+	require.NoError(t, err)
+	_, ok := report.(*Admi002Document)
+	require.True(t, ok)
+
+	fwm.ErrorWire = nil
+	_, err = fwm.ToAdmi002()
+	require.True(t, errors.Is(err, ErrFieldRequired))
+
+	_, err = FEDWireMessageFromAdmi002(&Admi002Document{})
+	require.True(t, errors.Is(err, ErrISODocument))
+}
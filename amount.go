@@ -6,6 +6,7 @@ package wire
 
 import (
 	"encoding/json"
+	"fmt"
 	"strings"
 )
 
@@ -91,3 +92,48 @@ func (a *Amount) fieldInclusion() error {
 func (a *Amount) AmountField() string {
 	return a.numericStringField(a.Amount, 12)
 }
+
+// Cents returns Amount in cents, e.g. 000001234567 is 1234567
+func (a *Amount) Cents() (int64, error) {
+	if a.Amount == "" {
+		return 0, fieldError("Amount", ErrFieldRequired)
+	}
+	if err := a.isAmountImplied(a.Amount); err != nil {
+		return 0, fieldError("Amount", err, a.Amount)
+	}
+	d, err := ParseDecimal(a.Amount)
+	if err != nil {
+		return 0, fieldError("Amount", err, a.Amount)
+	}
+	return d.Units, nil
+}
+
+// SetCents sets Amount to cents as 12 numeric characters with leading zeros
+func (a *Amount) SetCents(cents int64) error {
+	if cents < 0 || cents > 999999999999 {
+		return fieldError("Amount", ErrMoneyRange, cents)
+	}
+	a.Amount = fmt.Sprintf("%012d", cents)
+	return nil
+}
+
+// Money returns Amount as US dollars
+func (a *Amount) Money() (Money, error) {
+	cents, err := a.Cents()
+	if err != nil {
+		return Money{}, err
+	}
+	return NewMoney("USD", cents), nil
+}
+
+// SetMoney sets Amount to m, which must be whole cents of US dollars
+func (a *Amount) SetMoney(m Money) error {
+	if m.Currency != "USD" {
+		return fieldError("Currency", ErrMoneyCurrency, m.Currency)
+	}
+	cents, err := m.MinorUnits()
+	if err != nil {
+		return err
+	}
+	return a.SetCents(cents)
+}
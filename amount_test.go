@@ -1,6 +1,7 @@
 package wire
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
@@ -67,3 +68,61 @@ func TestAmountTagError(t *testing.T) {
 
 	require.EqualError(t, err, fieldError("tag", ErrValidTagForType, a.tag).Error())
 }
+
+// TestAmountCents converts Amount to and from cents
+func TestAmountCents(t *testing.T) {
+	a := mockAmount()
+	cents, err := a.Cents()
+	require.NoError(t, err)
+	require.Equal(t, int64(1234567), cents)
+
+	m, err := a.Money()
+	require.NoError(t, err)
+	require.Equal(t, "USD 12345.67", m.String())
+
+	require.NoError(t, a.SetCents(99))
+	require.Equal(t, "000000000099", a.Amount)
+	require.NoError(t, a.SetCents(999999999999))
+	require.Equal(t, "999999999999", a.Amount)
+	require.True(t, errors.Is(a.SetCents(1000000000000), ErrMoneyRange))
+	require.True(t, errors.Is(a.SetCents(-1), ErrMoneyRange))
+
+	a.Amount = "X,"
+	_, err = a.Cents()
+	require.True(t, errors.Is(err, ErrNonAmount))
+}
+
+// TestAmountSetMoney sets Amount from whole cents of US dollars
+func TestAmountSetMoney(t *testing.T) {
+	a := NewAmount()
+	require.NoError(t, a.SetMoney(Money{Currency: "USD", Amount: Decimal{Units: 1234567, Scale: 2}}))
+	require.Equal(t, "000001234567", a.Amount)
+	require.NoError(t, a.SetMoney(Money{Currency: "USD", Amount: Decimal{Units: 5, Scale: 0}}))
+	require.Equal(t, "000000000500", a.Amount)
+
+	require.True(t, errors.Is(a.SetMoney(Money{Currency: "USD", Amount: Decimal{Units: 1001, Scale: 3}}), ErrMoneyPrecision))
+	require.True(t, errors.Is(a.SetMoney(NewMoney("EUR", 100)), ErrMoneyCurrency))
+	require.Equal(t, "000000000500", a.Amount)
+}
+
+// TestAmountCloneEqual copies Amount with its tag and compares it after normalizing padding
+func TestAmountCloneEqual(t *testing.T) {
+	a := mockAmount()
+	var clone *Amount
+	CloneTag(&clone, a)
+	require.Equal(t, a, clone)
+	require.Equal(t, TagAmount, clone.tag)
+	require.True(t, TagEqual(a, clone))
+
+	clone.Amount = "1234567"
+	require.Equal(t, "000001234567", a.Amount)
+	require.True(t, TagEqual(a, clone))
+	clone.Amount = "1234568"
+	require.False(t, TagEqual(a, clone))
+
+	var nilAmount *Amount
+	CloneTag(&clone, nilAmount)
+	require.Nil(t, clone)
+	require.True(t, TagEqual(nilAmount, clone))
+	require.False(t, TagEqual(nilAmount, a))
+}
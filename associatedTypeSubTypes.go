@@ -96,3 +96,18 @@ var svcTypeSubTypes = associatedTypeSubTypes{
 	SettlementTransfer + RefusalRequestCredit,
 	SettlementTransfer + SSIServiceMessage,
 }
+
+// businessFunctionTypeSubTypes contains the types/subtypes associated with each BusinessFunctionCode
+var businessFunctionTypeSubTypes = map[string]associatedTypeSubTypes{
+	BankTransfer:                     btrTypeSubTypes,
+	CustomerTransfer:                 ctrTypeSubTypes,
+	CustomerTransferPlus:             ctpTypeSubTypes,
+	CheckSameDaySettlement:           cksTypeSubTypes,
+	DepositSendersAccount:            depTypeSubTypes,
+	FEDFundsReturned:                 ffrTypeSubTypes,
+	FEDFundsSold:                     ffsTypeSubTypes,
+	DrawdownResponse:                 drwTypeSubTypes,
+	BankDrawDownRequest:              drbTypeSubTypes,
+	CustomerCorporateDrawdownRequest: drcTypeSubTypes,
+	BFCServiceMessage:                svcTypeSubTypes,
+}
@@ -0,0 +1,179 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// BankTransferBuilder builds a BTR bank transfer, exposing only the tags a bank transfer permits
+type BankTransferBuilder struct {
+	messageBuilder
+}
+
+// NewBankTransferBuilder returns a builder of a BTR bank transfer with a default {1500} SenderSupplied and
+// {1510} TypeSubType 1000, a basic funds transfer
+func NewBankTransferBuilder() *BankTransferBuilder {
+	b := &BankTransferBuilder{newMessageBuilder(BankTransfer, FundsTransfer, BasicFundsTransfer)}
+	return b
+}
+
+// SenderSupplied sets {1500} SenderSupplied
+func (b *BankTransferBuilder) SenderSupplied(tag *SenderSupplied) *BankTransferBuilder {
+	CloneTag(&b.fwm.SenderSupplied, tag)
+	return b
+}
+
+// UserRequestCorrelation sets the UserRequestCorrelation of {1500} SenderSupplied
+func (b *BankTransferBuilder) UserRequestCorrelation(id string) *BankTransferBuilder {
+	b.setUserRequestCorrelation(id)
+	return b
+}
+
+// TypeSubType sets {1510} TypeSubType to typeCode and subTypeCode
+func (b *BankTransferBuilder) TypeSubType(typeCode, subTypeCode string) *BankTransferBuilder {
+	b.setTypeSubType(typeCode, subTypeCode)
+	return b
+}
+
+// IMAD sets {1520} InputMessageAccountabilityData
+func (b *BankTransferBuilder) IMAD(tag *InputMessageAccountabilityData) *BankTransferBuilder {
+	CloneTag(&b.fwm.InputMessageAccountabilityData, tag)
+	return b
+}
+
+// Amount sets {2000} Amount in cents
+func (b *BankTransferBuilder) Amount(cents int64) *BankTransferBuilder {
+	b.setAmount(cents)
+	return b
+}
+
+// Sender sets {3100} SenderDepositoryInstitution to the ABA routing number and short name of the sender
+func (b *BankTransferBuilder) Sender(aba, shortName string) *BankTransferBuilder {
+	b.setSender(aba, shortName)
+	return b
+}
+
+// Receiver sets {3400} ReceiverDepositoryInstitution to the ABA routing number and short name of the receiver
+func (b *BankTransferBuilder) Receiver(aba, shortName string) *BankTransferBuilder {
+	b.setReceiver(aba, shortName)
+	return b
+}
+
+// SenderReference sets {3320} SenderReference
+func (b *BankTransferBuilder) SenderReference(ref string) *BankTransferBuilder {
+	b.setSenderReference(ref)
+	return b
+}
+
+// PreviousMessageIdentifier sets {3500} PreviousMessageIdentifier to the IMAD of the previous message
+func (b *BankTransferBuilder) PreviousMessageIdentifier(imad string) *BankTransferBuilder {
+	b.setPreviousMessageIdentifier(imad)
+	return b
+}
+
+// BeneficiaryIntermediaryFI sets {4000} BeneficiaryIntermediaryFI
+func (b *BankTransferBuilder) BeneficiaryIntermediaryFI(fi FinancialInstitution) *BankTransferBuilder {
+	b.setBeneficiaryIntermediaryFI(fi)
+	return b
+}
+
+// BeneficiaryFI sets {4100} BeneficiaryFI
+func (b *BankTransferBuilder) BeneficiaryFI(fi FinancialInstitution) *BankTransferBuilder {
+	b.setBeneficiaryFI(fi)
+	return b
+}
+
+// Beneficiary sets {4200} Beneficiary
+func (b *BankTransferBuilder) Beneficiary(p Personal) *BankTransferBuilder {
+	b.setBeneficiary(p)
+	return b
+}
+
+// BeneficiaryReference sets {4320} BeneficiaryReference
+func (b *BankTransferBuilder) BeneficiaryReference(ref string) *BankTransferBuilder {
+	b.setBeneficiaryReference(ref)
+	return b
+}
+
+// Originator sets {5000} Originator
+func (b *BankTransferBuilder) Originator(p Personal) *BankTransferBuilder {
+	b.setOriginator(p)
+	return b
+}
+
+// OriginatorFI sets {5100} OriginatorFI
+func (b *BankTransferBuilder) OriginatorFI(fi FinancialInstitution) *BankTransferBuilder {
+	b.setOriginatorFI(fi)
+	return b
+}
+
+// InstructingFI sets {5200} InstructingFI
+func (b *BankTransferBuilder) InstructingFI(fi FinancialInstitution) *BankTransferBuilder {
+	b.setInstructingFI(fi)
+	return b
+}
+
+// OriginatorToBeneficiary sets {6000} OriginatorToBeneficiary
+func (b *BankTransferBuilder) OriginatorToBeneficiary(tag *OriginatorToBeneficiary) *BankTransferBuilder {
+	CloneTag(&b.fwm.OriginatorToBeneficiary, tag)
+	return b
+}
+
+// FIReceiverFI sets {6100} FIReceiverFI
+func (b *BankTransferBuilder) FIReceiverFI(tag *FIReceiverFI) *BankTransferBuilder {
+	CloneTag(&b.fwm.FIReceiverFI, tag)
+	return b
+}
+
+// FIIntermediaryFI sets {6200} FIIntermediaryFI
+func (b *BankTransferBuilder) FIIntermediaryFI(tag *FIIntermediaryFI) *BankTransferBuilder {
+	CloneTag(&b.fwm.FIIntermediaryFI, tag)
+	return b
+}
+
+// FIIntermediaryFIAdvice sets {6210} FIIntermediaryFIAdvice
+func (b *BankTransferBuilder) FIIntermediaryFIAdvice(tag *FIIntermediaryFIAdvice) *BankTransferBuilder {
+	CloneTag(&b.fwm.FIIntermediaryFIAdvice, tag)
+	return b
+}
+
+// FIBeneficiaryFI sets {6300} FIBeneficiaryFI
+func (b *BankTransferBuilder) FIBeneficiaryFI(tag *FIBeneficiaryFI) *BankTransferBuilder {
+	CloneTag(&b.fwm.FIBeneficiaryFI, tag)
+	return b
+}
+
+// FIBeneficiaryFIAdvice sets {6310} FIBeneficiaryFIAdvice
+func (b *BankTransferBuilder) FIBeneficiaryFIAdvice(tag *FIBeneficiaryFIAdvice) *BankTransferBuilder {
+	CloneTag(&b.fwm.FIBeneficiaryFIAdvice, tag)
+	return b
+}
+
+// FIBeneficiary sets {6400} FIBeneficiary
+func (b *BankTransferBuilder) FIBeneficiary(tag *FIBeneficiary) *BankTransferBuilder {
+	CloneTag(&b.fwm.FIBeneficiary, tag)
+	return b
+}
+
+// FIBeneficiaryAdvice sets {6410} FIBeneficiaryAdvice
+func (b *BankTransferBuilder) FIBeneficiaryAdvice(tag *FIBeneficiaryAdvice) *BankTransferBuilder {
+	CloneTag(&b.fwm.FIBeneficiaryAdvice, tag)
+	return b
+}
+
+// FIPaymentMethodToBeneficiary sets {6420} FIPaymentMethodToBeneficiary
+func (b *BankTransferBuilder) FIPaymentMethodToBeneficiary(tag *FIPaymentMethodToBeneficiary) *BankTransferBuilder {
+	CloneTag(&b.fwm.FIPaymentMethodToBeneficiary, tag)
+	return b
+}
+
+// FIAdditionalFIToFI sets {6500} FIAdditionalFIToFI
+func (b *BankTransferBuilder) FIAdditionalFIToFI(tag *FIAdditionalFIToFI) *BankTransferBuilder {
+	CloneTag(&b.fwm.FIAdditionalFIToFI, tag)
+	return b
+}
+
+// Build returns the message when it passes Validate, otherwise the errors of the setters and of Validate in a
+// base.ErrorList
+func (b *BankTransferBuilder) Build() (*FEDWireMessage, error) {
+	return b.build()
+}
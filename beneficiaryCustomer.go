@@ -114,6 +114,9 @@ func (bc *BeneficiaryCustomer) Validate() error {
 	if err := bc.isAlphanumeric(bc.CoverPayment.SwiftLineFive); err != nil {
 		return fieldError("SwiftLineFive", err, bc.CoverPayment.SwiftLineFive)
 	}
+	if err := bc.validateCoverPayment(TagBeneficiaryCustomer, bc.CoverPayment); err != nil {
+		return err
+	}
 	return nil
 }
 
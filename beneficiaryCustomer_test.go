@@ -10,8 +10,8 @@ import (
 // mockBeneficiaryCustomer creates a BeneficiaryCustomer
 func mockBeneficiaryCustomer() *BeneficiaryCustomer {
 	bc := NewBeneficiaryCustomer()
-	bc.CoverPayment.SwiftFieldTag = "Swift Field Tag"
-	bc.CoverPayment.SwiftLineOne = "Swift Line One"
+	bc.CoverPayment.SwiftFieldTag = "59"
+	bc.CoverPayment.SwiftLineOne = "/123456789"
 	bc.CoverPayment.SwiftLineTwo = "Swift Line Two"
 	bc.CoverPayment.SwiftLineThree = "Swift Line Three"
 	bc.CoverPayment.SwiftLineFour = "Swift Line Four"
@@ -122,3 +122,23 @@ func TestBeneficiaryCustomerTagError(t *testing.T) {
 
 	require.EqualError(t, err, fieldError("tag", ErrValidTagForType, bc.tag).Error())
 }
+
+// TestBeneficiaryCustomerSwiftFieldOption validates BeneficiaryCustomer SwiftFieldTag is a SWIFT field option permitted for the tag
+func TestBeneficiaryCustomerSwiftFieldOption(t *testing.T) {
+	bc := mockBeneficiaryCustomer()
+	bc.CoverPayment.SwiftFieldTag = "59K"
+
+	err := bc.Validate()
+
+	require.EqualError(t, err, fieldError("SwiftFieldTag", ErrSwiftFieldOption, bc.CoverPayment.SwiftFieldTag).Error())
+}
+
+// TestBeneficiaryCustomerSwiftFieldLine validates BeneficiaryCustomer SWIFT lines follow the format of the SWIFT field option
+func TestBeneficiaryCustomerSwiftFieldLine(t *testing.T) {
+	bc := mockBeneficiaryCustomer()
+	bc.CoverPayment.SwiftFieldTag = "59F"
+
+	err := bc.Validate()
+
+	require.EqualError(t, err, fieldError("SwiftLineTwo", ErrSwiftFieldLine, bc.CoverPayment.SwiftLineTwo).Error())
+}
@@ -139,3 +139,20 @@ func TestBeneficiaryTagError(t *testing.T) {
 
 	require.EqualError(t, err, fieldError("tag", ErrValidTagForType, ben.tag).Error())
 }
+
+// TestBeneficiaryCloneEqual copies Beneficiary and compares its nested fields after normalizing padding, keeping
+// the leading zeros of its identifier
+func TestBeneficiaryCloneEqual(t *testing.T) {
+	ben := mockBeneficiary()
+	var clone *Beneficiary
+	CloneTag(&clone, ben)
+	require.True(t, TagEqual(ben, clone))
+	clone.Personal.Name = " " + ben.Personal.Name + " "
+	require.True(t, TagEqual(ben, clone))
+	clone.Personal.Identifier = "00" + ben.Personal.Identifier
+	require.False(t, TagEqual(ben, clone))
+	clone.Personal.Identifier = ben.Personal.Identifier
+	clone.Personal.Address.AddressLineOne = "Other"
+	require.False(t, TagEqual(ben, clone))
+	require.NotEqual(t, "Other", ben.Personal.Address.AddressLineOne)
+}
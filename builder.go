@@ -0,0 +1,142 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+
+	"github.com/moov-io/base"
+)
+
+// messageBuilder holds the FEDWireMessage of a business function specific builder and the errors of its setters.
+//
+// Builders set tags of a single value, such as {3320} SenderReference, from that value and clear them when it is
+// blank. Parties and financial institutions are set from their Personal or FinancialInstitution, and other tags from a
+// tag made with its constructor, which is copied. A nil tag clears it.
+type messageBuilder struct {
+	fwm  *FEDWireMessage
+	errs base.ErrorList
+}
+
+// newMessageBuilder returns a builder of a message with bfc as its {3600} BusinessFunctionCode, a default {1500}
+// SenderSupplied and typeCode and subTypeCode as its {1510} TypeSubType
+func newMessageBuilder(bfc, typeCode, subTypeCode string) messageBuilder {
+	fwm := &FEDWireMessage{}
+	fwm.SenderSupplied = NewSenderSupplied()
+	fwm.TypeSubType = NewTypeSubType()
+	fwm.TypeSubType.TypeCode = typeCode
+	fwm.TypeSubType.SubTypeCode = subTypeCode
+	fwm.BusinessFunctionCode = NewBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = bfc
+	return messageBuilder{fwm: fwm}
+}
+
+// build returns a copy of the message when it is valid, otherwise the errors of the setters and of Validate
+func (b *messageBuilder) build() (*FEDWireMessage, error) {
+	errs := append(base.ErrorList(nil), b.errs...)
+	if err := b.fwm.Validate(); err != nil {
+		errs.Add(err)
+	}
+	if !errs.Empty() {
+		return nil, errs
+	}
+	return b.fwm.Clone(), nil
+}
+
+func (b *messageBuilder) setUserRequestCorrelation(id string) {
+	if b.fwm.SenderSupplied == nil {
+		b.fwm.SenderSupplied = NewSenderSupplied()
+	}
+	b.fwm.SenderSupplied.UserRequestCorrelation = id
+}
+
+func (b *messageBuilder) setTypeSubType(typeCode, subTypeCode string) {
+	b.fwm.TypeSubType = NewTypeSubType()
+	b.fwm.TypeSubType.TypeCode = typeCode
+	b.fwm.TypeSubType.SubTypeCode = subTypeCode
+}
+
+func (b *messageBuilder) setAmount(cents int64) {
+	amount := NewAmount()
+	if err := amount.SetCents(cents); err != nil {
+		b.errs.Add(err)
+		return
+	}
+	b.fwm.Amount = amount
+}
+
+func (b *messageBuilder) setSender(aba, shortName string) {
+	b.fwm.SenderDepositoryInstitution = NewSenderDepositoryInstitution()
+	b.fwm.SenderDepositoryInstitution.SenderABANumber = aba
+	b.fwm.SenderDepositoryInstitution.SenderShortName = shortName
+}
+
+func (b *messageBuilder) setReceiver(aba, shortName string) {
+	b.fwm.ReceiverDepositoryInstitution = NewReceiverDepositoryInstitution()
+	b.fwm.ReceiverDepositoryInstitution.ReceiverABANumber = aba
+	b.fwm.ReceiverDepositoryInstitution.ReceiverShortName = shortName
+}
+
+func (b *messageBuilder) setSenderReference(ref string) {
+	b.fwm.SenderReference = nil
+	if strings.TrimSpace(ref) != "" {
+		b.fwm.SenderReference = NewSenderReference()
+		b.fwm.SenderReference.SenderReference = ref
+	}
+}
+
+func (b *messageBuilder) setPreviousMessageIdentifier(imad string) {
+	b.fwm.PreviousMessageIdentifier = nil
+	if strings.TrimSpace(imad) != "" {
+		b.fwm.PreviousMessageIdentifier = NewPreviousMessageIdentifier()
+		b.fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = imad
+	}
+}
+
+func (b *messageBuilder) setBeneficiaryReference(ref string) {
+	b.fwm.BeneficiaryReference = nil
+	if strings.TrimSpace(ref) != "" {
+		b.fwm.BeneficiaryReference = NewBeneficiaryReference()
+		b.fwm.BeneficiaryReference.BeneficiaryReference = ref
+	}
+}
+
+func (b *messageBuilder) setAccountCreditedDrawdown(aba string) {
+	b.fwm.AccountCreditedDrawdown = nil
+	if strings.TrimSpace(aba) != "" {
+		b.fwm.AccountCreditedDrawdown = NewAccountCreditedDrawdown()
+		b.fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber = aba
+	}
+}
+
+func (b *messageBuilder) setBeneficiary(p Personal) {
+	b.fwm.Beneficiary = NewBeneficiary()
+	b.fwm.Beneficiary.Personal = p
+}
+
+func (b *messageBuilder) setOriginator(p Personal) {
+	b.fwm.Originator = NewOriginator()
+	b.fwm.Originator.Personal = p
+}
+
+func (b *messageBuilder) setBeneficiaryIntermediaryFI(fi FinancialInstitution) {
+	b.fwm.BeneficiaryIntermediaryFI = NewBeneficiaryIntermediaryFI()
+	b.fwm.BeneficiaryIntermediaryFI.FinancialInstitution = fi
+}
+
+func (b *messageBuilder) setBeneficiaryFI(fi FinancialInstitution) {
+	b.fwm.BeneficiaryFI = NewBeneficiaryFI()
+	b.fwm.BeneficiaryFI.FinancialInstitution = fi
+}
+
+func (b *messageBuilder) setOriginatorFI(fi FinancialInstitution) {
+	b.fwm.OriginatorFI = NewOriginatorFI()
+	b.fwm.OriginatorFI.FinancialInstitution = fi
+}
+
+func (b *messageBuilder) setInstructingFI(fi FinancialInstitution) {
+	b.fwm.InstructingFI = NewInstructingFI()
+	b.fwm.InstructingFI.FinancialInstitution = fi
+}
@@ -0,0 +1,227 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCustomerTransferPlusBuilder builds fedWireMessage-CustomerTransferPlusStructuredRemittance.txt
+func TestCustomerTransferPlusBuilder(t *testing.T) {
+	expected := readFEDWireMessage(t, "fedWireMessage-CustomerTransferPlusStructuredRemittance.txt")
+	cents, err := expected.Amount.Cents()
+	require.NoError(t, err)
+
+	fwm, err := NewCustomerTransferPlusBuilder().
+		SenderSupplied(expected.SenderSupplied).
+		IMAD(expected.InputMessageAccountabilityData).
+		Amount(cents).
+		Sender("121042882", "Wells Fargo NA").
+		Receiver("231380104", "Citadel").
+		SenderReference("Sender Reference").
+		PreviousMessageIdentifier("Previous Message Ident").
+		LocalInstrument(expected.LocalInstrument).
+		PaymentNotification(expected.PaymentNotification).
+		BeneficiaryIntermediaryFI(expected.BeneficiaryIntermediaryFI.FinancialInstitution).
+		BeneficiaryFI(expected.BeneficiaryFI.FinancialInstitution).
+		Beneficiary(expected.Beneficiary.Personal).
+		BeneficiaryReference("Reference").
+		Originator(expected.Originator.Personal).
+		OriginatorOptionF(expected.OriginatorOptionF).
+		OriginatorFI(expected.OriginatorFI.FinancialInstitution).
+		InstructingFI(expected.InstructingFI.FinancialInstitution).
+		OriginatorToBeneficiary(expected.OriginatorToBeneficiary).
+		FIReceiverFI(expected.FIReceiverFI).
+		FIIntermediaryFI(expected.FIIntermediaryFI).
+		FIIntermediaryFIAdvice(expected.FIIntermediaryFIAdvice).
+		FIBeneficiaryFI(expected.FIBeneficiaryFI).
+		FIBeneficiaryFIAdvice(expected.FIBeneficiaryFIAdvice).
+		FIBeneficiary(expected.FIBeneficiary).
+		FIBeneficiaryAdvice(expected.FIBeneficiaryAdvice).
+		FIPaymentMethodToBeneficiary(expected.FIPaymentMethodToBeneficiary).
+		FIAdditionalFIToFI(expected.FIAdditionalFIToFI).
+		RemittanceOriginator(expected.RemittanceOriginator).
+		RemittanceBeneficiary(expected.RemittanceBeneficiary).
+		PrimaryRemittanceDocument(expected.PrimaryRemittanceDocument).
+		ActualAmountPaid(expected.ActualAmountPaid).
+		GrossAmountRemittanceDocument(expected.GrossAmountRemittanceDocument).
+		AmountNegotiatedDiscount(expected.AmountNegotiatedDiscount).
+		Adjustment(expected.Adjustment).
+		DateRemittanceDocument(expected.DateRemittanceDocument).
+		SecondaryRemittanceDocument(expected.SecondaryRemittanceDocument).
+		RemittanceFreeText(expected.RemittanceFreeText).
+		Build()
+	require.NoError(t, err)
+	require.Empty(t, DiffOptions{IgnorePadding: true}.Diff(&expected, fwm))
+
+	// tags are copied
+	expected.LocalInstrument.LocalInstrumentCode = ANSIX12format
+	require.Equal(t, RemittanceInformationStructured, fwm.LocalInstrument.LocalInstrumentCode)
+}
+
+// TestBankTransferBuilder fills in the defaults and builds a copy of the message
+func TestBankTransferBuilder(t *testing.T) {
+	imad := NewInputMessageAccountabilityData()
+	imad.InputCycleDate = "20190410"
+	imad.InputSource = "Source08"
+	imad.InputSequenceNumber = "000001"
+
+	b := NewBankTransferBuilder().
+		UserRequestCorrelation("User Req").
+		IMAD(imad).
+		Amount(123456).
+		Sender("121042882", "Wells Fargo NA").
+		Receiver("231380104", "Citadel").
+		BeneficiaryFI(FinancialInstitution{IdentificationCode: DemandDepositAccountNumber, Identifier: "123456789", Name: "FI Name"}).
+		Beneficiary(mockBeneficiary().Personal)
+	fwm, err := b.Build()
+	require.NoError(t, err)
+	require.Equal(t, FormatVersion, fwm.SenderSupplied.FormatVersion)
+	require.Equal(t, EnvironmentProduction, fwm.SenderSupplied.TestProductionCode)
+	require.Equal(t, FundsTransfer+BasicFundsTransfer, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, BankTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, "000000123456", fwm.Amount.Amount)
+	require.Nil(t, fwm.SenderReference)
+
+	// the builder keeps building after Build
+	fwm.Amount.Amount = "000000000001"
+	next, err := b.SenderReference("Sender Reference").TypeSubType(FundsTransfer, ReversalTransfer).
+		PreviousMessageIdentifier("20190410Source08000001").Build()
+	require.NoError(t, err)
+	require.Equal(t, "000000123456", next.Amount.Amount)
+	require.Equal(t, "Sender Reference", next.SenderReference.SenderReference)
+
+	next, err = b.SenderReference("").PreviousMessageIdentifier("").TypeSubType(FundsTransfer, BasicFundsTransfer).Build()
+	require.NoError(t, err)
+	require.Nil(t, next.SenderReference)
+	require.Nil(t, next.PreviousMessageIdentifier)
+}
+
+// TestBuilder_Errors returns the errors of the setters and of Validate
+func TestBuilder_Errors(t *testing.T) {
+	fwm, err := NewCustomerTransferBuilder().Amount(-1).Build()
+	require.Nil(t, fwm)
+	var errs base.ErrorList
+	require.True(t, errors.As(err, &errs))
+	require.Len(t, errs, 2)
+	require.True(t, errors.Is(errs[0], ErrMoneyRange))
+	require.True(t, errors.Is(errs[1], ErrFieldRequired), "%v", errs[1])
+
+	// the subtype must be permitted by the business function
+	_, err = NewBankTransferBuilder().
+		UserRequestCorrelation("User Req").
+		IMAD(mockInputMessageAccountabilityData()).
+		Amount(100).
+		Sender("121042882", "").
+		Receiver("231380104", "").
+		TypeSubType(FundsTransfer, RequestCredit).
+		Build()
+	require.True(t, errors.As(err, &errs))
+	var bfcErr ErrBusinessFunctionCodeProperty
+	require.True(t, errors.As(errs[0], &bfcErr))
+
+	// a beneficiary and originator are mandatory for a customer transfer
+	_, err = NewCustomerTransferBuilder().
+		UserRequestCorrelation("User Req").
+		IMAD(mockInputMessageAccountabilityData()).
+		Amount(100).
+		Sender("121042882", "").
+		Receiver("231380104", "").
+		Beneficiary(mockBeneficiary().Personal).
+		Build()
+	require.True(t, errors.As(err, &errs))
+	var fe *FieldError
+	require.True(t, errors.As(errs[0], &fe))
+	require.Equal(t, "Originator", fe.FieldName)
+}
+
+// TestServiceMessageBuilder has a zero amount by default
+func TestServiceMessageBuilder(t *testing.T) {
+	sm := NewServiceMessage()
+	sm.LineOne = "Line One"
+	fwm, err := NewServiceMessageBuilder().
+		UserRequestCorrelation("User Req").
+		IMAD(mockInputMessageAccountabilityData()).
+		Sender("121042882", "").
+		Receiver("231380104", "").
+		ServiceMessage(sm).
+		Build()
+	require.NoError(t, err)
+	require.Equal(t, "000000000000", fwm.Amount.Amount)
+	require.Equal(t, BFCServiceMessage, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, "Line One", fwm.ServiceMessage.LineOne)
+}
+
+// TestDrawdownBuilder builds fedWireMessage-DrawdownRefusal.txt as a drawdown request
+func TestDrawdownBuilder(t *testing.T) {
+	refusal := readFEDWireMessage(t, "fedWireMessage-DrawdownRefusal.txt")
+
+	fwm, err := NewDrawdownRequestBuilder().
+		UserRequestCorrelation("User Req").
+		IMAD(mockInputMessageAccountabilityData()).
+		Amount(1234567).
+		Sender("121042882", "Wells Fargo NA").
+		Receiver("231380104", "Citadel").
+		Beneficiary(refusal.Beneficiary.Personal).
+		BeneficiaryReference("Reference").
+		AccountDebitedDrawdown(refusal.AccountDebitedDrawdown).
+		Originator(refusal.Originator.Personal).
+		AccountCreditedDrawdown("121042882").
+		Build()
+	require.NoError(t, err)
+	require.Equal(t, FundsTransfer+RequestCredit, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, CustomerCorporateDrawdownRequest, fwm.BusinessFunctionCode.BusinessFunctionCode)
+
+	b := NewBankDrawdownRequestBuilder()
+	require.Equal(t, SettlementTransfer, b.fwm.TypeSubType.TypeCode)
+	require.Equal(t, BankDrawDownRequest, b.fwm.BusinessFunctionCode.BusinessFunctionCode)
+	b = NewDrawdownPaymentBuilder()
+	require.Equal(t, FundsTransferRequestCredit, b.fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, DrawdownResponse, b.fwm.BusinessFunctionCode.BusinessFunctionCode)
+}
+
+// TestBuilder_PermittedTags ensures builders do not expose the tags their business functions prohibit
+func TestBuilder_PermittedTags(t *testing.T) {
+	customerTags := []string{"Charges", "InstructedAmount", "ExchangeRate"}
+	ctpTags := []string{"LocalInstrument", "PaymentNotification", "OriginatorOptionF", "OrderingCustomer",
+		"UnstructuredAddenda", "RelatedRemittance", "RemittanceOriginator", "RemittanceFreeText"}
+	drawdownTags := []string{"AccountDebitedDrawdown", "AccountCreditedDrawdown", "FIDrawdownDebitAccountAdvice"}
+
+	tests := []struct {
+		builder    interface{}
+		prohibited []string
+	}{
+		{NewBankTransferBuilder(), concat(customerTags, ctpTags, drawdownTags, []string{"ServiceMessage"})},
+		{NewCustomerTransferBuilder(), concat(ctpTags, drawdownTags, []string{"ServiceMessage"})},
+		{NewCustomerTransferPlusBuilder(), concat(drawdownTags, []string{"ServiceMessage"})},
+		{NewFEDFundsSoldBuilder(), concat(customerTags, ctpTags, drawdownTags, []string{"ServiceMessage"})},
+		{NewDrawdownRequestBuilder(), concat(customerTags, ctpTags, []string{"ServiceMessage"})},
+		{NewServiceMessageBuilder(), concat(customerTags, ctpTags)},
+	}
+	for _, tt := range tests {
+		typ := reflect.TypeOf(tt.builder)
+		for _, name := range tt.prohibited {
+			_, ok := typ.MethodByName(name)
+			require.False(t, ok, "%s.%s", typ.Elem().Name(), name)
+		}
+		_, ok := typ.MethodByName("Build")
+		require.True(t, ok)
+	}
+	_, ok := reflect.TypeOf(NewCustomerTransferPlusBuilder()).MethodByName("RemittanceFreeText")
+	require.True(t, ok)
+}
+
+func concat(lists ...[]string) []string {
+	var out []string
+	for _, l := range lists {
+		out = append(out, l...)
+	}
+	return out
+}
@@ -0,0 +1,193 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// Camt029Namespace is the XML namespace of the supported camt.029 ResolutionOfInvestigation version
+const Camt029Namespace = "urn:iso:std:iso:20022:tech:xsd:camt.029.001.09"
+
+const (
+	// isoStatusCancelled is the camt.029 confirmation of an accepted cancellation request
+	isoStatusCancelled = "CNCL"
+	// isoStatusRejectedCancellation is the camt.029 confirmation of a refused cancellation request
+	isoStatusRejectedCancellation = "RJCR"
+	// isoStatusAcceptedCancellation is the camt.029 transaction status of an accepted cancellation request
+	isoStatusAcceptedCancellation = "ACCR"
+	// isoCamt056MessageName is the message name of a cancellation request
+	isoCamt056MessageName = "camt.056.001.08"
+)
+
+// Camt029Document is an ISO 20022 camt.029 ResolutionOfInvestigation document
+type Camt029Document struct {
+	XMLName                   xml.Name                         `xml:"urn:iso:std:iso:20022:tech:xsd:camt.029.001.09 Document"`
+	ResolutionOfInvestigation Camt029ResolutionOfInvestigation `xml:"RsltnOfInvstgtn"`
+}
+
+// Camt029ResolutionOfInvestigation is the RsltnOfInvstgtn of a camt.029 document
+type Camt029ResolutionOfInvestigation struct {
+	Assignment          ISOAssignment                `xml:"Assgnmt"`
+	Status              Camt029Status                `xml:"Sts"`
+	CancellationDetails []Camt029CancellationDetails `xml:"CxlDtls,omitempty"`
+}
+
+// Camt029Status is the Sts of a camt.029 document
+type Camt029Status struct {
+	Confirmation string `xml:"Conf"`
+}
+
+// Camt029CancellationDetails is the CxlDtls of a camt.029 document
+type Camt029CancellationDetails struct {
+	Transactions []Camt029TransactionInfo `xml:"TxInfAndSts"`
+}
+
+// Camt029TransactionInfo is the TxInfAndSts of a camt.029 document, which holds the outcome for one cancellation request
+type Camt029TransactionInfo struct {
+	CancellationStatusID          string                           `xml:"CxlStsId,omitempty"`
+	OriginalGroupInfo             *ISOOriginalGroupInfo            `xml:"OrgnlGrpInf,omitempty"`
+	OriginalInstructionID         string                           `xml:"OrgnlInstrId,omitempty"`
+	OriginalEndToEndID            string                           `xml:"OrgnlEndToEndId,omitempty"`
+	TransactionCancellationStatus string                           `xml:"TxCxlSts,omitempty"`
+	CancellationStatusReason      []ISOReasonInfo                  `xml:"CxlStsRsnInf,omitempty"`
+	OriginalTransactionReference  *ISOOriginalTransactionReference `xml:"OrgnlTxRef,omitempty"`
+}
+
+// Camt029FromXML reads a camt.029 document
+func Camt029FromXML(bs []byte) (*Camt029Document, error) {
+	doc := &Camt029Document{}
+	if err := isoFromXML(bs, doc, "camt.029"); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// XML returns the camt.029 document as XML
+func (doc *Camt029Document) XML() ([]byte, error) {
+	return isoXML(doc)
+}
+
+// ToCamt029 converts the answer to a request for reversal into a camt.029 ResolutionOfInvestigation.
+//
+// A service message (SubTypeCode 90) refuses the request identified by {3500} PreviousMessageIdentifier and is
+// converted with status RJCR, its {9000} ServiceMessage lines are the refusal reason. A reversal transfer (SubTypeCode
+// 02 or 08) accepts the request and is converted with status CNCL, referring to the reversed payment; the funds are
+// returned by the pacs.004 from ToPacs004. ToCamt029 does not validate the message, callers should make a Validate()
+// call first.
+func (fwm *FEDWireMessage) ToCamt029() (*Camt029Document, error) {
+	if fwm.TypeSubType == nil {
+		return nil, fieldError("TypeSubType", ErrFieldRequired)
+	}
+	tx := Camt029TransactionInfo{
+		CancellationStatusID:         fwm.isoMessageID(),
+		OriginalTransactionReference: fwm.isoOriginalTransactionReference(),
+	}
+	tx.OriginalTransactionReference.PaymentTypeInfo = fwm.isoPaymentTypeInfo()
+
+	confirmation := ""
+	switch fwm.TypeSubType.SubTypeCode {
+	case SSIServiceMessage:
+		confirmation = isoStatusRejectedCancellation
+		tx.TransactionCancellationStatus = isoStatusRejectedCancellation
+		tx.OriginalGroupInfo = &ISOOriginalGroupInfo{OriginalMessageNameID: isoCamt056MessageName}
+	case ReversalTransfer, ReversalPriorDayTransfer:
+		confirmation = isoStatusCancelled
+		tx.TransactionCancellationStatus = isoStatusAcceptedCancellation
+		tx.OriginalGroupInfo = &ISOOriginalGroupInfo{OriginalMessageNameID: fwm.isoOriginalMessageName()}
+	default:
+		return nil, fieldError("TypeSubType", ErrISOTypeSubType, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	}
+	tx.OriginalGroupInfo.OriginalMessageID = fwm.previousMessageID()
+	if tx.OriginalGroupInfo.OriginalMessageID == "" {
+		return nil, fieldError("PreviousMessageIdentifier", ErrFieldRequired)
+	}
+	if fwm.SenderReference != nil && strings.TrimSpace(fwm.SenderReference.SenderReference) != "" {
+		tx.CancellationStatusID = strings.TrimSpace(fwm.SenderReference.SenderReference)
+	}
+	if fwm.BeneficiaryReference != nil {
+		tx.OriginalEndToEndID = strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference)
+	}
+	if lines := fwm.serviceMessageLines(); len(lines) > 0 {
+		tx.CancellationStatusReason = []ISOReasonInfo{{AdditionalInfo: lines}}
+	}
+
+	instructing, instructed := fwm.isoSenderReceiverAgents()
+	doc := &Camt029Document{
+		ResolutionOfInvestigation: Camt029ResolutionOfInvestigation{
+			Assignment: ISOAssignment{
+				ID:               fwm.isoMessageID(),
+				Assigner:         ISOPartyChoice{Agent: instructing},
+				Assignee:         ISOPartyChoice{Agent: instructed},
+				CreationDateTime: isoCreationDateTime(),
+			},
+			Status:              Camt029Status{Confirmation: confirmation},
+			CancellationDetails: []Camt029CancellationDetails{{Transactions: []Camt029TransactionInfo{tx}}},
+		},
+	}
+	return doc, nil
+}
+
+// FEDWireMessageFromCamt029 converts a camt.029 ResolutionOfInvestigation which refuses a cancellation request (RJCR)
+// into a SVC service message refusing the request for reversal.
+//
+// The document must hold exactly one transaction. OrgnlGrpInf/OrgnlMsgId becomes {3500} PreviousMessageIdentifier and
+// the status reasons become {9000} ServiceMessage lines. An accepted cancellation returns ErrISOCancellationStatus,
+// the reversal transfer is created from the pacs.004 with FEDWireMessageFromPacs004. The returned message is not
+// validated, callers should make a Validate() call to confirm the message is a valid Fedwire message.
+func FEDWireMessageFromCamt029(doc *Camt029Document) (*FEDWireMessage, error) {
+	if doc == nil {
+		return nil, fieldError("Document", ErrISODocument)
+	}
+	rsltn := doc.ResolutionOfInvestigation
+	if len(rsltn.CancellationDetails) != 1 || len(rsltn.CancellationDetails[0].Transactions) != 1 {
+		return nil, fieldError("TxInfAndSts", ErrISOTransactionCount)
+	}
+	tx := rsltn.CancellationDetails[0].Transactions[0]
+	status := tx.TransactionCancellationStatus
+	if status == "" {
+		status = rsltn.Status.Confirmation
+	}
+	if status != isoStatusRejectedCancellation {
+		return nil, fieldError("TxCxlSts", ErrISOCancellationStatus, status)
+	}
+	if tx.OriginalGroupInfo == nil || tx.OriginalGroupInfo.OriginalMessageID == "" {
+		return nil, fieldError("OrgnlGrpInf", ErrISODocument)
+	}
+
+	fwm := &FEDWireMessage{}
+	fwm.setIMADFromISO(rsltn.Assignment.ID, rsltn.Assignment.CreationDateTime)
+	fwm.setISOSenderSupplied("")
+
+	var info *ISOPaymentTypeInfo
+	if tx.OriginalTransactionReference != nil {
+		info = tx.OriginalTransactionReference.PaymentTypeInfo
+	}
+	fwm.setFromISOPaymentTypeInfo(info, BFCServiceMessage, FundsTransfer+SSIServiceMessage)
+
+	fwm.Amount = NewAmount()
+	fwm.Amount.Amount = strings.Repeat("0", 12)
+	if err := fwm.setFromISOSenderReceiverAgents(rsltn.Assignment.Assigner.Agent, rsltn.Assignment.Assignee.Agent); err != nil {
+		return nil, err
+	}
+	if tx.CancellationStatusID != "" && tx.CancellationStatusID != rsltn.Assignment.ID {
+		fwm.SenderReference = NewSenderReference()
+		fwm.SenderReference.SenderReference = tx.CancellationStatusID
+	}
+	fwm.setPreviousMessageID(tx.OriginalGroupInfo.OriginalMessageID)
+	if tx.OriginalEndToEndID != "" && tx.OriginalEndToEndID != isoNotProvided {
+		fwm.BeneficiaryReference = NewBeneficiaryReference()
+		fwm.BeneficiaryReference.BeneficiaryReference = tx.OriginalEndToEndID
+	}
+	fwm.setFromISOOriginalTransactionReference(tx.OriginalTransactionReference)
+
+	var lines []string
+	for _, reason := range tx.CancellationStatusReason {
+		lines = append(lines, reason.AdditionalInfo...)
+	}
+	fwm.setServiceMessageLines(lines)
+	return fwm, nil
+}
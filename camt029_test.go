@@ -0,0 +1,76 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestCamt029_RequestReversalRefusal converts a refusal of a request for reversal into camt.029 and back
+func TestCamt029_RequestReversalRefusal(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-RequestReversalRefusal.txt")
+
+	doc, err := fwm.ToCamt029()
+	require.NoError(t, err)
+	rsltn := doc.ResolutionOfInvestigation
+	tx := rsltn.CancellationDetails[0].Transactions[0]
+	require.Equal(t, "RJCR", rsltn.Status.Confirmation)
+	require.Equal(t, "RJCR", tx.TransactionCancellationStatus)
+	require.Equal(t, "20190410Source08000007", tx.OriginalGroupInfo.OriginalMessageID)
+	require.Equal(t, "camt.056.001.08", tx.OriginalGroupInfo.OriginalMessageNameID)
+
+	bs, err := doc.XML()
+	require.NoError(t, err)
+	read, err := Camt029FromXML(bs)
+	require.NoError(t, err)
+
+	out, err := FEDWireMessageFromCamt029(read)
+	require.NoError(t, err)
+	require.NoError(t, out.Validate())
+	require.Equal(t, fwm.TypeSubType.String(), out.TypeSubType.String())
+	require.Equal(t, fwm.InputMessageAccountabilityData.String(), out.InputMessageAccountabilityData.String())
+	require.Equal(t, fwm.Amount.String(), out.Amount.String())
+	require.Equal(t, fwm.BusinessFunctionCode.String(), out.BusinessFunctionCode.String())
+	require.Equal(t, fwm.SenderReference.String(), out.SenderReference.String())
+	require.Equal(t, fwm.PreviousMessageIdentifier.String(), out.PreviousMessageIdentifier.String())
+	require.Equal(t, fwm.ServiceMessage.String(), out.ServiceMessage.String())
+}
+
+// TestCamt029_ReversalTransfer converts an accepted request for reversal into camt.029
+func TestCamt029_ReversalTransfer(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-ReversalTransfer.txt")
+
+	doc, err := fwm.ToCamt029()
+	require.NoError(t, err)
+	require.Equal(t, "CNCL", doc.ResolutionOfInvestigation.Status.Confirmation)
+	tx := doc.ResolutionOfInvestigation.CancellationDetails[0].Transactions[0]
+	require.Equal(t, "ACCR", tx.TransactionCancellationStatus)
+	require.Equal(t, "20190410Source08000001", tx.OriginalGroupInfo.OriginalMessageID)
+
+	_, err = FEDWireMessageFromCamt029(doc)
+	require.True(t, errors.Is(err, ErrISOCancellationStatus))
+}
+
+// TestCamt029_FromXML reads a camt.029 refusal into a service message
+func TestCamt029_FromXML(t *testing.T) {
+	bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", "camt029-RequestReversalRefusal.xml"))
+	require.NoError(t, err)
+	doc, err := Camt029FromXML(bs)
+	require.NoError(t, err)
+
+	fwm, err := FEDWireMessageFromCamt029(doc)
+	require.NoError(t, err)
+	require.NoError(t, fwm.Validate())
+	require.Equal(t, BFCServiceMessage, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, "1090", fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, "20190410Source08000007", fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, "Refusal 2", fwm.SenderReference.SenderReference)
+	require.Equal(t, "FUNDS ALREADY CREDITED", fwm.ServiceMessage.LineOne)
+}
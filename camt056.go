@@ -0,0 +1,182 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// Camt056Namespace is the XML namespace of the supported camt.056 FIToFIPaymentCancellationRequest version
+const Camt056Namespace = "urn:iso:std:iso:20022:tech:xsd:camt.056.001.08"
+
+// Camt056Document is an ISO 20022 camt.056 FIToFIPaymentCancellationRequest document
+type Camt056Document struct {
+	XMLName                    xml.Name                                `xml:"urn:iso:std:iso:20022:tech:xsd:camt.056.001.08 Document"`
+	PaymentCancellationRequest Camt056FIToFIPaymentCancellationRequest `xml:"FIToFIPmtCxlReq"`
+}
+
+// Camt056FIToFIPaymentCancellationRequest is the FIToFIPmtCxlReq of a camt.056 document
+type Camt056FIToFIPaymentCancellationRequest struct {
+	Assignment ISOAssignment       `xml:"Assgnmt"`
+	Underlying []Camt056Underlying `xml:"Undrlg"`
+}
+
+// Camt056Underlying is the Undrlg of a camt.056 document
+type Camt056Underlying struct {
+	Transactions []Camt056TransactionInfo `xml:"TxInf"`
+}
+
+// Camt056TransactionInfo is the TxInf of a camt.056 document, which identifies the payment to cancel
+type Camt056TransactionInfo struct {
+	CancellationID                    string                           `xml:"CxlId,omitempty"`
+	OriginalGroupInfo                 *ISOOriginalGroupInfo            `xml:"OrgnlGrpInf,omitempty"`
+	OriginalInstructionID             string                           `xml:"OrgnlInstrId,omitempty"`
+	OriginalEndToEndID                string                           `xml:"OrgnlEndToEndId,omitempty"`
+	OriginalInterbankSettlementAmount *ISOAmount                       `xml:"OrgnlIntrBkSttlmAmt,omitempty"`
+	OriginalInterbankSettlementDate   string                           `xml:"OrgnlIntrBkSttlmDt,omitempty"`
+	CancellationReason                []ISOReasonInfo                  `xml:"CxlRsnInf,omitempty"`
+	OriginalTransactionReference      *ISOOriginalTransactionReference `xml:"OrgnlTxRef,omitempty"`
+}
+
+// Camt056FromXML reads a camt.056 document
+func Camt056FromXML(bs []byte) (*Camt056Document, error) {
+	doc := &Camt056Document{}
+	if err := isoFromXML(bs, doc, "camt.056"); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// XML returns the camt.056 document as XML
+func (doc *Camt056Document) XML() ([]byte, error) {
+	return isoXML(doc)
+}
+
+// ToCamt056 converts a request for reversal (SubTypeCode 01 or 07) into a camt.056 FIToFIPaymentCancellationRequest.
+//
+// {3500} PreviousMessageIdentifier, the IMAD of the payment to reverse, is the original message identifier and its cycle
+// date is the original settlement date. {9000} ServiceMessage lines are the cancellation reason. The business function
+// code and type/subtype are carried in OrgnlTxRef/PmtTpInf so the request converts back to the same message.
+// ToCamt056 does not validate the message, callers should make a Validate() call first.
+func (fwm *FEDWireMessage) ToCamt056() (*Camt056Document, error) {
+	if fwm.TypeSubType == nil {
+		return nil, fieldError("TypeSubType", ErrFieldRequired)
+	}
+	switch fwm.TypeSubType.SubTypeCode {
+	case RequestReversal, RequestReversalPriorDayTransfer:
+	default:
+		return nil, fieldError("TypeSubType", ErrISOTypeSubType, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	}
+	previous := fwm.previousMessageID()
+	if previous == "" {
+		return nil, fieldError("PreviousMessageIdentifier", ErrFieldRequired)
+	}
+
+	tx := Camt056TransactionInfo{
+		CancellationID: fwm.isoMessageID(),
+		OriginalGroupInfo: &ISOOriginalGroupInfo{
+			OriginalMessageID:     previous,
+			OriginalMessageNameID: fwm.isoOriginalMessageName(),
+		},
+		OriginalTransactionReference: fwm.isoOriginalTransactionReference(),
+	}
+	tx.OriginalTransactionReference.PaymentTypeInfo = fwm.isoPaymentTypeInfo()
+	if fwm.SenderReference != nil && strings.TrimSpace(fwm.SenderReference.SenderReference) != "" {
+		tx.CancellationID = strings.TrimSpace(fwm.SenderReference.SenderReference)
+	}
+	if fwm.BeneficiaryReference != nil {
+		tx.OriginalEndToEndID = strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference)
+	}
+	if fwm.Amount != nil {
+		tx.OriginalInterbankSettlementAmount = &ISOAmount{Currency: isoCurrencyUSD, Value: isoDecimalFromImplied(fwm.Amount.Amount)}
+	}
+	if imad := imadFromISOMessageID(previous); imad != nil {
+		tx.OriginalInterbankSettlementDate = isoDateFromCCYYMMDD(imad.InputCycleDate)
+	}
+	if lines := fwm.serviceMessageLines(); len(lines) > 0 {
+		tx.CancellationReason = []ISOReasonInfo{{AdditionalInfo: lines}}
+	}
+
+	instructing, instructed := fwm.isoSenderReceiverAgents()
+	doc := &Camt056Document{
+		PaymentCancellationRequest: Camt056FIToFIPaymentCancellationRequest{
+			Assignment: ISOAssignment{
+				ID:               fwm.isoMessageID(),
+				Assigner:         ISOPartyChoice{Agent: instructing},
+				Assignee:         ISOPartyChoice{Agent: instructed},
+				CreationDateTime: isoCreationDateTime(),
+			},
+			Underlying: []Camt056Underlying{{Transactions: []Camt056TransactionInfo{tx}}},
+		},
+	}
+	return doc, nil
+}
+
+// FEDWireMessageFromCamt056 converts a camt.056 FIToFIPaymentCancellationRequest into a request for reversal.
+//
+// The document must hold exactly one transaction. OrgnlGrpInf/OrgnlMsgId becomes {3500} PreviousMessageIdentifier.
+// Without OrgnlTxRef/PmtTpInf the message is a SVC service message, with SubTypeCode 07 when the original settlement
+// date is before the request's cycle date and 01 otherwise. The returned message is not validated, callers should
+// make a Validate() call to confirm the message is a valid Fedwire message.
+func FEDWireMessageFromCamt056(doc *Camt056Document) (*FEDWireMessage, error) {
+	if doc == nil {
+		return nil, fieldError("Document", ErrISODocument)
+	}
+	req := doc.PaymentCancellationRequest
+	if len(req.Underlying) != 1 || len(req.Underlying[0].Transactions) != 1 {
+		return nil, fieldError("TxInf", ErrISOTransactionCount)
+	}
+	tx := req.Underlying[0].Transactions[0]
+	if tx.OriginalGroupInfo == nil || tx.OriginalGroupInfo.OriginalMessageID == "" {
+		return nil, fieldError("OrgnlGrpInf", ErrISODocument)
+	}
+
+	fwm := &FEDWireMessage{}
+	fwm.setIMADFromISO(req.Assignment.ID, req.Assignment.CreationDateTime)
+	fwm.setISOSenderSupplied("")
+
+	subType := RequestReversal
+	if tx.OriginalInterbankSettlementDate != "" {
+		if ccyymmddFromISODate(tx.OriginalInterbankSettlementDate) < fwm.InputMessageAccountabilityData.InputCycleDate {
+			subType = RequestReversalPriorDayTransfer
+		}
+	}
+	var info *ISOPaymentTypeInfo
+	if tx.OriginalTransactionReference != nil {
+		info = tx.OriginalTransactionReference.PaymentTypeInfo
+	}
+	fwm.setFromISOPaymentTypeInfo(info, BFCServiceMessage, FundsTransfer+subType)
+
+	fwm.Amount = NewAmount()
+	fwm.Amount.Amount = strings.Repeat("0", 12)
+	if tx.OriginalInterbankSettlementAmount != nil {
+		amount, err := impliedFromISODecimal(tx.OriginalInterbankSettlementAmount.Value)
+		if err != nil {
+			return nil, err
+		}
+		fwm.Amount.Amount = amount
+	}
+	if err := fwm.setFromISOSenderReceiverAgents(req.Assignment.Assigner.Agent, req.Assignment.Assignee.Agent); err != nil {
+		return nil, err
+	}
+	if tx.CancellationID != "" && tx.CancellationID != req.Assignment.ID {
+		fwm.SenderReference = NewSenderReference()
+		fwm.SenderReference.SenderReference = tx.CancellationID
+	}
+	fwm.setPreviousMessageID(tx.OriginalGroupInfo.OriginalMessageID)
+	if tx.OriginalEndToEndID != "" && tx.OriginalEndToEndID != isoNotProvided {
+		fwm.BeneficiaryReference = NewBeneficiaryReference()
+		fwm.BeneficiaryReference.BeneficiaryReference = tx.OriginalEndToEndID
+	}
+	fwm.setFromISOOriginalTransactionReference(tx.OriginalTransactionReference)
+
+	var lines []string
+	for _, reason := range tx.CancellationReason {
+		lines = append(lines, reason.AdditionalInfo...)
+	}
+	fwm.setServiceMessageLines(lines)
+	return fwm, nil
+}
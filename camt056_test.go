@@ -0,0 +1,86 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestCamt056_RequestReversal converts a request for reversal into camt.056 and back
+func TestCamt056_RequestReversal(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-RequestReversal.txt")
+
+	doc, err := fwm.ToCamt056()
+	require.NoError(t, err)
+	req := doc.PaymentCancellationRequest
+	tx := req.Underlying[0].Transactions[0]
+	require.Equal(t, "20190410Source08000007", req.Assignment.ID)
+	require.Equal(t, "Reversal Req 1", tx.CancellationID)
+	require.Equal(t, "20190410Source08000001", tx.OriginalGroupInfo.OriginalMessageID)
+	require.Equal(t, "2019-04-10", tx.OriginalInterbankSettlementDate)
+	require.Equal(t, "12345.67", tx.OriginalInterbankSettlementAmount.Value)
+	require.Equal(t, []string{"DUPLICATE PAYMENT", "PLEASE RETURN FUNDS"}, tx.CancellationReason[0].AdditionalInfo)
+
+	bs, err := doc.XML()
+	require.NoError(t, err)
+	read, err := Camt056FromXML(bs)
+	require.NoError(t, err)
+
+	out, err := FEDWireMessageFromCamt056(read)
+	require.NoError(t, err)
+	require.NoError(t, out.Validate())
+	require.Equal(t, fwm.TypeSubType.String(), out.TypeSubType.String())
+	require.Equal(t, fwm.InputMessageAccountabilityData.String(), out.InputMessageAccountabilityData.String())
+	require.Equal(t, fwm.Amount.String(), out.Amount.String())
+	require.Equal(t, fwm.BusinessFunctionCode.String(), out.BusinessFunctionCode.String())
+	require.Equal(t, fwm.SenderReference.String(), out.SenderReference.String())
+	require.Equal(t, fwm.PreviousMessageIdentifier.String(), out.PreviousMessageIdentifier.String())
+	require.Equal(t, fwm.BeneficiaryReference.String(), out.BeneficiaryReference.String())
+	require.Equal(t, fwm.Originator.String(), out.Originator.String())
+	require.Equal(t, fwm.OriginatorFI.String(), out.OriginatorFI.String())
+	require.Equal(t, fwm.BeneficiaryFI.String(), out.BeneficiaryFI.String())
+	require.Equal(t, fwm.Beneficiary.String(), out.Beneficiary.String())
+	require.Equal(t, fwm.ServiceMessage.String(), out.ServiceMessage.String())
+}
+
+// TestCamt056_FromXML reads a camt.056 for a prior day payment into a request for reversal
+func TestCamt056_FromXML(t *testing.T) {
+	bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", "camt056-RequestReversal.xml"))
+	require.NoError(t, err)
+	doc, err := Camt056FromXML(bs)
+	require.NoError(t, err)
+
+	fwm, err := FEDWireMessageFromCamt056(doc)
+	require.NoError(t, err)
+	require.NoError(t, fwm.Validate())
+	require.Equal(t, BFCServiceMessage, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, "1007", fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, "20190411Source08000003", fwm.InputMessageAccountabilityData.IMAD())
+	require.Equal(t, "20190410Source08000001", fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, "000001234567", fwm.Amount.Amount)
+	require.Equal(t, "{5000}D987654321*Jane Doe*", fwm.Originator.String())
+	require.Equal(t, "{4200}D123456789*John Doe*", fwm.Beneficiary.String())
+	require.Equal(t, "DUPLICATE PAYMENT", fwm.ServiceMessage.LineOne)
+}
+
+// TestCamt056_TypeSubType ensures only requests for reversal are converted
+func TestCamt056_TypeSubType(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-BankTransfer.txt")
+	_, err := fwm.ToCamt056()
+	require.True(t, errors.Is(err, ErrISOTypeSubType))
+
+	fwm = readFEDWireMessage(t, "fedWireMessage-RequestReversal.txt")
+	fwm.PreviousMessageIdentifier = nil
+	_, err = fwm.ToCamt056()
+	require.True(t, errors.Is(err, ErrFieldRequired))
+
+	_, err = FEDWireMessageFromCamt056(&Camt056Document{})
+	require.True(t, errors.Is(err, ErrISOTransactionCount))
+}
@@ -0,0 +1,73 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Canonical returns the canonical form of the business content of the FEDWireMessage.
+//
+// The canonical form holds a [path] line for each tag in the order of FEDWireMessage, which is the order of the
+// Fedwire format, followed by a field="value" line, quoted as a Go string, for each field of the tag which is not
+// blank. Paths are the JSON paths of the tag and its fields. It excludes:
+//
+//   - the ID of the message, which is not a tag
+//   - the tags appended by the Fed: {1100} MessageDisposition, {1110} ReceiptTimeStamp,
+//     {1120} OutputMessageAccountabilityData and {1130} ErrorWire
+//   - padding: values are trimmed of spaces, and amounts and the IMAD sequence number of leading zeros, as compared
+//     by Diff with IgnorePadding. Identifiers, accounts and routing numbers keep their leading zeros
+//   - delimiters: values are read from the parsed fields, so the * delimiters of the tag format and their cleanup do
+//     not appear
+//
+// For example:
+//
+//	[amount]
+//	amount="1234567"
+//	[beneficiary]
+//	personal.identificationCode="T"
+//	personal.name="John Doe"
+//
+// A nil FEDWireMessage has an empty canonical form, as does a message without tags.
+func (fwm *FEDWireMessage) Canonical() []byte {
+	if fwm == nil {
+		return []byte{}
+	}
+	var buf strings.Builder
+	v := reflect.ValueOf(fwm).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Type.Kind() != reflect.Ptr || fedAppendedTags[field.Name] || v.Field(i).IsNil() {
+			continue
+		}
+		tag := strings.Split(field.Tag.Get("json"), ",")[0]
+		buf.WriteString("[" + tag + "]\n")
+		for _, column := range csvColumnsOf(field.Type.Elem(), "", nil) {
+			if value := normalizeFieldPadding(tag+"."+column.name, csvValue(v.Field(i), column.index)); value != "" {
+				buf.WriteString(column.name + "=" + strconv.Quote(value) + "\n")
+			}
+		}
+	}
+	return []byte(buf.String())
+}
+
+// Fingerprint returns the hex encoded SHA-256 digest of the Canonical form of the FEDWireMessage.
+//
+// Messages with the same business content have the same fingerprint, whether they were read from the Fedwire tag
+// format or from JSON, built in Go, or appended to by the Fed. Messages which Diff with IgnorePadding reports equal
+// have the same fingerprint, so messages with the same fingerprint may differ in their ID, in the tags appended by
+// the Fed and in padding: spaces around values and leading zeros of amounts and the IMAD sequence number. The
+// fingerprint is stable across releases for tags and fields which are unchanged.
+//
+// A nil FEDWireMessage has the fingerprint of an empty canonical form.
+func (fwm *FEDWireMessage) Fingerprint() string {
+	sum := sha256.Sum256(fwm.Canonical())
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,99 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestFEDWireMessage_Canonical writes the tags in order without padding, blank fields or Fed appended tags
+func TestFEDWireMessage_Canonical(t *testing.T) {
+	fwm := FEDWireMessage{
+		ID:               "ignored",
+		ReceiptTimeStamp: mockReceiptTimeStamp(),
+		Amount:           mockAmount(),
+		Beneficiary:      NewBeneficiary(),
+	}
+	fwm.Beneficiary.Personal.IdentificationCode = DriversLicenseNumber
+	fwm.Beneficiary.Personal.Name = "John Doe  "
+	fwm.Beneficiary.Personal.Address.AddressLineOne = `Line "One"`
+
+	require.Equal(t, `[amount]
+amount="1234567"
+[beneficiary]
+personal.identificationCode="3"
+personal.name="John Doe"
+personal.address.addressLineOne="Line \"One\""
+`, string(fwm.Canonical()))
+}
+
+// TestFEDWireMessage_Fingerprint has the same fingerprint for the same business content from any source
+func TestFEDWireMessage_Fingerprint(t *testing.T) {
+	for _, name := range []string{
+		"fedWireMessage-CustomerTransfer.txt",
+		"fedWireMessage-CustomerTransferPlusCOVS.txt",
+		"fedWireMessage-CustomerTransferPlusStructuredRemittance.txt",
+		"fedWireMessage-BankTransfer.txt",
+	} {
+		fwm := readFEDWireMessage(t, name)
+		fingerprint := fwm.Fingerprint()
+		require.Len(t, fingerprint, 64)
+
+		// JSON
+		bs, err := json.Marshal(fwm)
+		require.NoError(t, err, name)
+		var fromJSON FEDWireMessage
+		require.NoError(t, json.Unmarshal(bs, &fromJSON), name)
+		require.Equal(t, fingerprint, fromJSON.Fingerprint(), name)
+
+		// tag format
+		f := NewFile()
+		f.AddFEDWireMessage(fwm)
+		var buf strings.Builder
+		require.NoError(t, NewWriter(&buf).Write(f), name)
+		read, err := NewReader(strings.NewReader(buf.String())).Read()
+		require.NoError(t, err, name)
+		require.Equal(t, fingerprint, read.FEDWireMessage.Fingerprint(), name)
+
+		// padding, ID and Fed appended tags
+		clone := fwm.Clone()
+		clone.ID = "other"
+		clone.Amount.Amount = strings.TrimLeft(clone.Amount.Amount, "0")
+		clone.OutputMessageAccountabilityData = mockOutputMessageAccountabilityData()
+		if clone.CurrencyInstructedAmount != nil {
+			clone.CurrencyInstructedAmount.Amount = "000" + clone.CurrencyInstructedAmount.Amount
+		}
+		require.Equal(t, fingerprint, clone.Fingerprint(), name)
+
+		clone.Amount.Amount = "1"
+		require.NotEqual(t, fingerprint, clone.Fingerprint(), name)
+	}
+}
+
+// TestFEDWireMessage_FingerprintIdentifierZeros has different fingerprints for identifiers which differ in leading zeros
+func TestFEDWireMessage_FingerprintIdentifierZeros(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	fwm.Beneficiary.Personal.Identifier = "0012345"
+	other := fwm.Clone()
+	other.Beneficiary.Personal.Identifier = "12345"
+	require.NotEqual(t, fwm.Fingerprint(), other.Fingerprint())
+	require.Contains(t, string(fwm.Canonical()), `personal.identifier="0012345"`)
+
+	fwm.SenderDepositoryInstitution.SenderABANumber = "021000021"
+	other = fwm.Clone()
+	other.SenderDepositoryInstitution.SenderABANumber = "21000021"
+	require.NotEqual(t, fwm.Fingerprint(), other.Fingerprint())
+}
+
+func TestFEDWireMessage_CanonicalNil(t *testing.T) {
+	var fwm *FEDWireMessage
+	require.Empty(t, fwm.Canonical())
+	require.Equal(t, (&FEDWireMessage{}).Fingerprint(), fwm.Fingerprint())
+	require.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", fwm.Fingerprint())
+}
@@ -162,3 +162,50 @@ func (c *Charges) SendersChargesThreeField() string {
 func (c *Charges) SendersChargesFourField() string {
 	return c.alphaField(c.SendersChargesFour, 15)
 }
+
+// Parsed returns each of the sender's charges, e.g. USD1234,56 is USD 1234.56, skipping blank charges
+func (c *Charges) Parsed() ([]Money, error) {
+	fields := []struct {
+		name  string
+		value string
+	}{
+		{"SendersChargesOne", c.SendersChargesOne},
+		{"SendersChargesTwo", c.SendersChargesTwo},
+		{"SendersChargesThree", c.SendersChargesThree},
+		{"SendersChargesFour", c.SendersChargesFour},
+	}
+	var charges []Money
+	for _, field := range fields {
+		if strings.TrimSpace(field.value) == "" {
+			continue
+		}
+		m, err := parseWireMoney(field.value)
+		if err != nil {
+			return nil, fieldError(field.name, err, field.value)
+		}
+		charges = append(charges, m)
+	}
+	return charges, nil
+}
+
+// SetParsed sets up to four sender's charges, formatting each with a currency code and a comma decimal marker, e.g.
+// USD 1234.56 as USD1234,56. Sender's charges which are not given are cleared.
+func (c *Charges) SetParsed(charges ...Money) error {
+	if len(charges) > 4 {
+		return fieldError("SendersCharges", ErrMoneyCount, len(charges))
+	}
+	values := make([]string, 4)
+	for i := range charges {
+		if err := c.isCurrencyCode(charges[i].Currency); err != nil {
+			return fieldError("CurrencyCode", err, charges[i].Currency)
+		}
+		amount, err := charges[i].wireAmount(12)
+		if err != nil {
+			return err
+		}
+		values[i] = charges[i].Currency + amount
+	}
+	c.SendersChargesOne, c.SendersChargesTwo, c.SendersChargesThree, c.SendersChargesFour =
+		values[0], values[1], values[2], values[3]
+	return nil
+}
@@ -1,6 +1,7 @@
 package wire
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -43,3 +44,28 @@ func TestChargesEmpty(t *testing.T) {
 	require.Empty(t, c.SendersChargesThree)
 	require.Empty(t, c.SendersChargesFour)
 }
+
+// TestChargesParsed parses and sets the sender's charges
+func TestChargesParsed(t *testing.T) {
+	c := mockCharges()
+	c.SendersChargesThree = ""
+	charges, err := c.Parsed()
+	require.NoError(t, err)
+	require.Equal(t, []Money{NewMoney("USD", 99), NewMoney("USD", 299), NewMoney("USD", 100)}, charges)
+
+	require.NoError(t, c.SetParsed(NewMoney("USD", 123456), NewMoney("JPY", 1500), NewMoney("USD", 5)))
+	require.Equal(t, "USD1234,56", c.SendersChargesOne)
+	require.Equal(t, "JPY1500", c.SendersChargesTwo)
+	require.Equal(t, "USD0,05", c.SendersChargesThree)
+	require.Equal(t, "", c.SendersChargesFour)
+	require.NoError(t, c.Validate())
+
+	require.True(t, errors.Is(c.SetParsed(make([]Money, 5)...), ErrMoneyCount))
+	require.True(t, errors.Is(c.SetParsed(NewMoney("XZP", 1)), ErrNonCurrencyCode))
+	require.True(t, errors.Is(c.SetParsed(NewMoney("USD", 1234567890123)), ErrMoneyRange))
+
+	c.SendersChargesTwo = "USD1,2,3"
+	_, err = c.Parsed()
+	require.True(t, errors.Is(err, ErrNonAmount))
+	require.Contains(t, err.Error(), "SendersChargesTwo")
+}
@@ -0,0 +1,80 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Clone returns a deep copy of the FEDWireMessage. Each tag is copied, including its unexported tag, so the copy
+// can be amended without changing the original.
+func (fwm *FEDWireMessage) Clone() *FEDWireMessage {
+	if fwm == nil {
+		return nil
+	}
+	clone := *fwm
+	v := reflect.ValueOf(&clone).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if field := v.Field(i); field.Kind() == reflect.Ptr {
+			CloneTag(field.Addr().Interface(), field.Interface())
+		}
+	}
+	return &clone
+}
+
+// Equal reports whether fwm and other hold the same tags with the same values after normalizing padding, i.e.
+// when DiffOptions{IgnorePadding: true} finds no changes. Amounts such as 000001234567 and 1234567 are equal, while
+// identifiers, accounts and routing numbers which differ in leading zeros are not. The ID of each message is not
+// compared.
+func (fwm *FEDWireMessage) Equal(other *FEDWireMessage) bool {
+	if fwm == nil || other == nil {
+		return fwm == other
+	}
+	return len(DiffOptions{IgnorePadding: true}.Diff(fwm, other)) == 0
+}
+
+// CloneTag sets dst, a pointer to a tag pointer such as &fwm.Amount, to a copy of the tag src, including its
+// unexported tag, or to nil when src is nil. Tags hold only values, so the copy can be amended without changing
+// src. CloneTag panics when dst is not a pointer to the type of src.
+func CloneTag(dst, src interface{}) {
+	d, sv := reflect.ValueOf(dst).Elem(), reflect.ValueOf(src)
+	if !sv.IsValid() || sv.IsNil() {
+		d.Set(reflect.Zero(d.Type()))
+		return
+	}
+	clone := reflect.New(sv.Type().Elem())
+	clone.Elem().Set(sv.Elem())
+	d.Set(clone)
+}
+
+// TagEqual reports whether a and b, two tags of the same type such as *Amount, are both nil or hold the same values
+// after normalizing padding, as compared by Equal. The unexported tag is not compared.
+func TagEqual(a, b interface{}) bool {
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+	if av.IsNil() || bv.IsNil() {
+		return av.IsNil() && bv.IsNil()
+	}
+	opts := DiffOptions{IgnorePadding: true}
+	path := tagPaths[av.Type()]
+	for _, column := range csvColumnsOf(av.Type().Elem(), path+".", nil) {
+		if !opts.equal(column.name, csvValue(av, column.index), csvValue(bv, column.index)) {
+			return false
+		}
+	}
+	return true
+}
+
+// tagPaths are the JSON paths of the tags of a FEDWireMessage by their type, e.g. amount for *Amount
+var tagPaths = func() map[reflect.Type]string {
+	paths := make(map[reflect.Type]string)
+	t := reflect.TypeOf(FEDWireMessage{})
+	for i := 0; i < t.NumField(); i++ {
+		if field := t.Field(i); field.Type.Kind() == reflect.Ptr {
+			paths[field.Type] = strings.Split(field.Tag.Get("json"), ",")[0]
+		}
+	}
+	return paths
+}()
@@ -0,0 +1,93 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestFEDWireMessage_Clone copies every tag so the copy can be amended without changing the original
+func TestFEDWireMessage_Clone(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.txt")
+	clone := fwm.Clone()
+	require.Equal(t, &fwm, clone)
+	require.NoError(t, clone.Validate())
+
+	v, cv := reflect.ValueOf(fwm), reflect.ValueOf(clone).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if v.Field(i).Kind() == reflect.Ptr && !v.Field(i).IsNil() {
+			require.NotEqual(t, v.Field(i).Pointer(), cv.Field(i).Pointer(), v.Type().Field(i).Name)
+		}
+	}
+
+	clone.Beneficiary.Personal.Name = "Jane Doe"
+	clone.OrderingCustomer.CoverPayment.SwiftLineTwo = "Other"
+	require.Equal(t, "Name", fwm.Beneficiary.Personal.Name)
+	require.NotEqual(t, "Other", fwm.OrderingCustomer.CoverPayment.SwiftLineTwo)
+	require.Equal(t, TagBeneficiary, clone.Beneficiary.tag)
+
+	var nilMessage *FEDWireMessage
+	require.Nil(t, nilMessage.Clone())
+}
+
+// TestFEDWireMessage_TagsHoldValues ensures copying a tag struct deeply copies it, which Clone relies on
+func TestFEDWireMessage_TagsHoldValues(t *testing.T) {
+	var check func(t *testing.T, typ reflect.Type)
+	check = func(t *testing.T, typ reflect.Type) {
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			switch field.Type.Kind() {
+			case reflect.String:
+			case reflect.Struct:
+				check(t, field.Type)
+			default:
+				t.Errorf("%s.%s is a %s which Clone would share", typ.Name(), field.Name, field.Type.Kind())
+			}
+		}
+	}
+	typ := reflect.TypeOf(FEDWireMessage{})
+	for i := 0; i < typ.NumField(); i++ {
+		if field := typ.Field(i); field.Type.Kind() == reflect.Ptr {
+			check(t, field.Type.Elem())
+		}
+	}
+}
+
+// TestFEDWireMessage_Equal compares messages after normalizing padding, keeping the leading zeros of identifiers
+func TestFEDWireMessage_Equal(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	clone := fwm.Clone()
+	clone.ID = "other"
+	require.True(t, fwm.Equal(clone))
+
+	require.Equal(t, "000001234567", fwm.Amount.Amount)
+	clone.Amount.Amount = "1234567"
+	clone.Beneficiary.Personal.Name = fwm.Beneficiary.Personal.Name + "   "
+	require.False(t, reflect.DeepEqual(&fwm, clone))
+	require.True(t, fwm.Equal(clone))
+
+	clone.Amount.Amount = "1234568"
+	require.False(t, fwm.Equal(clone))
+
+	clone = fwm.Clone()
+	clone.Beneficiary.Personal.Identifier = "00" + fwm.Beneficiary.Personal.Identifier
+	require.False(t, fwm.Equal(clone))
+
+	clone = fwm.Clone()
+	clone.Beneficiary.Personal.Name = "Other"
+	require.False(t, fwm.Equal(clone))
+
+	clone = fwm.Clone()
+	clone.OriginatorToBeneficiary = nil
+	require.False(t, fwm.Equal(clone))
+	require.False(t, clone.Equal(&fwm))
+
+	var nilMessage *FEDWireMessage
+	require.True(t, nilMessage.Equal(nil))
+	require.False(t, nilMessage.Equal(&fwm))
+}
@@ -0,0 +1,703 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// Code is a value of a code list and its description, e.g. for a dropdown or an explanation of a field
+type Code struct {
+	// Value is the code as it appears in a tag, e.g. CTR
+	Value string `json:"value"`
+	// Description of the code, e.g. Customer Transfer
+	Description string `json:"description"`
+}
+
+// codeList is the catalog of a code list, in the order of the constants in const.go
+type codeList []Code
+
+// description returns the description of value, or "" when value is not in the list
+func (l codeList) description(value string) string {
+	for _, c := range l {
+		if c.Value == value {
+			return c.Description
+		}
+	}
+	return ""
+}
+
+// contains returns true when value is in the list
+func (l codeList) contains(value string) bool {
+	for _, c := range l {
+		if c.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// codes returns a copy of the list which the caller may modify
+func (l codeList) codes() []Code {
+	return append([]Code(nil), l...)
+}
+
+// Catalogs returns the catalog of every code list keyed by the name of its type, e.g. BusinessFunction
+func Catalogs() map[string][]Code {
+	return map[string][]Code{
+		"BusinessFunction":               BusinessFunctions(),
+		"TypeCode":                       TypeCodes(),
+		"SubTypeCode":                    SubTypeCodes(),
+		"LocalInstrumentCode":            LocalInstrumentCodes(),
+		"ChargeDetails":                  ChargeDetailsCodes(),
+		"IdentificationCode":             IdentificationCodes(),
+		"AdviceCode":                     AdviceCodes(),
+		"RemittanceLocationMethod":       RemittanceLocationMethods(),
+		"AddressType":                    AddressTypes(),
+		"IdentificationType":             IdentificationTypes(),
+		"OrganizationIdentificationCode": OrganizationIdentificationCodes(),
+		"PrivateIdentificationCode":      PrivateIdentificationCodes(),
+		"DocumentTypeCode":               DocumentTypeCodes(),
+		"AdjustmentReasonCode":           AdjustmentReasonCodes(),
+		"CreditDebitIndicator":           CreditDebitIndicators(),
+		"PartyIdentifierCode":            PartyIdentifierCodes(),
+		"OptionFLineCode":                OptionFLineCodes(),
+	}
+}
+
+// fieldCatalogs names the catalogs of Catalogs holding the codes of each code field, keyed by struct and field name
+var fieldCatalogs = map[string][]string{
+	"TypeSubType.TypeCode":                         {"TypeCode"},
+	"TypeSubType.SubTypeCode":                      {"SubTypeCode"},
+	"BusinessFunctionCode.BusinessFunctionCode":    {"BusinessFunction"},
+	"LocalInstrument.LocalInstrumentCode":          {"LocalInstrumentCode"},
+	"Charges.ChargeDetails":                        {"ChargeDetails"},
+	"Personal.IdentificationCode":                  {"IdentificationCode"},
+	"FinancialInstitution.IdentificationCode":      {"IdentificationCode"},
+	"AccountDebitedDrawdown.IdentificationCode":    {"IdentificationCode"},
+	"Advice.AdviceCode":                            {"AdviceCode"},
+	"RelatedRemittance.RemittanceLocationMethod":   {"RemittanceLocationMethod"},
+	"RemittanceData.AddressType":                   {"AddressType"},
+	"RemittanceOriginator.IdentificationType":      {"IdentificationType"},
+	"RemittanceOriginator.IdentificationCode":      {"OrganizationIdentificationCode", "PrivateIdentificationCode"},
+	"RemittanceBeneficiary.IdentificationType":     {"IdentificationType"},
+	"RemittanceBeneficiary.IdentificationCode":     {"OrganizationIdentificationCode", "PrivateIdentificationCode"},
+	"PrimaryRemittanceDocument.DocumentTypeCode":   {"DocumentTypeCode"},
+	"SecondaryRemittanceDocument.DocumentTypeCode": {"DocumentTypeCode"},
+	"Adjustment.CreditDebitIndicator":              {"CreditDebitIndicator"},
+	"Adjustment.AdjustmentReasonCode":              {"AdjustmentReasonCode"},
+}
+
+// fieldConstants holds the codes of the code fields which have no catalog and are validated against const.go, keyed
+// by struct and field name. MessageDuplicationOriginal is empty and so is not listed.
+var fieldConstants = map[string][]string{
+	"SenderSupplied.FormatVersion":             {FormatVersion},
+	"SenderSupplied.TestProductionCode":        {EnvironmentTest, EnvironmentProduction},
+	"SenderSupplied.MessageDuplicationCode":    {MessageDuplicationResend},
+	"BusinessFunctionCode.TransactionTypeCode": {"COV"},
+}
+
+// fieldCodes returns the codes of the code field keyed by struct and field name in catalog order and without
+// duplicates, or nil when the field is not a code
+func fieldCodes(key string) []string {
+	codes := append([]string(nil), fieldConstants[key]...)
+	seen := make(map[string]bool)
+	catalogs := Catalogs()
+	for _, name := range fieldCatalogs[key] {
+		for _, code := range catalogs[name] {
+			if !seen[code.Value] {
+				seen[code.Value] = true
+				codes = append(codes, code.Value)
+			}
+		}
+	}
+	return codes
+}
+
+// BusinessFunction is a {3600} BusinessFunctionCode, e.g. CTR
+type BusinessFunction string
+
+// businessFunctions is the catalog of BusinessFunction
+var businessFunctions = codeList{
+	{BankTransfer, "Bank Transfer"},
+	{CheckSameDaySettlement, "Check Same Day Settlement"},
+	{CustomerTransferPlus, "Customer Transfer Plus"},
+	{CustomerTransfer, "Customer Transfer"},
+	{DepositSendersAccount, "Deposit to Sender's Account"},
+	{BankDrawDownRequest, "Bank-to-Bank Drawdown Request"},
+	{CustomerCorporateDrawdownRequest, "Customer or Corporate Drawdown Request"},
+	{DrawdownResponse, "Drawdown Payment"},
+	{FEDFundsReturned, "Fed Funds Returned"},
+	{FEDFundsSold, "Fed Funds Sold"},
+	{BFCServiceMessage, "Service Message"},
+}
+
+// BusinessFunctions returns the catalog of BusinessFunction
+func BusinessFunctions() []Code {
+	return businessFunctions.codes()
+}
+
+// String returns the code
+func (c BusinessFunction) String() string {
+	return string(c)
+}
+
+// Description returns the description of the code, or "" when it is not valid
+func (c BusinessFunction) Description() string {
+	return businessFunctions.description(string(c))
+}
+
+// IsValid returns true when the code is in the catalog
+func (c BusinessFunction) IsValid() bool {
+	return businessFunctions.contains(string(c))
+}
+
+// TypeCode is a {1510} TypeCode, e.g. 10
+type TypeCode string
+
+// typeCodes is the catalog of TypeCode
+var typeCodes = codeList{
+	{FundsTransfer, "Funds Transfer"},
+	{ForeignTransfer, "Foreign Transfer"},
+	{SettlementTransfer, "Settlement Transfer"},
+}
+
+// TypeCodes returns the catalog of TypeCode
+func TypeCodes() []Code {
+	return typeCodes.codes()
+}
+
+// String returns the code
+func (c TypeCode) String() string {
+	return string(c)
+}
+
+// Description returns the description of the code, or "" when it is not valid
+func (c TypeCode) Description() string {
+	return typeCodes.description(string(c))
+}
+
+// IsValid returns true when the code is in the catalog
+func (c TypeCode) IsValid() bool {
+	return typeCodes.contains(string(c))
+}
+
+// SubTypeCode is a {1510} SubTypeCode, e.g. 00
+type SubTypeCode string
+
+// subTypeCodes is the catalog of SubTypeCode
+var subTypeCodes = codeList{
+	{BasicFundsTransfer, "Basic Funds Transfer"},
+	{RequestReversal, "Request for Reversal"},
+	{ReversalTransfer, "Reversal of Transfer"},
+	{RequestReversalPriorDayTransfer, "Request for Reversal of a Prior Day Transfer"},
+	{ReversalPriorDayTransfer, "Reversal of a Prior Day Transfer"},
+	{RequestCredit, "Request for Credit (Drawdown)"},
+	{FundsTransferRequestCredit, "Funds Transfer Honoring a Request for Credit"},
+	{RefusalRequestCredit, "Refusal to Honor a Request for Credit"},
+	{SSIServiceMessage, "Service Message"},
+}
+
+// SubTypeCodes returns the catalog of SubTypeCode
+func SubTypeCodes() []Code {
+	return subTypeCodes.codes()
+}
+
+// String returns the code
+func (c SubTypeCode) String() string {
+	return string(c)
+}
+
+// Description returns the description of the code, or "" when it is not valid
+func (c SubTypeCode) Description() string {
+	return subTypeCodes.description(string(c))
+}
+
+// IsValid returns true when the code is in the catalog
+func (c SubTypeCode) IsValid() bool {
+	return subTypeCodes.contains(string(c))
+}
+
+// LocalInstrumentCode is a {3610} LocalInstrumentCode, e.g. COVS
+type LocalInstrumentCode string
+
+// localInstrumentCodes is the catalog of LocalInstrumentCode
+var localInstrumentCodes = codeList{
+	{ANSIX12format, "ANSI X12 Format"},
+	{SequenceBCoverPaymentStructured, "Sequence B Cover Payment Structured"},
+	{GeneralXMLformat, "General XML Format"},
+	{ISO20022XMLformat, "ISO 20022 XML Format"},
+	{NarrativeText, "Narrative Text"},
+	{ProprietaryLocalInstrumentCode, "Proprietary Local Instrument Code"},
+	{RemittanceInformationStructured, "Remittance Information Structured"},
+	{RelatedRemittanceInformation, "Related Remittance Information"},
+	{STP820format, "STP 820 Format"},
+	{SWIFTfield70, "SWIFT Field 70"},
+	{UNEDIFACTformat, "UN/EDIFACT Format"},
+}
+
+// LocalInstrumentCodes returns the catalog of LocalInstrumentCode
+func LocalInstrumentCodes() []Code {
+	return localInstrumentCodes.codes()
+}
+
+// String returns the code
+func (c LocalInstrumentCode) String() string {
+	return string(c)
+}
+
+// Description returns the description of the code, or "" when it is not valid
+func (c LocalInstrumentCode) Description() string {
+	return localInstrumentCodes.description(string(c))
+}
+
+// IsValid returns true when the code is in the catalog
+func (c LocalInstrumentCode) IsValid() bool {
+	return localInstrumentCodes.contains(string(c))
+}
+
+// ChargeDetails is a {3700} Charges ChargeDetails, e.g. S
+type ChargeDetails string
+
+// chargeDetails is the catalog of ChargeDetails
+var chargeDetails = codeList{
+	{CDBeneficiary, "Beneficiary"},
+	{CDShared, "Shared"},
+}
+
+// ChargeDetailsCodes returns the catalog of ChargeDetails
+func ChargeDetailsCodes() []Code {
+	return chargeDetails.codes()
+}
+
+// String returns the code
+func (c ChargeDetails) String() string {
+	return string(c)
+}
+
+// Description returns the description of the code, or "" when it is not valid
+func (c ChargeDetails) Description() string {
+	return chargeDetails.description(string(c))
+}
+
+// IsValid returns true when the code is in the catalog
+func (c ChargeDetails) IsValid() bool {
+	return chargeDetails.contains(string(c))
+}
+
+// IdentificationCode is the identification code of a party or financial institution, e.g. D
+type IdentificationCode string
+
+// identificationCodes is the catalog of IdentificationCode
+var identificationCodes = codeList{
+	{SWIFTBankIdentifierCode, "SWIFT Bank Identifier Code (BIC)"},
+	{CHIPSParticipant, "CHIPS Participant"},
+	{DemandDepositAccountNumber, "Demand Deposit Account (DDA) Number"},
+	{FEDRoutingNumber, "Fed Routing Number"},
+	{SWIFTBICORBEIANDAccountNumber, "SWIFT BIC or BEI and Account Number"},
+	{CHIPSIdentifier, "CHIPS Identifier"},
+	{PassportNumber, "Passport Number"},
+	{TaxIdentificationNumber, "Tax Identification Number"},
+	{DriversLicenseNumber, "Driver's License Number"},
+	{AlienRegistrationNumber, "Alien Registration Number"},
+	{CorporateIdentification, "Corporate Identification"},
+	{OtherIdentification, "Other Identification"},
+}
+
+// IdentificationCodes returns the catalog of IdentificationCode
+func IdentificationCodes() []Code {
+	return identificationCodes.codes()
+}
+
+// String returns the code
+func (c IdentificationCode) String() string {
+	return string(c)
+}
+
+// Description returns the description of the code, or "" when it is not valid
+func (c IdentificationCode) Description() string {
+	return identificationCodes.description(string(c))
+}
+
+// IsValid returns true when the code is in the catalog
+func (c IdentificationCode) IsValid() bool {
+	return identificationCodes.contains(string(c))
+}
+
+// AdviceCode is the advice code of an advice tag, e.g. LTR
+type AdviceCode string
+
+// adviceCodes is the catalog of AdviceCode
+var adviceCodes = codeList{
+	{AdviceCodeHold, "Hold"},
+	{AdviceCodeLetter, "Letter"},
+	{AdviceCodePhone, "Phone"},
+	{AdviceCodeTelex, "Telex"},
+	{AdviceCodeWire, "Wire"},
+}
+
+// AdviceCodes returns the catalog of AdviceCode
+func AdviceCodes() []Code {
+	return adviceCodes.codes()
+}
+
+// String returns the code
+func (c AdviceCode) String() string {
+	return string(c)
+}
+
+// Description returns the description of the code, or "" when it is not valid
+func (c AdviceCode) Description() string {
+	return adviceCodes.description(string(c))
+}
+
+// IsValid returns true when the code is in the catalog
+func (c AdviceCode) IsValid() bool {
+	return adviceCodes.contains(string(c))
+}
+
+// RemittanceLocationMethod is a {8250} RelatedRemittance RemittanceLocationMethod, e.g. EMAL
+type RemittanceLocationMethod string
+
+// remittanceLocationMethods is the catalog of RemittanceLocationMethod
+var remittanceLocationMethods = codeList{
+	{RLMElectronicDataExchange, "Electronic Data Exchange"},
+	{RLMEmail, "Email"},
+	{RLMFax, "Fax"},
+	{RLMPostalService, "Postal Service"},
+	{RLMSMSM, "Short Message Service (Text)"},
+	{RLMURI, "Uniform Resource Identifier"},
+}
+
+// RemittanceLocationMethods returns the catalog of RemittanceLocationMethod
+func RemittanceLocationMethods() []Code {
+	return remittanceLocationMethods.codes()
+}
+
+// String returns the code
+func (c RemittanceLocationMethod) String() string {
+	return string(c)
+}
+
+// Description returns the description of the code, or "" when it is not valid
+func (c RemittanceLocationMethod) Description() string {
+	return remittanceLocationMethods.description(string(c))
+}
+
+// IsValid returns true when the code is in the catalog
+func (c RemittanceLocationMethod) IsValid() bool {
+	return remittanceLocationMethods.contains(string(c))
+}
+
+// AddressType is the address type of a remittance party, e.g. ADDR
+type AddressType string
+
+// addressTypes is the catalog of AddressType
+var addressTypes = codeList{
+	{CompletePostalAddress, "Complete Postal Address"},
+	{HomeAddress, "Home Address"},
+	{BusinessAddress, "Business Address"},
+	{MailAddress, "Mail To Address"},
+	{DeliveryAddress, "Delivery To Address"},
+	{PostOfficeBox, "Post Office Box"},
+}
+
+// AddressTypes returns the catalog of AddressType
+func AddressTypes() []Code {
+	return addressTypes.codes()
+}
+
+// String returns the code
+func (c AddressType) String() string {
+	return string(c)
+}
+
+// Description returns the description of the code, or "" when it is not valid
+func (c AddressType) Description() string {
+	return addressTypes.description(string(c))
+}
+
+// IsValid returns true when the code is in the catalog
+func (c AddressType) IsValid() bool {
+	return addressTypes.contains(string(c))
+}
+
+// IdentificationType is the identification type of a remittance party, e.g. OI
+type IdentificationType string
+
+// identificationTypes is the catalog of IdentificationType
+var identificationTypes = codeList{
+	{OrganizationID, "Organization ID"},
+	{PrivateID, "Private ID"},
+}
+
+// IdentificationTypes returns the catalog of IdentificationType
+func IdentificationTypes() []Code {
+	return identificationTypes.codes()
+}
+
+// String returns the code
+func (c IdentificationType) String() string {
+	return string(c)
+}
+
+// Description returns the description of the code, or "" when it is not valid
+func (c IdentificationType) Description() string {
+	return identificationTypes.description(string(c))
+}
+
+// IsValid returns true when the code is in the catalog
+func (c IdentificationType) IsValid() bool {
+	return identificationTypes.contains(string(c))
+}
+
+// OrganizationIdentificationCode is the identification code of a remittance party with an OrganizationID, e.g. DUNS
+type OrganizationIdentificationCode string
+
+// organizationIdentificationCodes is the catalog of OrganizationIdentificationCode
+var organizationIdentificationCodes = codeList{
+	{OICBankPartyIdentification, "Bank Party Identification"},
+	{OICCustomerNumber, "Customer Number"},
+	{OICDataUniversalNumberSystem, "Data Universal Number System (Dun & Bradstreet)"},
+	{OICEmployerIdentificationNumber, "Employer Identification Number"},
+	{OICGlobalLocationNumber, "Global Location Number"},
+	{OICProprietaryIdentificationNumber, "Proprietary Identification Number"},
+	{OICSWIFTBICORBEI, "SWIFT BIC or BEI"},
+	{OICTaxIdentificationNumber, "Tax Identification Number"},
+}
+
+// OrganizationIdentificationCodes returns the catalog of OrganizationIdentificationCode
+func OrganizationIdentificationCodes() []Code {
+	return organizationIdentificationCodes.codes()
+}
+
+// String returns the code
+func (c OrganizationIdentificationCode) String() string {
+	return string(c)
+}
+
+// Description returns the description of the code, or "" when it is not valid
+func (c OrganizationIdentificationCode) Description() string {
+	return organizationIdentificationCodes.description(string(c))
+}
+
+// IsValid returns true when the code is in the catalog
+func (c OrganizationIdentificationCode) IsValid() bool {
+	return organizationIdentificationCodes.contains(string(c))
+}
+
+// PrivateIdentificationCode is the identification code of a remittance party with a PrivateID, e.g. SOSE
+type PrivateIdentificationCode string
+
+// privateIdentificationCodes is the catalog of PrivateIdentificationCode
+var privateIdentificationCodes = codeList{
+	{PICAlienRegistrationNumber, "Alien Registration Number"},
+	{PICPassportNumber, "Passport Number"},
+	{PICCustomerNumber, "Customer Number"},
+	{PICDateBirthPlace, "Date and Place of Birth"},
+	{PICEmployeeIdentificationNumber, "Employee Identification Number"},
+	{PICNationalIdentityNumber, "National Identity Number"},
+	{PICProprietaryIdentificationNumber, "Proprietary Identification Number"},
+	{PICSocialSecurityNumber, "Social Security Number"},
+	{PICTaxIdentificationNumber, "Tax Identification Number"},
+}
+
+// PrivateIdentificationCodes returns the catalog of PrivateIdentificationCode
+func PrivateIdentificationCodes() []Code {
+	return privateIdentificationCodes.codes()
+}
+
+// String returns the code
+func (c PrivateIdentificationCode) String() string {
+	return string(c)
+}
+
+// Description returns the description of the code, or "" when it is not valid
+func (c PrivateIdentificationCode) Description() string {
+	return privateIdentificationCodes.description(string(c))
+}
+
+// IsValid returns true when the code is in the catalog
+func (c PrivateIdentificationCode) IsValid() bool {
+	return privateIdentificationCodes.contains(string(c))
+}
+
+// DocumentTypeCode is the document type code of a remittance document, e.g. CINV
+type DocumentTypeCode string
+
+// documentTypeCodes is the catalog of DocumentTypeCode
+var documentTypeCodes = codeList{
+	{AccountsReceivableOpenItem, "Accounts Receivable Open Item"},
+	{BillLadingShippingNotice, "Bill of Lading Shipping Notice"},
+	{CommercialInvoice, "Commercial Invoice"},
+	{CommercialContract, "Commercial Contract"},
+	{CreditNoteRelatedFinancialAdjustment, "Credit Note Related to Financial Adjustment"},
+	{CreditNote, "Credit Note"},
+	{DebitNote, "Debit Note"},
+	{DispatchAdvice, "Dispatch Advice"},
+	{DebitNoteRelatedFinancialAdjustment, "Debit Note Related to Financial Adjustment"},
+	{HireInvoice, "Hire Invoice"},
+	{MeteredServiceInvoice, "Metered Service Invoice"},
+	{ProprietaryDocumentType, "Proprietary Document Type"},
+	{PurchaseOrder, "Purchase Order"},
+	{SelfBilledInvoice, "Self Billed Invoice"},
+	{StatementAccount, "Statement of Account"},
+	{TradeServicesUtilityTransaction, "Trade Services Utility Transaction"},
+	{Voucher, "Voucher"},
+}
+
+// DocumentTypeCodes returns the catalog of DocumentTypeCode
+func DocumentTypeCodes() []Code {
+	return documentTypeCodes.codes()
+}
+
+// String returns the code
+func (c DocumentTypeCode) String() string {
+	return string(c)
+}
+
+// Description returns the description of the code, or "" when it is not valid
+func (c DocumentTypeCode) Description() string {
+	return documentTypeCodes.description(string(c))
+}
+
+// IsValid returns true when the code is in the catalog
+func (c DocumentTypeCode) IsValid() bool {
+	return documentTypeCodes.contains(string(c))
+}
+
+// AdjustmentReasonCode is an {8600} Adjustment AdjustmentReasonCode, e.g. 01
+type AdjustmentReasonCode string
+
+// adjustmentReasonCodes is the catalog of AdjustmentReasonCode
+var adjustmentReasonCodes = codeList{
+	{PricingError, "Pricing Error"},
+	{ExtensionError, "Extension Error"},
+	{ItemNotAcceptedDamaged, "Item Not Accepted: Damaged"},
+	{ItemNotAcceptedQuality, "Item Not Accepted: Quality"},
+	{QuantityContested, "Quantity Contested"},
+	{IncorrectProduct, "Incorrect Product"},
+	{ReturnsDamaged, "Returns: Damaged"},
+	{ReturnsQuality, "Returns: Quality"},
+	{ItemNotReceived, "Item Not Received"},
+	{TotalOrderNotReceived, "Total Order Not Received"},
+	{CreditAgreed, "Credit as Agreed"},
+	{CoveredCreditMemo, "Covered by Credit Memo"},
+}
+
+// AdjustmentReasonCodes returns the catalog of AdjustmentReasonCode
+func AdjustmentReasonCodes() []Code {
+	return adjustmentReasonCodes.codes()
+}
+
+// String returns the code
+func (c AdjustmentReasonCode) String() string {
+	return string(c)
+}
+
+// Description returns the description of the code, or "" when it is not valid
+func (c AdjustmentReasonCode) Description() string {
+	return adjustmentReasonCodes.description(string(c))
+}
+
+// IsValid returns true when the code is in the catalog
+func (c AdjustmentReasonCode) IsValid() bool {
+	return adjustmentReasonCodes.contains(string(c))
+}
+
+// CreditDebitIndicator is an {8600} Adjustment CreditDebitIndicator, e.g. CRDT
+type CreditDebitIndicator string
+
+// creditDebitIndicators is the catalog of CreditDebitIndicator
+var creditDebitIndicators = codeList{
+	{CreditIndicator, "Credit"},
+	{DebitIndicator, "Debit"},
+}
+
+// CreditDebitIndicators returns the catalog of CreditDebitIndicator
+func CreditDebitIndicators() []Code {
+	return creditDebitIndicators.codes()
+}
+
+// String returns the code
+func (c CreditDebitIndicator) String() string {
+	return string(c)
+}
+
+// Description returns the description of the code, or "" when it is not valid
+func (c CreditDebitIndicator) Description() string {
+	return creditDebitIndicators.description(string(c))
+}
+
+// IsValid returns true when the code is in the catalog
+func (c CreditDebitIndicator) IsValid() bool {
+	return creditDebitIndicators.contains(string(c))
+}
+
+// PartyIdentifierCode is the code of a {5010} OriginatorOptionF PartyIdentifier which is not an account number, e.g. SOSE
+type PartyIdentifierCode string
+
+// partyIdentifierCodes is the catalog of PartyIdentifierCode
+var partyIdentifierCodes = codeList{
+	{PartyIdentifierAlienRegistrationNumber, "Alien Registration Number"},
+	{PartyIdentifierPassportNumber, "Passport Number"},
+	{PartyIdentifierCustomerIdentificationNumber, "Customer Identification Number"},
+	{PartyIdentifierDriversLicenseNumber, "Driver's License Number"},
+	{PartyIdentifierEmployerNumber, "Employer Number"},
+	{PartyIdentifierNationalIdentifyNumber, "National Identity Number"},
+	{PartyIdentifierSocialSecurityNumber, "Social Security Number"},
+	{PartyIdentifierTaxIdentificationNumber, "Tax Identification Number"},
+}
+
+// PartyIdentifierCodes returns the catalog of PartyIdentifierCode
+func PartyIdentifierCodes() []Code {
+	return partyIdentifierCodes.codes()
+}
+
+// String returns the code
+func (c PartyIdentifierCode) String() string {
+	return string(c)
+}
+
+// Description returns the description of the code, or "" when it is not valid
+func (c PartyIdentifierCode) Description() string {
+	return partyIdentifierCodes.description(string(c))
+}
+
+// IsValid returns true when the code is in the catalog
+func (c PartyIdentifierCode) IsValid() bool {
+	return partyIdentifierCodes.contains(string(c))
+}
+
+// OptionFLineCode is the line code of a {5010} OriginatorOptionF line, e.g. 2
+type OptionFLineCode string
+
+// optionFLineCodes is the catalog of OptionFLineCode
+var optionFLineCodes = codeList{
+	{OptionFName, "Name"},
+	{OptionFAddress, "Address"},
+	{OptionFCountryTown, "Country and Town"},
+	{OptionFDOB, "Date of Birth"},
+	{OptionFBirthPlace, "Place of Birth"},
+	{OptionFCustomerIdentificationNumber, "Customer Identification Number"},
+	{OptionFNationalIdentityNumber, "National Identity Number"},
+	{OptionFAdditionalInformation, "Additional Information"},
+}
+
+// OptionFLineCodes returns the catalog of OptionFLineCode
+func OptionFLineCodes() []Code {
+	return optionFLineCodes.codes()
+}
+
+// String returns the code
+func (c OptionFLineCode) String() string {
+	return string(c)
+}
+
+// Description returns the description of the code, or "" when it is not valid
+func (c OptionFLineCode) Description() string {
+	return optionFLineCodes.description(string(c))
+}
+
+// IsValid returns true when the code is in the catalog
+func (c OptionFLineCode) IsValid() bool {
+	return optionFLineCodes.contains(string(c))
+}
@@ -0,0 +1,100 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodes(t *testing.T) {
+	require.Equal(t, "CTR", BusinessFunction(CustomerTransfer).String())
+	require.Equal(t, "Customer Transfer", BusinessFunction(CustomerTransfer).Description())
+	require.True(t, BusinessFunction(CustomerTransfer).IsValid())
+	require.Equal(t, "Funds Transfer", TypeCode(FundsTransfer).Description())
+	require.Equal(t, "Request for Reversal", SubTypeCode(RequestReversal).Description())
+	require.Equal(t, "Fed Routing Number", IdentificationCode(FEDRoutingNumber).Description())
+	require.Equal(t, "Letter", AdviceCode(AdviceCodeLetter).Description())
+	require.Equal(t, "Post Office Box", AddressType(PostOfficeBox).Description())
+	require.Equal(t, "Commercial Invoice", DocumentTypeCode(CommercialInvoice).Description())
+	require.Equal(t, "Pricing Error", AdjustmentReasonCode(PricingError).Description())
+	require.Equal(t, "Social Security Number", PartyIdentifierCode(PartyIdentifierSocialSecurityNumber).Description())
+
+	require.False(t, BusinessFunction("ZZZ").IsValid())
+	require.Equal(t, "", BusinessFunction("ZZZ").Description())
+	require.False(t, TypeCode("").IsValid())
+	require.False(t, IdentificationCode("").IsValid())
+}
+
+func TestCatalogs(t *testing.T) {
+	catalogs := Catalogs()
+	require.Len(t, catalogs, 17)
+	for name, codes := range catalogs {
+		require.NotEmpty(t, codes, name)
+		seen := make(map[string]bool)
+		for _, c := range codes {
+			require.NotEmpty(t, c.Value, name)
+			require.NotEmpty(t, c.Description, name+" "+c.Value)
+			require.False(t, seen[c.Value], name+" "+c.Value)
+			seen[c.Value] = true
+		}
+	}
+	require.Len(t, BusinessFunctions(), 11)
+	require.Len(t, TypeCodes(), 3)
+	require.Len(t, SubTypeCodes(), 9)
+	require.Equal(t, Code{Value: BankTransfer, Description: "Bank Transfer"}, BusinessFunctions()[0])
+
+	// the catalog returned is a copy
+	codes := BusinessFunctions()
+	codes[0].Description = "changed"
+	require.Equal(t, "Bank Transfer", BusinessFunction(BankTransfer).Description())
+
+	bs, err := json.Marshal(TypeCodes()[:1])
+	require.NoError(t, err)
+	require.Equal(t, `[{"value":"10","description":"Funds Transfer"}]`, string(bs))
+}
+
+// TestCatalogs_Validators ensures the validators accept exactly the codes of the catalogs
+func TestCatalogs_Validators(t *testing.T) {
+	v := &validator{}
+	checks := []struct {
+		codes    []Code
+		validate func(string) error
+		err      error
+	}{
+		{TypeCodes(), v.isTypeCode, ErrTypeCode},
+		{SubTypeCodes(), v.isSubTypeCode, ErrSubTypeCode},
+		{LocalInstrumentCodes(), v.isLocalInstrumentCode, ErrLocalInstrumentCode},
+		{BusinessFunctions(), v.isBusinessFunctionCode, ErrBusinessFunctionCode},
+		{ChargeDetailsCodes(), v.isChargeDetails, ErrChargeDetails},
+		{IdentificationCodes(), v.isIdentificationCode, ErrIdentificationCode},
+		{AdviceCodes(), v.isAdviceCode, ErrAdviceCode},
+		{AddressTypes(), v.isAddressType, ErrAddressType},
+		{RemittanceLocationMethods(), v.isRemittanceLocationMethod, ErrRemittanceLocationMethod},
+		{IdentificationTypes(), v.isIdentificationType, ErrIdentificationType},
+		{OrganizationIdentificationCodes(), v.isOrganizationIdentificationCode, ErrOrganizationIdentificationCode},
+		{PrivateIdentificationCodes(), v.isPrivateIdentificationCode, ErrPrivateIdentificationCode},
+		{DocumentTypeCodes(), v.isDocumentTypeCode, ErrDocumentTypeCode},
+		{AdjustmentReasonCodes(), v.isAdjustmentReasonCode, ErrAdjustmentReasonCode},
+		{CreditDebitIndicators(), v.isCreditDebitIndicator, ErrCreditDebitIndicator},
+	}
+	for _, check := range checks {
+		for _, c := range check.codes {
+			require.NoError(t, check.validate(c.Value), c.Value)
+		}
+		require.Equal(t, check.err, check.validate("ZZZZ"))
+	}
+
+	for _, c := range PartyIdentifierCodes() {
+		require.NoError(t, v.validatePartyIdentifier(c.Value+"/123456"), c.Value)
+	}
+	require.Equal(t, ErrPartyIdentifier, v.validatePartyIdentifier("ZZZZ/123456"))
+	for _, c := range OptionFLineCodes() {
+		require.NoError(t, v.validateOptionFLine(c.Value+"/LINE"), c.Value)
+	}
+	require.Equal(t, ErrOptionFLine, v.validateOptionFLine("0/LINE"))
+}
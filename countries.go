@@ -0,0 +1,382 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+)
+
+// CountryConfidence is how certainly a country is the country of a party
+type CountryConfidence string
+
+const (
+	// CountryConfidenceHigh is a country code field, or the BIC or routing number of a financial institution
+	CountryConfidenceHigh CountryConfidence = "high"
+	// CountryConfidenceMedium is the country of the account or BIC identifying a customer, which is where their
+	// account is held
+	CountryConfidenceMedium CountryConfidence = "medium"
+	// CountryConfidenceLow is a country name at the end of an unstructured address line
+	CountryConfidenceLow CountryConfidence = "low"
+)
+
+// countryConfidenceRanks orders the confidences from the least to the most certain
+var countryConfidenceRanks = map[CountryConfidence]int{
+	CountryConfidenceLow:    1,
+	CountryConfidenceMedium: 2,
+	CountryConfidenceHigh:   3,
+}
+
+// CountrySource is the kind of field a country is derived from
+type CountrySource string
+
+const (
+	// CountrySourceCountryCode is a country code field, such as the country of a structured address
+	CountrySourceCountryCode CountrySource = "countryCode"
+	// CountrySourceOptionF is the country of a SWIFT option F "3/" line
+	CountrySourceOptionF CountrySource = "optionF"
+	// CountrySourceBIC is the country code of a BIC, its 5th and 6th characters
+	CountrySourceBIC CountrySource = "bic"
+	// CountrySourceIBAN is the country code of an IBAN, its first 2 characters
+	CountrySourceIBAN CountrySource = "iban"
+	// CountrySourceRoutingNumber is a Fed routing number, which is a US financial institution
+	CountrySourceRoutingNumber CountrySource = "routingNumber"
+	// CountrySourceAddressLine is a country name at the end of an address line
+	CountrySourceAddressLine CountrySource = "addressLine"
+)
+
+// CountryEvidence is a country of a party and the field it is derived from
+type CountryEvidence struct {
+	// Role of the party
+	Role PartyRole `json:"role"`
+	// Country is the ISO 3166 alpha-2 country code, e.g. US
+	Country string `json:"country"`
+	// Confidence the country is the country of the party
+	Confidence CountryConfidence `json:"confidence"`
+	// Source is the kind of field the country is derived from
+	Source CountrySource `json:"source"`
+	// Tag of the field, e.g. {7050}
+	Tag string `json:"tag"`
+	// Path is the JSON path of the field, e.g. orderingCustomer.coverPayment.swiftLineFour
+	Path string `json:"path"`
+	// Value the country is derived from
+	Value string `json:"value"`
+}
+
+// PartyCountries is the set of countries of a party role
+type PartyCountries struct {
+	// Role of the parties
+	Role PartyRole `json:"role"`
+	// Countries holds each country of the role once by country code, with its most confident evidence
+	Countries []CountryEvidence `json:"countries"`
+	// Evidence holds every country found for the role in tag order
+	Evidence []CountryEvidence `json:"evidence"`
+}
+
+// countryRoles are the roles in the order of the PaymentChain
+var countryRoles = []PartyRole{
+	RoleDebtor, RoleDebtorAgent, RoleInstructingAgent, RoleInstructedAgent, RoleIntermediary, RoleCreditorAgent, RoleCreditor,
+}
+
+// countryTagRoles are the party roles of the tags holding countries, which are the tags of the PaymentChain, the
+// {7052}, {7056} and {7057} cover payment institutions and the {8300} and {8350} remittance parties
+var countryTagRoles = map[string]PartyRole{
+	TagOriginator:                    RoleDebtor,
+	TagOriginatorOptionF:             RoleDebtor,
+	TagOrderingCustomer:              RoleDebtor,
+	TagRemittanceOriginator:          RoleDebtor,
+	TagOriginatorFI:                  RoleDebtorAgent,
+	TagOrderingInstitution:           RoleDebtorAgent,
+	TagInstructingFI:                 RoleInstructingAgent,
+	TagSenderDepositoryInstitution:   RoleInstructingAgent,
+	TagReceiverDepositoryInstitution: RoleInstructedAgent,
+	TagBeneficiaryIntermediaryFI:     RoleIntermediary,
+	TagIntermediaryInstitution:       RoleIntermediary,
+	TagBeneficiaryFI:                 RoleCreditorAgent,
+	TagInstitutionAccount:            RoleCreditorAgent,
+	TagBeneficiary:                   RoleCreditor,
+	TagBeneficiaryCustomer:           RoleCreditor,
+	TagRemittanceBeneficiary:         RoleCreditor,
+}
+
+// countryNameAliases are names of countries in addresses besides their English names
+var countryNameAliases = map[string]string{
+	"USA":                      "US",
+	"UNITED STATES OF AMERICA": "US",
+	"UK":                       "GB",
+	"GREAT BRITAIN":            "GB",
+	"RUSSIAN FEDERATION":       "RU",
+	"BURMA":                    "MM",
+	"DPRK":                     "KP",
+	"UAE":                      "AE",
+	"UNITED ARAB EMIRATES":     "AE",
+}
+
+// countryNames holds the ISO 3166 alpha-2 code of each normalized country name
+var countryNames = newCountryNames()
+
+// newCountryNames returns the codes of the English names of the countries, and of their countryNameAliases
+func newCountryNames() map[string]string {
+	names := make(map[string]string)
+	for a := 'A'; a <= 'Z'; a++ {
+		for b := 'A'; b <= 'Z'; b++ {
+			code := string([]rune{a, b})
+			if isCountryCode(code) {
+				r, _ := language.ParseRegion(code)
+				names[normalizeScreeningName(display.English.Regions().Name(r))] = code
+			}
+		}
+	}
+	for name, code := range countryNameAliases {
+		names[name] = code
+	}
+	return names
+}
+
+// countryExceptionalCodes are the codes reserved by ISO 3166 for territories which are not countries
+var countryExceptionalCodes = map[string]bool{
+	"AC": true, "CP": true, "DG": true, "EA": true, "IC": true, "TA": true,
+}
+
+// isCountryCode returns true for a current ISO 3166 alpha-2 country code in upper case
+func isCountryCode(code string) bool {
+	if len(code) != 2 || code != strings.ToUpper(code) || countryExceptionalCodes[code] {
+		return false
+	}
+	r, err := language.ParseRegion(code)
+	return err == nil && r.IsCountry() && r.String() == code && r.Canonicalize().String() == code
+}
+
+// Countries returns the set of countries of each party role of the message in the order of the PaymentChain,
+// derived from:
+//   - the country code fields of the remittance tags and the "3/" lines of option F parties
+//   - the 5th and 6th characters of BICs
+//   - the first 2 characters of IBAN account numbers, when the IBAN check digits are valid
+//   - Fed routing numbers, which are US financial institutions
+//   - the English name of a country at the end of an address line
+//
+// The BIC, IBAN or routing number of a debtor or creditor is where their account is held, so it has medium confidence.
+// Roles without a country are left out.
+func (fwm *FEDWireMessage) Countries() []PartyCountries {
+	byRole := make(map[PartyRole][]CountryEvidence)
+	for _, e := range fwm.countryEvidence() {
+		byRole[e.Role] = append(byRole[e.Role], e)
+	}
+
+	var countries []PartyCountries
+	for _, role := range countryRoles {
+		evidence := byRole[role]
+		if len(evidence) == 0 {
+			continue
+		}
+		best := make(map[string]CountryEvidence)
+		for _, e := range evidence {
+			if b, ok := best[e.Country]; !ok || countryConfidenceRanks[e.Confidence] > countryConfidenceRanks[b.Confidence] {
+				best[e.Country] = e
+			}
+		}
+		pc := PartyCountries{Role: role, Evidence: evidence}
+		for _, e := range best {
+			pc.Countries = append(pc.Countries, e)
+		}
+		sort.Slice(pc.Countries, func(i, j int) bool { return pc.Countries[i].Country < pc.Countries[j].Country })
+		countries = append(countries, pc)
+	}
+	return countries
+}
+
+// countryEvidence returns the countries of the ScreeningValues and of the identifiers of the parties
+func (fwm *FEDWireMessage) countryEvidence() []CountryEvidence {
+	var evidence []CountryEvidence
+	add := func(role PartyRole, country string, confidence CountryConfidence, source CountrySource, tag, path, value string) {
+		evidence = append(evidence, CountryEvidence{
+			Role: role, Country: country, Confidence: confidence, Source: source, Tag: tag, Path: path, Value: value,
+		})
+	}
+
+	for _, v := range fwm.ScreeningValues() {
+		role, ok := countryTagRoles[v.Tag]
+		if !ok {
+			continue
+		}
+		switch v.Kind {
+		case ScreeningCountry:
+			source := CountrySourceCountryCode
+			if v.Tag == TagOriginatorOptionF || strings.Contains(v.Path, ".swiftLine") {
+				source = CountrySourceOptionF
+			}
+			if code := strings.ToUpper(v.Value); isCountryCode(code) {
+				add(role, code, CountryConfidenceHigh, source, v.Tag, v.Path, v.Value)
+			}
+		case ScreeningBIC:
+			if len(v.Value) >= 6 && isCountryCode(strings.ToUpper(v.Value[4:6])) {
+				add(role, strings.ToUpper(v.Value[4:6]), countryIdentifierConfidence(role), CountrySourceBIC, v.Tag, v.Path, v.Value)
+			}
+		case ScreeningAddress:
+			if code := countryOfAddressLine(v.Value); code != "" {
+				add(role, code, CountryConfidenceLow, CountrySourceAddressLine, v.Tag, v.Path, v.Value)
+			}
+		}
+	}
+
+	for _, p := range fwm.countryParties() {
+		identifier := strings.TrimSpace(p.Identifier)
+		if identifier == "" {
+			continue
+		}
+		if p.IdentificationCode == FEDRoutingNumber {
+			add(p.Role, "US", countryIdentifierConfidence(p.Role), CountrySourceRoutingNumber, p.Tag, fwm.countryPath(p.Tag, identifier), identifier)
+			continue
+		}
+		// a BIC and account identifier is the BIC, a slash and the account
+		account := identifier[strings.LastIndex(identifier, "/")+1:]
+		if iban := strings.ToUpper(strings.Replace(account, " ", "", -1)); isIBAN(iban) {
+			add(p.Role, iban[:2], CountryConfidenceMedium, CountrySourceIBAN, p.Tag, fwm.countryPath(p.Tag, account), account)
+		}
+	}
+
+	sort.SliceStable(evidence, func(i, j int) bool {
+		if ri, rj := countryRoleIndex(evidence[i].Role), countryRoleIndex(evidence[j].Role); ri != rj {
+			return ri < rj
+		}
+		return evidence[i].Tag < evidence[j].Tag
+	})
+	return evidence
+}
+
+// countryParties returns the PaymentChain with the {7052}, {7056} and {7057} cover payment institutions
+func (fwm *FEDWireMessage) countryParties() []Party {
+	parties := fwm.PaymentChain()
+	if oi := fwm.OrderingInstitution; oi != nil {
+		parties = appendSwiftParty(parties, RoleDebtorAgent, TagOrderingInstitution, oi.CoverPayment.SwiftFieldTag, coverPaymentLines(oi.CoverPayment))
+	}
+	if ii := fwm.IntermediaryInstitution; ii != nil {
+		parties = appendSwiftParty(parties, RoleIntermediary, TagIntermediaryInstitution, ii.CoverPayment.SwiftFieldTag, coverPaymentLines(ii.CoverPayment))
+	}
+	if ia := fwm.InstitutionAccount; ia != nil {
+		parties = appendSwiftParty(parties, RoleCreditorAgent, TagInstitutionAccount, ia.CoverPayment.SwiftFieldTag, coverPaymentLines(ia.CoverPayment))
+	}
+	return parties
+}
+
+// countryPath returns the path of the first field of tag holding value
+func (fwm *FEDWireMessage) countryPath(tag, value string) string {
+	for _, info := range fieldInfos {
+		if info.Tag != tag {
+			continue
+		}
+		if v, _ := fwm.Get(info.Path); strings.Contains(v, value) {
+			return info.Path
+		}
+	}
+	return ""
+}
+
+// countryIdentifierConfidence returns the confidence of the country of a BIC or routing number identifying a party
+func countryIdentifierConfidence(role PartyRole) CountryConfidence {
+	if role == RoleDebtor || role == RoleCreditor {
+		return CountryConfidenceMedium
+	}
+	return CountryConfidenceHigh
+}
+
+// countryRoleIndex returns the index of role in countryRoles
+func countryRoleIndex(role PartyRole) int {
+	for i := range countryRoles {
+		if countryRoles[i] == role {
+			return i
+		}
+	}
+	return len(countryRoles)
+}
+
+// countryOfAddressLine returns the code of the longest country name the address line ends with, or ""
+func countryOfAddressLine(line string) string {
+	tokens := strings.Fields(normalizeScreeningName(line))
+	for i := range tokens {
+		if code, ok := countryNames[strings.Join(tokens[i:], " ")]; ok {
+			return code
+		}
+	}
+	return ""
+}
+
+// isIBAN returns true for an IBAN in upper case without spaces whose country code and check digits are valid
+func isIBAN(s string) bool {
+	if len(s) < 15 || len(s) > 34 || !isCountryCode(s[:2]) || s[2] < '0' || s[2] > '9' || s[3] < '0' || s[3] > '9' {
+		return false
+	}
+	// the check digits are valid when the IBAN, with its first 4 characters moved to the end and each letter
+	// replaced by 10 to 35, is 1 modulo 97
+	var digits strings.Builder
+	for _, r := range s[4:] + s[:4] {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r >= 'A' && r <= 'Z':
+			digits.WriteString(strconv.Itoa(int(r-'A') + 10))
+		default:
+			return false
+		}
+	}
+	n, ok := new(big.Int).SetString(digits.String(), 10)
+	return ok && n.Mod(n, big.NewInt(97)).Int64() == 1
+}
+
+// JurisdictionRisk is the risk of a country of a message
+type JurisdictionRisk string
+
+const (
+	// JurisdictionRestricted is a country payments must not be made to or from, e.g. under comprehensive sanctions
+	JurisdictionRestricted JurisdictionRisk = "restricted"
+	// JurisdictionHighRisk is a country which calls for enhanced due diligence
+	JurisdictionHighRisk JurisdictionRisk = "highRisk"
+)
+
+// JurisdictionOptions are the lists of countries CheckJurisdictions flags. No countries are listed by default, as
+// the lists are set by each institution's compliance policy.
+type JurisdictionOptions struct {
+	// Restricted are the ISO 3166 alpha-2 codes of the restricted countries
+	Restricted []string `json:"restricted,omitempty"`
+	// HighRisk are the ISO 3166 alpha-2 codes of the high-risk countries
+	HighRisk []string `json:"highRisk,omitempty"`
+	// MinConfidence is the lowest confidence of a country which is flagged, which is CountryConfidenceLow when ""
+	MinConfidence CountryConfidence `json:"minConfidence,omitempty"`
+}
+
+// JurisdictionFinding is a country of a party role which is on a list of JurisdictionOptions
+type JurisdictionFinding struct {
+	CountryEvidence
+	// Risk of the country, which is restricted when a country is on both lists
+	Risk JurisdictionRisk `json:"risk"`
+}
+
+// CheckJurisdictions returns a finding for each country of each party role of the message which is restricted or
+// high-risk, in the order of Countries, with the most confident evidence of the country
+func (fwm *FEDWireMessage) CheckJurisdictions(opts JurisdictionOptions) []JurisdictionFinding {
+	risks := make(map[string]JurisdictionRisk)
+	for _, code := range opts.HighRisk {
+		risks[strings.ToUpper(strings.TrimSpace(code))] = JurisdictionHighRisk
+	}
+	for _, code := range opts.Restricted {
+		risks[strings.ToUpper(strings.TrimSpace(code))] = JurisdictionRestricted
+	}
+	min := countryConfidenceRanks[opts.MinConfidence]
+
+	var findings []JurisdictionFinding
+	for _, pc := range fwm.Countries() {
+		for _, e := range pc.Countries {
+			if risk, ok := risks[e.Country]; ok && countryConfidenceRanks[e.Confidence] >= min {
+				findings = append(findings, JurisdictionFinding{CountryEvidence: e, Risk: risk})
+			}
+		}
+	}
+	return findings
+}
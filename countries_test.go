@@ -0,0 +1,122 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// countriesOf returns the country set of role
+func countriesOf(fwm *FEDWireMessage, role PartyRole) []CountryEvidence {
+	for _, pc := range fwm.Countries() {
+		if pc.Role == role {
+			return pc.Countries
+		}
+	}
+	return nil
+}
+
+func TestFEDWireMessage_Countries(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.txt")
+
+	var roles []PartyRole
+	for _, pc := range fwm.Countries() {
+		roles = append(roles, pc.Role)
+	}
+	require.Equal(t, countryRoles, roles)
+
+	// {7050} 50F*TXID/123-45-6789*1/Jane Doe*2/1000 Colonial Farm Rd*3/US/Pottstown*4/19800101*
+	require.Equal(t, []CountryEvidence{{
+		Role: RoleDebtor, Country: "US", Confidence: CountryConfidenceHigh, Source: CountrySourceOptionF,
+		Tag: TagOrderingCustomer, Path: "orderingCustomer.coverPayment.swiftLineFour", Value: "US",
+	}}, countriesOf(&fwm, RoleDebtor))
+
+	// {3100}121042882Wells Fargo NA
+	require.Equal(t, []CountryEvidence{{
+		Role: RoleInstructingAgent, Country: "US", Confidence: CountryConfidenceHigh, Source: CountrySourceRoutingNumber,
+		Tag: TagSenderDepositoryInstitution, Path: "senderDepositoryInstitution.senderABANumber", Value: "121042882",
+	}}, countriesOf(&fwm, RoleInstructingAgent))
+
+	// {7057} 57A*/123456789*DEUTDEFF*
+	require.Equal(t, []CountryEvidence{{
+		Role: RoleCreditorAgent, Country: "DE", Confidence: CountryConfidenceHigh, Source: CountrySourceBIC,
+		Tag: TagInstitutionAccount, Path: "institutionAccount.coverPayment.swiftLineTwo", Value: "DEUTDEFF",
+	}}, countriesOf(&fwm, RoleCreditorAgent))
+
+	// {7059} 59*/DE89370400440532013000*John Doe*Taunusanlage 12*Frankfurt am Main*
+	require.Equal(t, []CountryEvidence{{
+		Role: RoleCreditor, Country: "DE", Confidence: CountryConfidenceMedium, Source: CountrySourceIBAN,
+		Tag: TagBeneficiaryCustomer, Path: "beneficiaryCustomer.coverPayment.swiftLineOne", Value: "DE89370400440532013000",
+	}}, countriesOf(&fwm, RoleCreditor))
+
+	// an address line naming a country, and the most confident evidence of each country
+	fwm.Beneficiary.Personal.Address.AddressLineThree = "Frankfurt am Main, Germany"
+	fwm.Beneficiary.Personal.Address.AddressLineTwo = "Zurich Switzerland"
+	creditor := countriesOf(&fwm, RoleCreditor)
+	require.Len(t, creditor, 2)
+	require.Equal(t, "CH", creditor[0].Country)
+	require.Equal(t, CountryConfidenceLow, creditor[0].Confidence)
+	require.Equal(t, CountrySourceAddressLine, creditor[0].Source)
+	require.Equal(t, "beneficiary.personal.address.addressLineTwo", creditor[0].Path)
+	require.Equal(t, CountrySourceIBAN, creditor[1].Source)
+	for _, pc := range fwm.Countries() {
+		if pc.Role == RoleCreditor {
+			require.Len(t, pc.Evidence, 3)
+		}
+	}
+
+	// an IBAN with wrong check digits is not a country
+	fwm.BeneficiaryCustomer.CoverPayment.SwiftLineOne = "/DE88370400440532013000"
+	require.Len(t, countriesOf(&fwm, RoleCreditor), 2)
+
+	require.Empty(t, (&FEDWireMessage{}).Countries())
+}
+
+func TestCountryHelpers(t *testing.T) {
+	require.True(t, isCountryCode("DE"))
+	require.True(t, isCountryCode("KP"))
+	require.False(t, isCountryCode("UK"))
+	require.False(t, isCountryCode("EU"))
+	require.False(t, isCountryCode("de"))
+
+	require.True(t, isIBAN("GB82WEST12345698765432"))
+	require.False(t, isIBAN("GB82WEST12345698765433"))
+	require.False(t, isIBAN("123456789"))
+
+	require.Equal(t, "KP", countryOfAddressLine("Pyongyang, North Korea"))
+	require.Equal(t, "US", countryOfAddressLine("New York NY 10001 USA"))
+	require.Equal(t, "PG", countryOfAddressLine("Port Moresby Papua New Guinea"))
+	require.Equal(t, "", countryOfAddressLine("San Francisco CA"))
+}
+
+func TestFEDWireMessage_CheckJurisdictions(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.txt")
+	require.Empty(t, fwm.CheckJurisdictions(JurisdictionOptions{}))
+
+	fwm.OrderingCustomer.CoverPayment.SwiftLineFour = "3/IR/Tehran"
+	fwm.BeneficiaryFI.FinancialInstitution.IdentificationCode = SWIFTBankIdentifierCode
+	fwm.BeneficiaryFI.FinancialInstitution.Identifier = "EXTBIRTH"
+	fwm.Beneficiary.Personal.Address.AddressLineThree = "Moscow Russia"
+
+	findings := fwm.CheckJurisdictions(JurisdictionOptions{Restricted: []string{"ir"}, HighRisk: []string{"RU", "IR"}})
+	require.Len(t, findings, 3)
+	require.Equal(t, JurisdictionFinding{
+		CountryEvidence: CountryEvidence{
+			Role: RoleDebtor, Country: "IR", Confidence: CountryConfidenceHigh, Source: CountrySourceOptionF,
+			Tag: TagOrderingCustomer, Path: "orderingCustomer.coverPayment.swiftLineFour", Value: "IR",
+		},
+		Risk: JurisdictionRestricted,
+	}, findings[0])
+	require.Equal(t, RoleCreditorAgent, findings[1].Role)
+	require.Equal(t, CountrySourceBIC, findings[1].Source)
+	require.Equal(t, JurisdictionHighRisk, findings[2].Risk)
+	require.Equal(t, "RU", findings[2].Country)
+
+	// address lines are left out above low confidence
+	findings = fwm.CheckJurisdictions(JurisdictionOptions{HighRisk: []string{"RU", "IR"}, MinConfidence: CountryConfidenceMedium})
+	require.Len(t, findings, 2)
+}
@@ -0,0 +1,254 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/moov-io/base"
+)
+
+// csvColumn is a CSV column and the index path of the FEDWireMessage field it holds
+type csvColumn struct {
+	name  string
+	index []int
+}
+
+// csvColumns holds every column of a FEDWireMessage in tag order
+var csvColumns = csvColumnsOf(reflect.TypeOf(FEDWireMessage{}), "", nil)
+
+// csvColumnsOf returns the columns of every exported string field of t, named by the JSON path of the field
+func csvColumnsOf(t reflect.Type, prefix string, index []int) []csvColumn {
+	var columns []csvColumn
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		path := append(append([]int{}, index...), i)
+
+		ft := field.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		switch ft.Kind() {
+		case reflect.Struct:
+			columns = append(columns, csvColumnsOf(ft, prefix+name+".", path)...)
+		case reflect.String:
+			columns = append(columns, csvColumn{name: prefix + name, index: path})
+		}
+	}
+	return columns
+}
+
+// CSVColumns returns the header written by CSVWriter and accepted by CSVReader.
+//
+// There is one column for every field of every tag in FEDWireMessage, in tag order. Each column is named by the
+// JSON path of its field, e.g. "amount.amount", "beneficiary.personal.name" or
+// "orderingCustomer.coverPayment.swiftLineOne".
+func CSVColumns() []string {
+	names := make([]string, len(csvColumns))
+	for i := range csvColumns {
+		names[i] = csvColumns[i].name
+	}
+	return names
+}
+
+// csvValue returns the value of the column in v, or "" when a tag along the path is nil
+func csvValue(v reflect.Value, index []int) string {
+	for _, i := range index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return ""
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v.String()
+}
+
+// CSVWriter writes FEDWireMessages as the rows of a CSV file with a header of CSVColumns
+type CSVWriter struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+// NewCSVWriter returns a new CSVWriter that writes to w.
+func NewCSVWriter(w io.Writer) *CSVWriter {
+	return &CSVWriter{
+		w: csv.NewWriter(w),
+	}
+}
+
+// Write validates and writes each FEDWireMessage as a row, writing the header before the first row
+func (w *CSVWriter) Write(messages ...FEDWireMessage) error {
+	if !w.wroteHeader {
+		if err := w.w.Write(CSVColumns()); err != nil {
+			return err
+		}
+		w.wroteHeader = true
+	}
+	for i := range messages {
+		if err := messages[i].Validate(); err != nil {
+			return err
+		}
+		v := reflect.ValueOf(messages[i])
+		row := make([]string, len(csvColumns))
+		for j := range csvColumns {
+			row[j] = csvValue(v, csvColumns[j].index)
+		}
+		if err := w.w.Write(row); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// Flush writes any buffered data to the underlying io.Writer.
+func (w *CSVWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+// CSVReader reads FEDWireMessages from the rows of a CSV file.
+//
+// The header may hold any subset of CSVColumns in any order. A tag is left nil when all of its columns are blank.
+type CSVReader struct {
+	r *csv.Reader
+	// lineNum is the line number of the row being parsed
+	lineNum int
+	// errors holds each error encountered when attempting to parse the rows
+	errors base.ErrorList
+}
+
+// NewCSVReader returns a new CSVReader that reads from r.
+func NewCSVReader(r io.Reader) *CSVReader {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	return &CSVReader{
+		r: reader,
+	}
+}
+
+// Read reads every row and returns a validated FEDWireMessage for each row which parses and validates.
+//
+// Rows with errors are skipped and their errors are returned in a base.ErrorList of base.ParseError, where
+// Line is the line number of the row.
+func (r *CSVReader) Read() ([]FEDWireMessage, error) {
+	r.lineNum = 1
+	header, err := r.r.Read()
+	if err != nil {
+		return nil, r.parseError("header", err)
+	}
+	columns, err := r.parseHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []FEDWireMessage
+	for {
+		row, err := r.r.Read()
+		if err == io.EOF {
+			break
+		}
+		r.lineNum++
+		if err != nil {
+			r.errors.Add(r.parseError("FEDWireMessage", err))
+			continue
+		}
+		fwm, err := r.parseRow(columns, row)
+		if err != nil {
+			r.errors.Add(err)
+			continue
+		}
+		messages = append(messages, fwm)
+	}
+	if r.errors.Empty() {
+		return messages, nil
+	}
+	return messages, r.errors
+}
+
+// parseError returns a new ParseError for the current row
+func (r *CSVReader) parseError(record string, err error) error {
+	return &base.ParseError{
+		Line:   r.lineNum,
+		Record: record,
+		Err:    err,
+	}
+}
+
+// parseHeader returns the JSON path of each column in the header
+func (r *CSVReader) parseHeader(header []string) ([][]string, error) {
+	known := make(map[string]bool, len(csvColumns))
+	for i := range csvColumns {
+		known[csvColumns[i].name] = true
+	}
+	seen := make(map[string]bool, len(header))
+	columns := make([][]string, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if !known[name] {
+			return nil, r.parseError(name, fieldError("column", ErrCSVColumn, name))
+		}
+		if seen[name] {
+			return nil, r.parseError(name, fieldError("column", ErrCSVDuplicateColumn, name))
+		}
+		seen[name] = true
+		columns[i] = strings.Split(name, ".")
+	}
+	return columns, nil
+}
+
+// parseRow builds a FEDWireMessage from the non-blank columns of a row using each tag's JSON decoding, which
+// sets the tag, and validates it.
+func (r *CSVReader) parseRow(columns [][]string, row []string) (FEDWireMessage, error) {
+	fwm := FEDWireMessage{}
+	if len(row) != len(columns) {
+		return fwm, r.parseError("FEDWireMessage", fmt.Errorf("has %d columns, expected %d", len(row), len(columns)))
+	}
+
+	data := make(map[string]interface{})
+	for i, path := range columns {
+		if strings.TrimSpace(row[i]) == "" {
+			continue
+		}
+		node := data
+		for _, key := range path[:len(path)-1] {
+			child, ok := node[key].(map[string]interface{})
+			if !ok {
+				child = make(map[string]interface{})
+				node[key] = child
+			}
+			node = child
+		}
+		node[path[len(path)-1]] = row[i]
+	}
+
+	bs, err := json.Marshal(data)
+	if err != nil {
+		return fwm, r.parseError("FEDWireMessage", err)
+	}
+	if err := json.Unmarshal(bs, &fwm); err != nil {
+		return fwm, r.parseError("FEDWireMessage", err)
+	}
+	if err := fwm.Validate(); err != nil {
+		return fwm, r.parseError("FEDWireMessage", err)
+	}
+	return fwm, nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCSVColumns ensures the CSV header names every field of every tag once and is documented
+func TestCSVColumns(t *testing.T) {
+	columns := CSVColumns()
+	require.Equal(t, "id", columns[0])
+	require.Contains(t, columns, "senderSupplied.formatVersion")
+	require.Contains(t, columns, "beneficiary.personal.name")
+	require.Contains(t, columns, "beneficiary.personal.address.addressLineThree")
+	require.Contains(t, columns, "orderingCustomer.coverPayment.swiftLineOne")
+	require.Equal(t, "serviceMessage.lineTwelve", columns[len(columns)-1])
+
+	bs, err := ioutil.ReadFile(filepath.Join("docs", "csv.md"))
+	require.NoError(t, err)
+	seen := make(map[string]bool)
+	for _, column := range columns {
+		require.False(t, seen[column], column)
+		seen[column] = true
+		require.Contains(t, string(bs), "| `"+column+"` |", "docs/csv.md does not document %s", column)
+	}
+}
+
+// TestCSV_RoundTrip writes several messages into one CSV file and reads them back
+func TestCSV_RoundTrip(t *testing.T) {
+	messages := []FEDWireMessage{
+		readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt"),
+		readFEDWireMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.txt"),
+		readFEDWireMessage(t, "fedWireMessage-BankTransfer.txt"),
+	}
+
+	var buf bytes.Buffer
+	w := NewCSVWriter(&buf)
+	require.NoError(t, w.Write(messages[0]))
+	require.NoError(t, w.Write(messages[1:]...))
+	require.Equal(t, strings.Join(CSVColumns(), ",")+"\n", strings.SplitAfter(buf.String(), "\n")[0])
+
+	read, err := NewCSVReader(&buf).Read()
+	require.NoError(t, err)
+	require.Len(t, read, len(messages))
+	for i := range messages {
+		require.Equal(t, messages[i], read[i])
+	}
+	require.Nil(t, read[2].Charges)
+	require.Nil(t, read[2].OrderingCustomer)
+}
+
+// TestCSVReader_Columns reads a subset of columns in any order, leaving tags with blank columns nil
+func TestCSVReader_Columns(t *testing.T) {
+	bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-BankTransfer.csv"))
+	require.NoError(t, err)
+
+	read, err := NewCSVReader(bytes.NewReader(bs)).Read()
+	require.NoError(t, err)
+	require.Len(t, read, 2)
+	require.Equal(t, "000000001000", read[0].Amount.Amount)
+	require.Equal(t, TagAmount, read[0].Amount.tag)
+	require.Equal(t, "Citadel", read[0].Beneficiary.Personal.Name)
+	require.Nil(t, read[0].BeneficiaryReference)
+	require.Nil(t, read[1].Beneficiary)
+	require.Equal(t, "Reference", read[1].BeneficiaryReference.BeneficiaryReference)
+}
+
+// TestCSVReader_RowErrors reports errors by row while returning the rows which validate
+func TestCSVReader_RowErrors(t *testing.T) {
+	bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-BankTransfer.csv"))
+	require.NoError(t, err)
+	lines := strings.Split(string(bs), "\n")
+	lines[1] = strings.Replace(lines[1], "000000001000", "00000000Z000", 1)
+	lines = append(lines[:3], "too,few")
+
+	read, err := NewCSVReader(strings.NewReader(strings.Join(lines, "\n"))).Read()
+	require.Len(t, read, 1)
+	var list base.ErrorList
+	require.True(t, errors.As(err, &list))
+	require.Len(t, list, 2)
+
+	var pe *base.ParseError
+	require.True(t, errors.As(list[0], &pe))
+	require.Equal(t, 2, pe.Line)
+	require.True(t, errors.Is(pe.Err, ErrNonAmount))
+	require.True(t, errors.As(list[1], &pe))
+	require.Equal(t, 4, pe.Line)
+}
+
+// TestCSVReader_Header rejects unknown and duplicate columns
+func TestCSVReader_Header(t *testing.T) {
+	_, err := NewCSVReader(strings.NewReader("id,beneficiary.name\n")).Read()
+	require.True(t, errors.Is(err, ErrCSVColumn))
+
+	_, err = NewCSVReader(strings.NewReader("id,amount.amount,amount.amount\n")).Read()
+	require.True(t, errors.Is(err, ErrCSVDuplicateColumn))
+
+	_, err = NewCSVReader(strings.NewReader("")).Read()
+	require.Error(t, err)
+}
+
+// TestCSVWriter_Invalid does not write messages which do not validate
+func TestCSVWriter_Invalid(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-BankTransfer.txt")
+	fwm.Amount.Amount = "Z"
+
+	var buf bytes.Buffer
+	require.True(t, errors.Is(NewCSVWriter(&buf).Write(fwm), ErrNonAmount))
+}
@@ -108,3 +108,21 @@ func (cia *CurrencyInstructedAmount) SwiftFieldTagField() string {
 func (cia *CurrencyInstructedAmount) AmountField() string {
 	return cia.numericStringField(cia.Amount, 15)
 }
+
+// Money returns the currency instructed amount, e.g. USD and 1234,56 is USD 1234.56
+func (cia *CurrencyInstructedAmount) Money() (Money, error) {
+	return ParseMoney(cia.CurrencyCode, cia.Amount)
+}
+
+// SetMoney sets CurrencyCode and Amount, formatting Amount with a comma decimal marker, e.g. 1234,56
+func (cia *CurrencyInstructedAmount) SetMoney(m Money) error {
+	if err := cia.isCurrencyCode(m.Currency); err != nil {
+		return fieldError("CurrencyCode", err, m.Currency)
+	}
+	amount, err := m.wireAmount(15)
+	if err != nil {
+		return err
+	}
+	cia.CurrencyCode, cia.Amount = m.Currency, amount
+	return nil
+}
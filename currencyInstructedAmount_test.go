@@ -1,6 +1,7 @@
 package wire
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
@@ -67,3 +68,23 @@ func TestCurrencyInstructedAmountTagError(t *testing.T) {
 
 	require.EqualError(t, err, fieldError("tag", ErrValidTagForType, cia.tag).Error())
 }
+
+// TestCurrencyInstructedAmountMoney converts the currency instructed amount to and from Money
+func TestCurrencyInstructedAmountMoney(t *testing.T) {
+	cia := mockCurrencyInstructedAmount()
+	m, err := cia.Money()
+	require.NoError(t, err)
+	require.Equal(t, "USD 1500.49", m.String())
+
+	require.NoError(t, cia.SetMoney(NewMoney("EUR", 100)))
+	require.Equal(t, "EUR", cia.CurrencyCode)
+	require.Equal(t, "1,00", cia.Amount)
+	require.NoError(t, cia.Validate())
+
+	cia.Amount = cia.AmountField()
+	m, err = cia.Money()
+	require.NoError(t, err)
+	require.Equal(t, NewMoney("EUR", 100), m)
+
+	require.True(t, errors.Is(cia.SetMoney(NewMoney("EUR", -1)), ErrMoneyRange))
+}
@@ -0,0 +1,197 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// CustomerTransferBuilder builds a CTR customer transfer, exposing only the tags a customer transfer permits
+type CustomerTransferBuilder struct {
+	messageBuilder
+}
+
+// NewCustomerTransferBuilder returns a builder of a CTR customer transfer with a default {1500} SenderSupplied
+// and {1510} TypeSubType 1000, a basic funds transfer. Beneficiary and Originator are mandatory.
+func NewCustomerTransferBuilder() *CustomerTransferBuilder {
+	b := &CustomerTransferBuilder{newMessageBuilder(CustomerTransfer, FundsTransfer, BasicFundsTransfer)}
+	return b
+}
+
+// SenderSupplied sets {1500} SenderSupplied
+func (b *CustomerTransferBuilder) SenderSupplied(tag *SenderSupplied) *CustomerTransferBuilder {
+	CloneTag(&b.fwm.SenderSupplied, tag)
+	return b
+}
+
+// UserRequestCorrelation sets the UserRequestCorrelation of {1500} SenderSupplied
+func (b *CustomerTransferBuilder) UserRequestCorrelation(id string) *CustomerTransferBuilder {
+	b.setUserRequestCorrelation(id)
+	return b
+}
+
+// TypeSubType sets {1510} TypeSubType to typeCode and subTypeCode
+func (b *CustomerTransferBuilder) TypeSubType(typeCode, subTypeCode string) *CustomerTransferBuilder {
+	b.setTypeSubType(typeCode, subTypeCode)
+	return b
+}
+
+// IMAD sets {1520} InputMessageAccountabilityData
+func (b *CustomerTransferBuilder) IMAD(tag *InputMessageAccountabilityData) *CustomerTransferBuilder {
+	CloneTag(&b.fwm.InputMessageAccountabilityData, tag)
+	return b
+}
+
+// Amount sets {2000} Amount in cents
+func (b *CustomerTransferBuilder) Amount(cents int64) *CustomerTransferBuilder {
+	b.setAmount(cents)
+	return b
+}
+
+// Sender sets {3100} SenderDepositoryInstitution to the ABA routing number and short name of the sender
+func (b *CustomerTransferBuilder) Sender(aba, shortName string) *CustomerTransferBuilder {
+	b.setSender(aba, shortName)
+	return b
+}
+
+// Receiver sets {3400} ReceiverDepositoryInstitution to the ABA routing number and short name of the receiver
+func (b *CustomerTransferBuilder) Receiver(aba, shortName string) *CustomerTransferBuilder {
+	b.setReceiver(aba, shortName)
+	return b
+}
+
+// SenderReference sets {3320} SenderReference
+func (b *CustomerTransferBuilder) SenderReference(ref string) *CustomerTransferBuilder {
+	b.setSenderReference(ref)
+	return b
+}
+
+// PreviousMessageIdentifier sets {3500} PreviousMessageIdentifier to the IMAD of the previous message
+func (b *CustomerTransferBuilder) PreviousMessageIdentifier(imad string) *CustomerTransferBuilder {
+	b.setPreviousMessageIdentifier(imad)
+	return b
+}
+
+// Charges sets {3700} Charges
+func (b *CustomerTransferBuilder) Charges(tag *Charges) *CustomerTransferBuilder {
+	CloneTag(&b.fwm.Charges, tag)
+	return b
+}
+
+// InstructedAmount sets {3710} InstructedAmount
+func (b *CustomerTransferBuilder) InstructedAmount(tag *InstructedAmount) *CustomerTransferBuilder {
+	CloneTag(&b.fwm.InstructedAmount, tag)
+	return b
+}
+
+// ExchangeRate sets {3720} ExchangeRate
+func (b *CustomerTransferBuilder) ExchangeRate(tag *ExchangeRate) *CustomerTransferBuilder {
+	CloneTag(&b.fwm.ExchangeRate, tag)
+	return b
+}
+
+// BeneficiaryIntermediaryFI sets {4000} BeneficiaryIntermediaryFI
+func (b *CustomerTransferBuilder) BeneficiaryIntermediaryFI(fi FinancialInstitution) *CustomerTransferBuilder {
+	b.setBeneficiaryIntermediaryFI(fi)
+	return b
+}
+
+// BeneficiaryFI sets {4100} BeneficiaryFI
+func (b *CustomerTransferBuilder) BeneficiaryFI(fi FinancialInstitution) *CustomerTransferBuilder {
+	b.setBeneficiaryFI(fi)
+	return b
+}
+
+// Beneficiary sets {4200} Beneficiary
+func (b *CustomerTransferBuilder) Beneficiary(p Personal) *CustomerTransferBuilder {
+	b.setBeneficiary(p)
+	return b
+}
+
+// BeneficiaryReference sets {4320} BeneficiaryReference
+func (b *CustomerTransferBuilder) BeneficiaryReference(ref string) *CustomerTransferBuilder {
+	b.setBeneficiaryReference(ref)
+	return b
+}
+
+// Originator sets {5000} Originator
+func (b *CustomerTransferBuilder) Originator(p Personal) *CustomerTransferBuilder {
+	b.setOriginator(p)
+	return b
+}
+
+// OriginatorFI sets {5100} OriginatorFI
+func (b *CustomerTransferBuilder) OriginatorFI(fi FinancialInstitution) *CustomerTransferBuilder {
+	b.setOriginatorFI(fi)
+	return b
+}
+
+// InstructingFI sets {5200} InstructingFI
+func (b *CustomerTransferBuilder) InstructingFI(fi FinancialInstitution) *CustomerTransferBuilder {
+	b.setInstructingFI(fi)
+	return b
+}
+
+// OriginatorToBeneficiary sets {6000} OriginatorToBeneficiary
+func (b *CustomerTransferBuilder) OriginatorToBeneficiary(tag *OriginatorToBeneficiary) *CustomerTransferBuilder {
+	CloneTag(&b.fwm.OriginatorToBeneficiary, tag)
+	return b
+}
+
+// FIReceiverFI sets {6100} FIReceiverFI
+func (b *CustomerTransferBuilder) FIReceiverFI(tag *FIReceiverFI) *CustomerTransferBuilder {
+	CloneTag(&b.fwm.FIReceiverFI, tag)
+	return b
+}
+
+// FIIntermediaryFI sets {6200} FIIntermediaryFI
+func (b *CustomerTransferBuilder) FIIntermediaryFI(tag *FIIntermediaryFI) *CustomerTransferBuilder {
+	CloneTag(&b.fwm.FIIntermediaryFI, tag)
+	return b
+}
+
+// FIIntermediaryFIAdvice sets {6210} FIIntermediaryFIAdvice
+func (b *CustomerTransferBuilder) FIIntermediaryFIAdvice(tag *FIIntermediaryFIAdvice) *CustomerTransferBuilder {
+	CloneTag(&b.fwm.FIIntermediaryFIAdvice, tag)
+	return b
+}
+
+// FIBeneficiaryFI sets {6300} FIBeneficiaryFI
+func (b *CustomerTransferBuilder) FIBeneficiaryFI(tag *FIBeneficiaryFI) *CustomerTransferBuilder {
+	CloneTag(&b.fwm.FIBeneficiaryFI, tag)
+	return b
+}
+
+// FIBeneficiaryFIAdvice sets {6310} FIBeneficiaryFIAdvice
+func (b *CustomerTransferBuilder) FIBeneficiaryFIAdvice(tag *FIBeneficiaryFIAdvice) *CustomerTransferBuilder {
+	CloneTag(&b.fwm.FIBeneficiaryFIAdvice, tag)
+	return b
+}
+
+// FIBeneficiary sets {6400} FIBeneficiary
+func (b *CustomerTransferBuilder) FIBeneficiary(tag *FIBeneficiary) *CustomerTransferBuilder {
+	CloneTag(&b.fwm.FIBeneficiary, tag)
+	return b
+}
+
+// FIBeneficiaryAdvice sets {6410} FIBeneficiaryAdvice
+func (b *CustomerTransferBuilder) FIBeneficiaryAdvice(tag *FIBeneficiaryAdvice) *CustomerTransferBuilder {
+	CloneTag(&b.fwm.FIBeneficiaryAdvice, tag)
+	return b
+}
+
+// FIPaymentMethodToBeneficiary sets {6420} FIPaymentMethodToBeneficiary
+func (b *CustomerTransferBuilder) FIPaymentMethodToBeneficiary(tag *FIPaymentMethodToBeneficiary) *CustomerTransferBuilder {
+	CloneTag(&b.fwm.FIPaymentMethodToBeneficiary, tag)
+	return b
+}
+
+// FIAdditionalFIToFI sets {6500} FIAdditionalFIToFI
+func (b *CustomerTransferBuilder) FIAdditionalFIToFI(tag *FIAdditionalFIToFI) *CustomerTransferBuilder {
+	CloneTag(&b.fwm.FIAdditionalFIToFI, tag)
+	return b
+}
+
+// Build returns the message when it passes Validate, otherwise the errors of the setters and of Validate in a
+// base.ErrorList
+func (b *CustomerTransferBuilder) Build() (*FEDWireMessage, error) {
+	return b.build()
+}
@@ -0,0 +1,338 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// CustomerTransferPlusBuilder builds a CTP customer transfer plus, exposing only the tags a customer transfer plus
+// permits. Which {7xxx} cover payment and {8xxx} remittance tags are mandatory or permitted depends on the
+// LocalInstrument, which Build checks.
+type CustomerTransferPlusBuilder struct {
+	messageBuilder
+}
+
+// NewCustomerTransferPlusBuilder returns a builder of a CTP customer transfer plus with a default {1500}
+// SenderSupplied and {1510} TypeSubType 1000, a basic funds transfer. Beneficiary and Originator or
+// OriginatorOptionF are mandatory.
+func NewCustomerTransferPlusBuilder() *CustomerTransferPlusBuilder {
+	b := &CustomerTransferPlusBuilder{newMessageBuilder(CustomerTransferPlus, FundsTransfer, BasicFundsTransfer)}
+	return b
+}
+
+// SenderSupplied sets {1500} SenderSupplied
+func (b *CustomerTransferPlusBuilder) SenderSupplied(tag *SenderSupplied) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.SenderSupplied, tag)
+	return b
+}
+
+// UserRequestCorrelation sets the UserRequestCorrelation of {1500} SenderSupplied
+func (b *CustomerTransferPlusBuilder) UserRequestCorrelation(id string) *CustomerTransferPlusBuilder {
+	b.setUserRequestCorrelation(id)
+	return b
+}
+
+// TypeSubType sets {1510} TypeSubType to typeCode and subTypeCode
+func (b *CustomerTransferPlusBuilder) TypeSubType(typeCode, subTypeCode string) *CustomerTransferPlusBuilder {
+	b.setTypeSubType(typeCode, subTypeCode)
+	return b
+}
+
+// IMAD sets {1520} InputMessageAccountabilityData
+func (b *CustomerTransferPlusBuilder) IMAD(tag *InputMessageAccountabilityData) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.InputMessageAccountabilityData, tag)
+	return b
+}
+
+// Amount sets {2000} Amount in cents
+func (b *CustomerTransferPlusBuilder) Amount(cents int64) *CustomerTransferPlusBuilder {
+	b.setAmount(cents)
+	return b
+}
+
+// Sender sets {3100} SenderDepositoryInstitution to the ABA routing number and short name of the sender
+func (b *CustomerTransferPlusBuilder) Sender(aba, shortName string) *CustomerTransferPlusBuilder {
+	b.setSender(aba, shortName)
+	return b
+}
+
+// Receiver sets {3400} ReceiverDepositoryInstitution to the ABA routing number and short name of the receiver
+func (b *CustomerTransferPlusBuilder) Receiver(aba, shortName string) *CustomerTransferPlusBuilder {
+	b.setReceiver(aba, shortName)
+	return b
+}
+
+// SenderReference sets {3320} SenderReference
+func (b *CustomerTransferPlusBuilder) SenderReference(ref string) *CustomerTransferPlusBuilder {
+	b.setSenderReference(ref)
+	return b
+}
+
+// PreviousMessageIdentifier sets {3500} PreviousMessageIdentifier to the IMAD of the previous message
+func (b *CustomerTransferPlusBuilder) PreviousMessageIdentifier(imad string) *CustomerTransferPlusBuilder {
+	b.setPreviousMessageIdentifier(imad)
+	return b
+}
+
+// LocalInstrument sets {3610} LocalInstrument
+func (b *CustomerTransferPlusBuilder) LocalInstrument(tag *LocalInstrument) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.LocalInstrument, tag)
+	return b
+}
+
+// PaymentNotification sets {3620} PaymentNotification
+func (b *CustomerTransferPlusBuilder) PaymentNotification(tag *PaymentNotification) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.PaymentNotification, tag)
+	return b
+}
+
+// Charges sets {3700} Charges
+func (b *CustomerTransferPlusBuilder) Charges(tag *Charges) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.Charges, tag)
+	return b
+}
+
+// InstructedAmount sets {3710} InstructedAmount
+func (b *CustomerTransferPlusBuilder) InstructedAmount(tag *InstructedAmount) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.InstructedAmount, tag)
+	return b
+}
+
+// ExchangeRate sets {3720} ExchangeRate
+func (b *CustomerTransferPlusBuilder) ExchangeRate(tag *ExchangeRate) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.ExchangeRate, tag)
+	return b
+}
+
+// BeneficiaryIntermediaryFI sets {4000} BeneficiaryIntermediaryFI
+func (b *CustomerTransferPlusBuilder) BeneficiaryIntermediaryFI(fi FinancialInstitution) *CustomerTransferPlusBuilder {
+	b.setBeneficiaryIntermediaryFI(fi)
+	return b
+}
+
+// BeneficiaryFI sets {4100} BeneficiaryFI
+func (b *CustomerTransferPlusBuilder) BeneficiaryFI(fi FinancialInstitution) *CustomerTransferPlusBuilder {
+	b.setBeneficiaryFI(fi)
+	return b
+}
+
+// Beneficiary sets {4200} Beneficiary
+func (b *CustomerTransferPlusBuilder) Beneficiary(p Personal) *CustomerTransferPlusBuilder {
+	b.setBeneficiary(p)
+	return b
+}
+
+// BeneficiaryReference sets {4320} BeneficiaryReference
+func (b *CustomerTransferPlusBuilder) BeneficiaryReference(ref string) *CustomerTransferPlusBuilder {
+	b.setBeneficiaryReference(ref)
+	return b
+}
+
+// Originator sets {5000} Originator
+func (b *CustomerTransferPlusBuilder) Originator(p Personal) *CustomerTransferPlusBuilder {
+	b.setOriginator(p)
+	return b
+}
+
+// OriginatorOptionF sets {5010} OriginatorOptionF
+func (b *CustomerTransferPlusBuilder) OriginatorOptionF(tag *OriginatorOptionF) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.OriginatorOptionF, tag)
+	return b
+}
+
+// OriginatorFI sets {5100} OriginatorFI
+func (b *CustomerTransferPlusBuilder) OriginatorFI(fi FinancialInstitution) *CustomerTransferPlusBuilder {
+	b.setOriginatorFI(fi)
+	return b
+}
+
+// InstructingFI sets {5200} InstructingFI
+func (b *CustomerTransferPlusBuilder) InstructingFI(fi FinancialInstitution) *CustomerTransferPlusBuilder {
+	b.setInstructingFI(fi)
+	return b
+}
+
+// OriginatorToBeneficiary sets {6000} OriginatorToBeneficiary
+func (b *CustomerTransferPlusBuilder) OriginatorToBeneficiary(tag *OriginatorToBeneficiary) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.OriginatorToBeneficiary, tag)
+	return b
+}
+
+// FIReceiverFI sets {6100} FIReceiverFI
+func (b *CustomerTransferPlusBuilder) FIReceiverFI(tag *FIReceiverFI) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.FIReceiverFI, tag)
+	return b
+}
+
+// FIIntermediaryFI sets {6200} FIIntermediaryFI
+func (b *CustomerTransferPlusBuilder) FIIntermediaryFI(tag *FIIntermediaryFI) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.FIIntermediaryFI, tag)
+	return b
+}
+
+// FIIntermediaryFIAdvice sets {6210} FIIntermediaryFIAdvice
+func (b *CustomerTransferPlusBuilder) FIIntermediaryFIAdvice(tag *FIIntermediaryFIAdvice) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.FIIntermediaryFIAdvice, tag)
+	return b
+}
+
+// FIBeneficiaryFI sets {6300} FIBeneficiaryFI
+func (b *CustomerTransferPlusBuilder) FIBeneficiaryFI(tag *FIBeneficiaryFI) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.FIBeneficiaryFI, tag)
+	return b
+}
+
+// FIBeneficiaryFIAdvice sets {6310} FIBeneficiaryFIAdvice
+func (b *CustomerTransferPlusBuilder) FIBeneficiaryFIAdvice(tag *FIBeneficiaryFIAdvice) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.FIBeneficiaryFIAdvice, tag)
+	return b
+}
+
+// FIBeneficiary sets {6400} FIBeneficiary
+func (b *CustomerTransferPlusBuilder) FIBeneficiary(tag *FIBeneficiary) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.FIBeneficiary, tag)
+	return b
+}
+
+// FIBeneficiaryAdvice sets {6410} FIBeneficiaryAdvice
+func (b *CustomerTransferPlusBuilder) FIBeneficiaryAdvice(tag *FIBeneficiaryAdvice) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.FIBeneficiaryAdvice, tag)
+	return b
+}
+
+// FIPaymentMethodToBeneficiary sets {6420} FIPaymentMethodToBeneficiary
+func (b *CustomerTransferPlusBuilder) FIPaymentMethodToBeneficiary(tag *FIPaymentMethodToBeneficiary) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.FIPaymentMethodToBeneficiary, tag)
+	return b
+}
+
+// FIAdditionalFIToFI sets {6500} FIAdditionalFIToFI
+func (b *CustomerTransferPlusBuilder) FIAdditionalFIToFI(tag *FIAdditionalFIToFI) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.FIAdditionalFIToFI, tag)
+	return b
+}
+
+// CurrencyInstructedAmount sets {7033} CurrencyInstructedAmount
+func (b *CustomerTransferPlusBuilder) CurrencyInstructedAmount(tag *CurrencyInstructedAmount) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.CurrencyInstructedAmount, tag)
+	return b
+}
+
+// OrderingCustomer sets {7050} OrderingCustomer
+func (b *CustomerTransferPlusBuilder) OrderingCustomer(tag *OrderingCustomer) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.OrderingCustomer, tag)
+	return b
+}
+
+// OrderingInstitution sets {7052} OrderingInstitution
+func (b *CustomerTransferPlusBuilder) OrderingInstitution(tag *OrderingInstitution) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.OrderingInstitution, tag)
+	return b
+}
+
+// IntermediaryInstitution sets {7056} IntermediaryInstitution
+func (b *CustomerTransferPlusBuilder) IntermediaryInstitution(tag *IntermediaryInstitution) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.IntermediaryInstitution, tag)
+	return b
+}
+
+// InstitutionAccount sets {7057} InstitutionAccount
+func (b *CustomerTransferPlusBuilder) InstitutionAccount(tag *InstitutionAccount) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.InstitutionAccount, tag)
+	return b
+}
+
+// BeneficiaryCustomer sets {7059} BeneficiaryCustomer
+func (b *CustomerTransferPlusBuilder) BeneficiaryCustomer(tag *BeneficiaryCustomer) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.BeneficiaryCustomer, tag)
+	return b
+}
+
+// Remittance sets {7070} Remittance
+func (b *CustomerTransferPlusBuilder) Remittance(tag *Remittance) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.Remittance, tag)
+	return b
+}
+
+// SenderToReceiver sets {7072} SenderToReceiver
+func (b *CustomerTransferPlusBuilder) SenderToReceiver(tag *SenderToReceiver) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.SenderToReceiver, tag)
+	return b
+}
+
+// UnstructuredAddenda sets {8200} UnstructuredAddenda
+func (b *CustomerTransferPlusBuilder) UnstructuredAddenda(tag *UnstructuredAddenda) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.UnstructuredAddenda, tag)
+	return b
+}
+
+// RelatedRemittance sets {8250} RelatedRemittance
+func (b *CustomerTransferPlusBuilder) RelatedRemittance(tag *RelatedRemittance) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.RelatedRemittance, tag)
+	return b
+}
+
+// RemittanceOriginator sets {8300} RemittanceOriginator
+func (b *CustomerTransferPlusBuilder) RemittanceOriginator(tag *RemittanceOriginator) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.RemittanceOriginator, tag)
+	return b
+}
+
+// RemittanceBeneficiary sets {8350} RemittanceBeneficiary
+func (b *CustomerTransferPlusBuilder) RemittanceBeneficiary(tag *RemittanceBeneficiary) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.RemittanceBeneficiary, tag)
+	return b
+}
+
+// PrimaryRemittanceDocument sets {8400} PrimaryRemittanceDocument
+func (b *CustomerTransferPlusBuilder) PrimaryRemittanceDocument(tag *PrimaryRemittanceDocument) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.PrimaryRemittanceDocument, tag)
+	return b
+}
+
+// ActualAmountPaid sets {8450} ActualAmountPaid
+func (b *CustomerTransferPlusBuilder) ActualAmountPaid(tag *ActualAmountPaid) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.ActualAmountPaid, tag)
+	return b
+}
+
+// GrossAmountRemittanceDocument sets {8500} GrossAmountRemittanceDocument
+func (b *CustomerTransferPlusBuilder) GrossAmountRemittanceDocument(tag *GrossAmountRemittanceDocument) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.GrossAmountRemittanceDocument, tag)
+	return b
+}
+
+// AmountNegotiatedDiscount sets {8550} AmountNegotiatedDiscount
+func (b *CustomerTransferPlusBuilder) AmountNegotiatedDiscount(tag *AmountNegotiatedDiscount) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.AmountNegotiatedDiscount, tag)
+	return b
+}
+
+// Adjustment sets {8600} Adjustment
+func (b *CustomerTransferPlusBuilder) Adjustment(tag *Adjustment) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.Adjustment, tag)
+	return b
+}
+
+// DateRemittanceDocument sets {8650} DateRemittanceDocument
+func (b *CustomerTransferPlusBuilder) DateRemittanceDocument(tag *DateRemittanceDocument) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.DateRemittanceDocument, tag)
+	return b
+}
+
+// SecondaryRemittanceDocument sets {8700} SecondaryRemittanceDocument
+func (b *CustomerTransferPlusBuilder) SecondaryRemittanceDocument(tag *SecondaryRemittanceDocument) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.SecondaryRemittanceDocument, tag)
+	return b
+}
+
+// RemittanceFreeText sets {8750} RemittanceFreeText
+func (b *CustomerTransferPlusBuilder) RemittanceFreeText(tag *RemittanceFreeText) *CustomerTransferPlusBuilder {
+	CloneTag(&b.fwm.RemittanceFreeText, tag)
+	return b
+}
+
+// Build returns the message when it passes Validate, otherwise the errors of the setters and of Validate in a
+// base.ErrorList
+func (b *CustomerTransferPlusBuilder) Build() (*FEDWireMessage, error) {
+	return b.build()
+}
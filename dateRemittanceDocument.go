@@ -7,6 +7,7 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"time"
 )
 
 // DateRemittanceDocument is the date of remittance document
@@ -91,3 +92,18 @@ func (drd *DateRemittanceDocument) fieldInclusion() error {
 func (drd *DateRemittanceDocument) DateRemittanceDocumentField() string {
 	return drd.alphaField(drd.DateRemittanceDocument, 8)
 }
+
+// Date returns DateRemittanceDocument as midnight UTC. The date is supplied by the originator and is not in
+// FedwireLocation.
+func (drd *DateRemittanceDocument) Date() (time.Time, error) {
+	t, err := parseCCYYMMDD(drd.DateRemittanceDocument, time.UTC)
+	if err != nil {
+		return t, fieldError("DateRemittanceDocument", err, drd.DateRemittanceDocument)
+	}
+	return t, nil
+}
+
+// SetDate sets DateRemittanceDocument to the calendar date of t
+func (drd *DateRemittanceDocument) SetDate(t time.Time) {
+	drd.DateRemittanceDocument = t.Format(ccyymmddLayout)
+}
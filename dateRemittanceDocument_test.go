@@ -1,6 +1,7 @@
 package wire
 
 import (
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -56,3 +57,19 @@ func TestDateRemittanceDocumentTagError(t *testing.T) {
 
 	require.EqualError(t, err, fieldError("tag", ErrValidTagForType, drd.tag).Error())
 }
+
+// TestDateRemittanceDocumentDate converts DateRemittanceDocument to and from a time.Time
+func TestDateRemittanceDocumentDate(t *testing.T) {
+	drd := mockDateRemittanceDocument()
+	drd.DateRemittanceDocument = "20190415"
+	date, err := drd.Date()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.April, 15, 0, 0, 0, 0, time.UTC), date)
+
+	drd.SetDate(time.Date(2020, time.January, 31, 0, 0, 0, 0, FedwireLocation))
+	require.Equal(t, "20200131", drd.DateRemittanceDocument)
+
+	drd.DateRemittanceDocument = "2019-04-15"
+	_, err = drd.Date()
+	require.True(t, errors.Is(err, ErrValidDate))
+}
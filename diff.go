@@ -0,0 +1,190 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// ChangeType is the kind of a Change between two FEDWireMessages
+type ChangeType string
+
+const (
+	// ChangeAdded is a tag which is only in the second message
+	ChangeAdded ChangeType = "added"
+	// ChangeRemoved is a tag which is only in the first message
+	ChangeRemoved ChangeType = "removed"
+	// ChangeModified is a field with a different value in each message
+	ChangeModified ChangeType = "modified"
+)
+
+// fedAppendedTags are the FEDWireMessage fields of the tags the Fedwire Funds Service appends to a message it sends
+var fedAppendedTags = map[string]bool{
+	"MessageDisposition":              true,
+	"ReceiptTimeStamp":                true,
+	"OutputMessageAccountabilityData": true,
+	"ErrorWire":                       true,
+}
+
+// Change is a difference between two FEDWireMessages
+type Change struct {
+	// Type of the change
+	Type ChangeType
+	// Tag of the change, e.g. {4200}
+	Tag string
+	// Path is the JSON path of the tag, for an added or removed tag, or of the field, e.g. beneficiary.personal.name
+	Path string
+	// Old is the value in the first message, which is the tag in wire format for a removed tag
+	Old string
+	// New is the value in the second message, which is the tag in wire format for an added tag
+	New string
+}
+
+// Changes is the list of differences between two FEDWireMessages in tag order
+type Changes []Change
+
+// DiffOptions configures the comparison of FEDWireMessages
+type DiffOptions struct {
+	// IgnoreFedAppended skips the tags appended by the Fed: {1100} MessageDisposition, {1110} ReceiptTimeStamp,
+	// {1120} OutputMessageAccountabilityData and {1130} ErrorWire
+	IgnoreFedAppended bool
+	// IgnorePadding compares values without leading and trailing spaces, and amounts and the IMAD sequence number
+	// without leading zeros. Identifiers, accounts and routing numbers are compared with their zeros.
+	IgnorePadding bool
+}
+
+// Diff returns every difference between two FEDWireMessages: tags only in a are removed, tags only in b are added
+// and fields of a tag in both with different values are modified.
+func Diff(a, b *FEDWireMessage) Changes {
+	return DiffOptions{}.Diff(a, b)
+}
+
+// Diff returns every difference between two FEDWireMessages using opts. A nil message has no tags.
+func (opts DiffOptions) Diff(a, b *FEDWireMessage) Changes {
+	if a == nil {
+		a = &FEDWireMessage{}
+	}
+	if b == nil {
+		b = &FEDWireMessage{}
+	}
+	var changes Changes
+	av, bv := reflect.ValueOf(a).Elem(), reflect.ValueOf(b).Elem()
+	t := av.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Type.Kind() != reflect.Ptr || field.Type.Elem().Kind() != reflect.Struct {
+			continue
+		}
+		if opts.IgnoreFedAppended && fedAppendedTags[field.Name] {
+			continue
+		}
+		path := strings.Split(field.Tag.Get("json"), ",")[0]
+		at, bt := av.Field(i), bv.Field(i)
+		tag := diffTag(at, bt)
+
+		switch {
+		case at.IsNil() && bt.IsNil():
+		case at.IsNil():
+			changes = append(changes, Change{Type: ChangeAdded, Tag: tag, Path: path, New: diffTagString(bt)})
+		case bt.IsNil():
+			changes = append(changes, Change{Type: ChangeRemoved, Tag: tag, Path: path, Old: diffTagString(at)})
+		default:
+			for _, column := range csvColumnsOf(field.Type.Elem(), path+".", nil) {
+				before, after := csvValue(at, column.index), csvValue(bt, column.index)
+				if opts.equal(column.name, before, after) {
+					continue
+				}
+				changes = append(changes, Change{Type: ChangeModified, Tag: tag, Path: column.name, Old: before, New: after})
+			}
+		}
+	}
+	return changes
+}
+
+// equal compares two values of the field at path
+func (opts DiffOptions) equal(path, a, b string) bool {
+	if !opts.IgnorePadding {
+		return a == b
+	}
+	return normalizeFieldPadding(path, a) == normalizeFieldPadding(path, b)
+}
+
+// paddedNumberFields are the paths of the numeric fields whose leading zeros are padding, besides the amounts
+var paddedNumberFields = map[string]bool{
+	"inputMessageAccountabilityData.inputSequenceNumber": true,
+}
+
+// normalizeFieldPadding returns the value of the field at path without leading and trailing spaces, and without the
+// leading zeros of an amount or a paddedNumberFields value. Other numeric values such as identifiers, accounts and
+// routing numbers keep their leading zeros, which are significant.
+func normalizeFieldPadding(path, s string) string {
+	s = strings.TrimSpace(s)
+	if !paddedNumberFields[path] {
+		if i, ok := fieldPaths[path]; !ok || fieldInfos[i].CharacterClass != CharacterClassAmount {
+			return s
+		}
+	}
+	return normalizePadding(s)
+}
+
+// normalizePadding returns a value without leading and trailing spaces, and a numeric value or an amount with one
+// decimal marker without leading zeros, e.g. 000000001500,49 as 1500,49
+func normalizePadding(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" || amountRegex.MatchString(s) || strings.Count(s, ",")+strings.Count(s, ".") > 1 {
+		return s
+	}
+	trimmed := strings.TrimLeft(s, "0")
+	if trimmed == "" || trimmed[0] == ',' || trimmed[0] == '.' {
+		return "0" + trimmed
+	}
+	return trimmed
+}
+
+// diffTag returns the tag of whichever of a and b is set
+func diffTag(a, b reflect.Value) string {
+	for _, v := range []reflect.Value{a, b} {
+		if v.IsNil() {
+			continue
+		}
+		if tag := v.Elem().FieldByName("tag"); tag.IsValid() && tag.String() != "" {
+			return tag.String()
+		}
+	}
+	return ""
+}
+
+// diffTagString returns the wire format of a tag
+func diffTagString(v reflect.Value) string {
+	if s, ok := v.Interface().(fmt.Stringer); ok {
+		return s.String()
+	}
+	return ""
+}
+
+// WriteUnified writes the changes as a unified diff of the messages named from and to. Each change is a hunk headed
+// by its tag and path, with the old value on a - line and the new value on a + line.
+func (c Changes) WriteUnified(w io.Writer, from, to string) error {
+	if len(c) == 0 {
+		return nil
+	}
+	var buf strings.Builder
+	buf.WriteString("--- " + from + "\n")
+	buf.WriteString("+++ " + to + "\n")
+	for _, change := range c {
+		buf.WriteString("@@ " + change.Tag + " " + change.Path + " @@\n")
+		if change.Type != ChangeAdded {
+			buf.WriteString("-" + change.Old + "\n")
+		}
+		if change.Type != ChangeRemoved {
+			buf.WriteString("+" + change.New + "\n")
+		}
+	}
+	_, err := io.WriteString(w, buf.String())
+	return err
+}
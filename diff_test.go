@@ -0,0 +1,133 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestDiff lists added and removed tags and modified fields in tag order
+func TestDiff(t *testing.T) {
+	a := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	b := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	require.Empty(t, Diff(&a, &b))
+
+	b.Amount.Amount = "000001234500"
+	b.Beneficiary.Personal.Name = "Jane Doe"
+	b.ReceiptTimeStamp = mockReceiptTimeStamp()
+	b.OriginatorToBeneficiary = nil
+
+	changes := Diff(&a, &b)
+	require.Equal(t, Changes{
+		{Type: ChangeAdded, Tag: TagReceiptTimeStamp, Path: "receiptTimeStamp", New: b.ReceiptTimeStamp.String()},
+		{Type: ChangeModified, Tag: TagAmount, Path: "amount.amount", Old: "000001234567", New: "000001234500"},
+		{Type: ChangeModified, Tag: TagBeneficiary, Path: "beneficiary.personal.name", Old: "Name", New: "Jane Doe"},
+		{Type: ChangeRemoved, Tag: TagOriginatorToBeneficiary, Path: "originatorToBeneficiary",
+			Old: a.OriginatorToBeneficiary.String()},
+	}, changes)
+
+	changes = Diff(&b, &a)
+	require.Equal(t, ChangeRemoved, changes[0].Type)
+	require.Equal(t, "000001234500", changes[1].Old)
+
+	changes = Diff(nil, &a)
+	require.Equal(t, Diff(&FEDWireMessage{}, &a), changes)
+	for _, change := range changes {
+		require.Equal(t, ChangeAdded, change.Type)
+	}
+}
+
+// TestDiffOptions ignores Fed appended tags and padding
+func TestDiffOptions(t *testing.T) {
+	a := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	b := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	b.OutputMessageAccountabilityData = mockOutputMessageAccountabilityData()
+	b.ErrorWire = mockErrorWire()
+	b.Amount.Amount = "1234567"
+	b.Beneficiary.Personal.Name = "Name  "
+	b.Beneficiary.Personal.Identifier = " 1234"
+
+	require.Len(t, Diff(&a, &b), 5)
+	require.Len(t, DiffOptions{IgnoreFedAppended: true}.Diff(&a, &b), 3)
+	require.Len(t, DiffOptions{IgnorePadding: true}.Diff(&a, &b), 2)
+	require.Empty(t, DiffOptions{IgnoreFedAppended: true, IgnorePadding: true}.Diff(&a, &b))
+
+	b.Beneficiary.Personal.Name = "Other"
+	b.Amount.Amount = "1234560"
+	changes := DiffOptions{IgnoreFedAppended: true, IgnorePadding: true}.Diff(&a, &b)
+	require.Len(t, changes, 2)
+	require.Equal(t, "amount.amount", changes[0].Path)
+	require.Equal(t, "beneficiary.personal.name", changes[1].Path)
+}
+
+// TestDiffOptionsIdentifierZeros keeps the leading zeros of identifiers, accounts and routing numbers
+func TestDiffOptionsIdentifierZeros(t *testing.T) {
+	a := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	b := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	opts := DiffOptions{IgnorePadding: true}
+
+	a.Beneficiary.Personal.Identifier = "0012345"
+	b.Beneficiary.Personal.Identifier = "12345"
+	changes := opts.Diff(&a, &b)
+	require.Len(t, changes, 1)
+	require.Equal(t, Change{Type: ChangeModified, Tag: TagBeneficiary, Path: "beneficiary.personal.identifier", Old: "0012345", New: "12345"}, changes[0])
+
+	b = readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	a.Beneficiary.Personal.Identifier = b.Beneficiary.Personal.Identifier
+	a.SenderDepositoryInstitution.SenderABANumber = "021000021"
+	b.SenderDepositoryInstitution.SenderABANumber = "21000021"
+	require.Len(t, opts.Diff(&a, &b), 1)
+
+	// the sequence number of the IMAD is zero padded
+	b.SenderDepositoryInstitution.SenderABANumber = a.SenderDepositoryInstitution.SenderABANumber
+	a.InputMessageAccountabilityData.InputSequenceNumber = "000001"
+	b.InputMessageAccountabilityData.InputSequenceNumber = "1"
+	require.Empty(t, opts.Diff(&a, &b))
+
+	require.Equal(t, "0012345", normalizeFieldPadding("beneficiary.personal.identifier", " 0012345 "))
+	require.Equal(t, "1234567", normalizeFieldPadding("amount.amount", "000001234567"))
+}
+
+// TestChanges_WriteUnified renders changes as a unified diff
+func TestChanges_WriteUnified(t *testing.T) {
+	a := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	b := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	b.Beneficiary.Personal.Name = "Jane Doe"
+	b.ReceiptTimeStamp = mockReceiptTimeStamp()
+	b.OriginatorToBeneficiary = nil
+
+	var buf bytes.Buffer
+	require.NoError(t, Diff(&a, &b).WriteUnified(&buf, "original", "repaired"))
+	require.Equal(t, `--- original
++++ repaired
+@@ {1110} receiptTimeStamp @@
++{1110}05021230A123
+@@ {4200} beneficiary.personal.name @@
+-Name
++Jane Doe
+@@ {6000} originatorToBeneficiary @@
+-`+a.OriginatorToBeneficiary.String()+`
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, Diff(&a, &a).WriteUnified(&buf, "original", "repaired"))
+	require.Empty(t, buf.String())
+}
+
+// TestNormalizePadding trims spaces and the leading zeros of numbers and amounts
+func TestNormalizePadding(t *testing.T) {
+	require.Equal(t, "", normalizePadding("   "))
+	require.Equal(t, "Name", normalizePadding(" Name "))
+	require.Equal(t, "1234567", normalizePadding("000001234567"))
+	require.Equal(t, "0", normalizePadding("000000000000"))
+	require.Equal(t, "1500,49", normalizePadding("000000001500,49"))
+	require.Equal(t, "0,99", normalizePadding("000,99"))
+	require.Equal(t, "0.5", normalizePadding(".5"))
+	require.Equal(t, "00A1", normalizePadding("00A1"))
+	require.Equal(t, "001,2,3", normalizePadding("001,2,3"))
+}
@@ -0,0 +1,308 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import "strings"
+
+// NewDrawdownResponse returns the drawdown payment (SubTypeCode 32) which answers request, a DRB or DRC drawdown
+// request (SubTypeCode 31), with imad as its IMAD.
+//
+// The payment is a DRW funds transfer sent by the receiver of request to the FI of {5400} AccountCreditedDrawdown. The
+// account of {4400} AccountDebitedDrawdown is the originator and the beneficiary, beneficiary FI and beneficiary
+// intermediary FI of request are kept. The amount, the type code and {4320} BeneficiaryReference are kept and the IMAD
+// of request is {3500} PreviousMessageIdentifier. The returned message is validated and checked against request with
+// ValidateDrawdownResponse.
+func NewDrawdownResponse(request *FEDWireMessage, imad *InputMessageAccountabilityData) (*FEDWireMessage, error) {
+	fwm, err := newDrawdownAnswer(request, imad, FundsTransferRequestCredit)
+	if err != nil {
+		return nil, err
+	}
+
+	fwm.ReceiverDepositoryInstitution = NewReceiverDepositoryInstitution()
+	fwm.ReceiverDepositoryInstitution.ReceiverABANumber = request.AccountCreditedDrawdown.DrawdownCreditAccountNumber
+	if request.AccountCreditedDrawdown.DrawdownCreditAccountNumber == request.SenderDepositoryInstitution.SenderABANumber {
+		fwm.ReceiverDepositoryInstitution.ReceiverShortName = request.SenderDepositoryInstitution.SenderShortName
+	}
+	debitDD := request.AccountDebitedDrawdown
+	fwm.Originator = NewOriginator()
+	fwm.Originator.Personal = Personal{
+		IdentificationCode: debitDD.IdentificationCode,
+		Identifier:         debitDD.Identifier,
+		Name:               debitDD.Name,
+		Address:            debitDD.Address,
+	}
+	CloneTag(&fwm.Beneficiary, request.Beneficiary)
+	if request.BeneficiaryFI != nil {
+		CloneTag(&fwm.BeneficiaryFI, request.BeneficiaryFI)
+	}
+	if request.BeneficiaryIntermediaryFI != nil {
+		CloneTag(&fwm.BeneficiaryIntermediaryFI, request.BeneficiaryIntermediaryFI)
+	}
+	return fwm, fwm.validateDrawdownAnswer(request)
+}
+
+// NewDrawdownRefusal returns the refusal (SubTypeCode 33) of request, a DRB or DRC drawdown request (SubTypeCode 31),
+// with imad as its IMAD and the lines of reason, at most 6, in {6500} FIAdditionalFIToFI.
+//
+// The refusal keeps the business function code of request and is sent by its receiver back to its sender. The
+// beneficiary, {4400} AccountDebitedDrawdown, {5000} Originator and {5400} AccountCreditedDrawdown of request are kept
+// so the refused drawdown can be identified, as are the amount, the type code and {4320} BeneficiaryReference. The
+// IMAD of request is {3500} PreviousMessageIdentifier. The returned message is validated and checked against request
+// with ValidateDrawdownResponse.
+func NewDrawdownRefusal(request *FEDWireMessage, imad *InputMessageAccountabilityData, reason ...string) (*FEDWireMessage, error) {
+	fwm, err := newDrawdownAnswer(request, imad, RefusalRequestCredit)
+	if err != nil {
+		return nil, err
+	}
+
+	fwm.ReceiverDepositoryInstitution = NewReceiverDepositoryInstitution()
+	fwm.ReceiverDepositoryInstitution.ReceiverABANumber = request.SenderDepositoryInstitution.SenderABANumber
+	fwm.ReceiverDepositoryInstitution.ReceiverShortName = request.SenderDepositoryInstitution.SenderShortName
+	if request.Beneficiary != nil {
+		CloneTag(&fwm.Beneficiary, request.Beneficiary)
+	}
+	if request.Originator != nil {
+		CloneTag(&fwm.Originator, request.Originator)
+	}
+	CloneTag(&fwm.AccountDebitedDrawdown, request.AccountDebitedDrawdown)
+	CloneTag(&fwm.AccountCreditedDrawdown, request.AccountCreditedDrawdown)
+	if lines := nonEmpty(reason...); len(lines) > 0 {
+		fwm.FIAdditionalFIToFI = NewFIAdditionalFIToFI()
+		a := &fwm.FIAdditionalFIToFI.AdditionalFIToFI
+		setLines([]*string{&a.LineOne, &a.LineTwo, &a.LineThree, &a.LineFour, &a.LineFive, &a.LineSix}, lines)
+	}
+	return fwm, fwm.validateDrawdownAnswer(request)
+}
+
+// ValidateDrawdownResponse checks that response, a DRW drawdown payment (SubTypeCode 32) or a refusal (SubTypeCode 33),
+// answers request, a DRB or DRC drawdown request (SubTypeCode 31).
+//
+// The response must reference the IMAD of request in {3500} PreviousMessageIdentifier, be sent by the receiver of
+// request in the same or a later cycle, and have the same type code, amount and {4320} BeneficiaryReference. A
+// drawdown payment must be sent to the FI of {5400} AccountCreditedDrawdown, from the account of {4400}
+// AccountDebitedDrawdown, to the beneficiary of request. A refusal must have the business function code of request, be
+// sent to its sender and hold the same {4400} AccountDebitedDrawdown and {5400} AccountCreditedDrawdown. Neither
+// message is validated on its own, callers should make a Validate() call for each first.
+func ValidateDrawdownResponse(request, response *FEDWireMessage) error {
+	if err := checkDrawdownRequest(request); err != nil {
+		return err
+	}
+	if err := checkDrawdownTags(response); err != nil {
+		return err
+	}
+
+	bfc := response.BusinessFunctionCode.BusinessFunctionCode
+	switch response.TypeSubType.SubTypeCode {
+	case FundsTransferRequestCredit:
+		if bfc != DrawdownResponse {
+			return fieldError("BusinessFunctionCode", ErrDrawdownMismatch, bfc)
+		}
+	case RefusalRequestCredit:
+		if bfc != request.BusinessFunctionCode.BusinessFunctionCode {
+			return fieldError("BusinessFunctionCode", ErrDrawdownMismatch, bfc)
+		}
+	default:
+		return fieldError("SubTypeCode", ErrDrawdownMismatch, response.TypeSubType.SubTypeCode)
+	}
+	if response.TypeSubType.TypeCode != request.TypeSubType.TypeCode {
+		return fieldError("TypeCode", ErrDrawdownMismatch, response.TypeSubType.TypeCode)
+	}
+	if previous := response.previousMessageID(); previous != request.InputMessageAccountabilityData.IMAD() {
+		return fieldError("PreviousMessageIdentifier", ErrDrawdownMismatch, previous)
+	}
+	if err := checkDrawdownCycleDate(request.InputMessageAccountabilityData, response.InputMessageAccountabilityData); err != nil {
+		return err
+	}
+	if !sameDrawdownAmount(response.Amount, request.Amount) {
+		return fieldError("Amount", ErrDrawdownMismatch, response.Amount.Amount)
+	}
+	if ref := beneficiaryReference(response); ref != beneficiaryReference(request) {
+		return fieldError("BeneficiaryReference", ErrDrawdownMismatch, ref)
+	}
+	sender := response.SenderDepositoryInstitution.SenderABANumber
+	if sender != request.ReceiverDepositoryInstitution.ReceiverABANumber {
+		return fieldError("SenderABANumber", ErrDrawdownMismatch, sender)
+	}
+
+	receiver := response.ReceiverDepositoryInstitution.ReceiverABANumber
+	if response.TypeSubType.SubTypeCode == RefusalRequestCredit {
+		if receiver != request.SenderDepositoryInstitution.SenderABANumber {
+			return fieldError("ReceiverABANumber", ErrDrawdownMismatch, receiver)
+		}
+		if response.AccountDebitedDrawdown == nil {
+			return fieldError("AccountDebitedDrawdown", ErrFieldRequired)
+		}
+		if !sameDrawdownAccount(response.AccountDebitedDrawdown.IdentificationCode, response.AccountDebitedDrawdown.Identifier,
+			request.AccountDebitedDrawdown.IdentificationCode, request.AccountDebitedDrawdown.Identifier) {
+			return fieldError("AccountDebitedDrawdown", ErrDrawdownMismatch, response.AccountDebitedDrawdown.Identifier)
+		}
+		if response.AccountCreditedDrawdown == nil {
+			return fieldError("AccountCreditedDrawdown", ErrFieldRequired)
+		}
+		if number := response.AccountCreditedDrawdown.DrawdownCreditAccountNumber; number != request.AccountCreditedDrawdown.DrawdownCreditAccountNumber {
+			return fieldError("AccountCreditedDrawdown", ErrDrawdownMismatch, number)
+		}
+		return nil
+	}
+
+	if receiver != request.AccountCreditedDrawdown.DrawdownCreditAccountNumber {
+		return fieldError("ReceiverABANumber", ErrDrawdownMismatch, receiver)
+	}
+	if response.Originator == nil {
+		return fieldError("Originator", ErrFieldRequired)
+	}
+	if !sameDrawdownAccount(response.Originator.Personal.IdentificationCode, response.Originator.Personal.Identifier,
+		request.AccountDebitedDrawdown.IdentificationCode, request.AccountDebitedDrawdown.Identifier) {
+		return fieldError("Originator", ErrDrawdownMismatch, response.Originator.Personal.Identifier)
+	}
+	if request.Beneficiary != nil {
+		if response.Beneficiary == nil {
+			return fieldError("Beneficiary", ErrFieldRequired)
+		}
+		if !sameDrawdownAccount(response.Beneficiary.Personal.IdentificationCode, response.Beneficiary.Personal.Identifier,
+			request.Beneficiary.Personal.IdentificationCode, request.Beneficiary.Personal.Identifier) {
+			return fieldError("Beneficiary", ErrDrawdownMismatch, response.Beneficiary.Personal.Identifier)
+		}
+	}
+	return nil
+}
+
+// newDrawdownAnswer populates the tags shared by a drawdown payment and a refusal of request
+func newDrawdownAnswer(request *FEDWireMessage, imad *InputMessageAccountabilityData, subTypeCode string) (*FEDWireMessage, error) {
+	if err := checkDrawdownRequest(request); err != nil {
+		return nil, err
+	}
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	if imad == nil {
+		return nil, fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+	}
+	if err := checkDrawdownCycleDate(request.InputMessageAccountabilityData, imad); err != nil {
+		return nil, err
+	}
+
+	fwm := &FEDWireMessage{}
+	fwm.SenderSupplied = NewSenderSupplied()
+	if request.SenderSupplied != nil {
+		fwm.SenderSupplied.UserRequestCorrelation = request.SenderSupplied.UserRequestCorrelation
+		fwm.SenderSupplied.TestProductionCode = request.SenderSupplied.TestProductionCode
+	}
+	fwm.TypeSubType = NewTypeSubType()
+	fwm.TypeSubType.TypeCode = request.TypeSubType.TypeCode
+	fwm.TypeSubType.SubTypeCode = subTypeCode
+	CloneTag(&fwm.InputMessageAccountabilityData, imad)
+	CloneTag(&fwm.Amount, request.Amount)
+	fwm.SenderDepositoryInstitution = NewSenderDepositoryInstitution()
+	fwm.SenderDepositoryInstitution.SenderABANumber = request.ReceiverDepositoryInstitution.ReceiverABANumber
+	fwm.SenderDepositoryInstitution.SenderShortName = request.ReceiverDepositoryInstitution.ReceiverShortName
+	fwm.BusinessFunctionCode = NewBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = request.BusinessFunctionCode.BusinessFunctionCode
+	if subTypeCode == FundsTransferRequestCredit {
+		fwm.BusinessFunctionCode.BusinessFunctionCode = DrawdownResponse
+	}
+	fwm.PreviousMessageIdentifier = NewPreviousMessageIdentifier()
+	fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = request.InputMessageAccountabilityData.IMAD()
+	if request.BeneficiaryReference != nil {
+		CloneTag(&fwm.BeneficiaryReference, request.BeneficiaryReference)
+	}
+	return fwm, nil
+}
+
+// validateDrawdownAnswer validates a drawdown payment or refusal built from request
+func (fwm *FEDWireMessage) validateDrawdownAnswer(request *FEDWireMessage) error {
+	if err := fwm.Validate(); err != nil {
+		return err
+	}
+	return ValidateDrawdownResponse(request, fwm)
+}
+
+// checkDrawdownRequest ensures request is a DRB or DRC drawdown request with the tags its answer is matched against
+func checkDrawdownRequest(request *FEDWireMessage) error {
+	if err := checkDrawdownTags(request); err != nil {
+		return err
+	}
+	switch bfc := request.BusinessFunctionCode.BusinessFunctionCode; bfc {
+	case BankDrawDownRequest, CustomerCorporateDrawdownRequest:
+	default:
+		return fieldError("BusinessFunctionCode", ErrDrawdownRequest, bfc)
+	}
+	if request.TypeSubType.SubTypeCode != RequestCredit {
+		return fieldError("SubTypeCode", ErrDrawdownRequest, request.TypeSubType.SubTypeCode)
+	}
+	if request.AccountDebitedDrawdown == nil {
+		return fieldError("AccountDebitedDrawdown", ErrFieldRequired)
+	}
+	if request.AccountCreditedDrawdown == nil {
+		return fieldError("AccountCreditedDrawdown", ErrFieldRequired)
+	}
+	return nil
+}
+
+// checkDrawdownTags ensures a drawdown message has the mandatory tags a request and its answer are matched on
+func checkDrawdownTags(fwm *FEDWireMessage) error {
+	if fwm == nil {
+		return fieldError("FEDWireMessage", ErrFieldRequired)
+	}
+	if fwm.TypeSubType == nil {
+		return fieldError("TypeSubType", ErrFieldRequired)
+	}
+	if fwm.InputMessageAccountabilityData == nil {
+		return fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+	}
+	if fwm.Amount == nil {
+		return fieldError("Amount", ErrFieldRequired)
+	}
+	if fwm.SenderDepositoryInstitution == nil {
+		return fieldError("SenderDepositoryInstitution", ErrFieldRequired)
+	}
+	if fwm.ReceiverDepositoryInstitution == nil {
+		return fieldError("ReceiverDepositoryInstitution", ErrFieldRequired)
+	}
+	if fwm.BusinessFunctionCode == nil {
+		return fieldError("BusinessFunctionCode", ErrFieldRequired)
+	}
+	return nil
+}
+
+// checkDrawdownCycleDate ensures the answer to a drawdown request is not in an earlier cycle than the request
+func checkDrawdownCycleDate(request, imad *InputMessageAccountabilityData) error {
+	requestDate, err := request.CycleDate()
+	if err != nil {
+		return err
+	}
+	date, err := imad.CycleDate()
+	if err != nil {
+		return err
+	}
+	if date.Before(requestDate) {
+		return fieldError("InputCycleDate", ErrDrawdownMismatch, imad.InputCycleDate)
+	}
+	return nil
+}
+
+// beneficiaryReference returns the {4320} BeneficiaryReference of a message without padding
+func beneficiaryReference(fwm *FEDWireMessage) string {
+	if fwm.BeneficiaryReference == nil {
+		return ""
+	}
+	return strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference)
+}
+
+// sameDrawdownAmount compares two amounts in cents, so 000001234567 and 1234567 are the same amount
+func sameDrawdownAmount(a, other *Amount) bool {
+	cents, err := a.Cents()
+	if err != nil {
+		return false
+	}
+	otherCents, err := other.Cents()
+	return err == nil && cents == otherCents
+}
+
+// sameDrawdownAccount compares the identification codes and identifiers of two accounts exactly, as leading zeros
+// of an account are significant
+func sameDrawdownAccount(code, identifier, otherCode, otherIdentifier string) bool {
+	return code == otherCode && identifier == otherIdentifier
+}
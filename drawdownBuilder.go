@@ -0,0 +1,215 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// DrawdownBuilder builds a DRC or DRB drawdown request or a DRW drawdown payment, exposing only the tags they
+// permit. NewDrawdownResponse and NewDrawdownRefusal build the answer to a drawdown request from the request.
+type DrawdownBuilder struct {
+	messageBuilder
+}
+
+// NewDrawdownRequestBuilder returns a builder of a DRC customer or corporate drawdown request with a default {1500}
+// SenderSupplied and {1510} TypeSubType 1031, a request for credit. Beneficiary, AccountDebitedDrawdown and
+// AccountCreditedDrawdown are mandatory.
+func NewDrawdownRequestBuilder() *DrawdownBuilder {
+	b := &DrawdownBuilder{newMessageBuilder(CustomerCorporateDrawdownRequest, FundsTransfer, RequestCredit)}
+	return b
+}
+
+// NewBankDrawdownRequestBuilder returns a builder of a DRB bank to bank drawdown request with a default {1500}
+// SenderSupplied and {1510} TypeSubType 1631, a request for credit. AccountDebitedDrawdown and
+// AccountCreditedDrawdown are mandatory.
+func NewBankDrawdownRequestBuilder() *DrawdownBuilder {
+	b := &DrawdownBuilder{newMessageBuilder(BankDrawDownRequest, SettlementTransfer, RequestCredit)}
+	return b
+}
+
+// NewDrawdownPaymentBuilder returns a builder of a DRW drawdown payment with a default {1500} SenderSupplied and
+// {1510} TypeSubType 1032, a funds transfer honoring a request for credit. Beneficiary and Originator are
+// mandatory.
+func NewDrawdownPaymentBuilder() *DrawdownBuilder {
+	b := &DrawdownBuilder{newMessageBuilder(DrawdownResponse, FundsTransfer, FundsTransferRequestCredit)}
+	return b
+}
+
+// SenderSupplied sets {1500} SenderSupplied
+func (b *DrawdownBuilder) SenderSupplied(tag *SenderSupplied) *DrawdownBuilder {
+	CloneTag(&b.fwm.SenderSupplied, tag)
+	return b
+}
+
+// UserRequestCorrelation sets the UserRequestCorrelation of {1500} SenderSupplied
+func (b *DrawdownBuilder) UserRequestCorrelation(id string) *DrawdownBuilder {
+	b.setUserRequestCorrelation(id)
+	return b
+}
+
+// TypeSubType sets {1510} TypeSubType to typeCode and subTypeCode
+func (b *DrawdownBuilder) TypeSubType(typeCode, subTypeCode string) *DrawdownBuilder {
+	b.setTypeSubType(typeCode, subTypeCode)
+	return b
+}
+
+// IMAD sets {1520} InputMessageAccountabilityData
+func (b *DrawdownBuilder) IMAD(tag *InputMessageAccountabilityData) *DrawdownBuilder {
+	CloneTag(&b.fwm.InputMessageAccountabilityData, tag)
+	return b
+}
+
+// Amount sets {2000} Amount in cents
+func (b *DrawdownBuilder) Amount(cents int64) *DrawdownBuilder {
+	b.setAmount(cents)
+	return b
+}
+
+// Sender sets {3100} SenderDepositoryInstitution to the ABA routing number and short name of the sender
+func (b *DrawdownBuilder) Sender(aba, shortName string) *DrawdownBuilder {
+	b.setSender(aba, shortName)
+	return b
+}
+
+// Receiver sets {3400} ReceiverDepositoryInstitution to the ABA routing number and short name of the receiver
+func (b *DrawdownBuilder) Receiver(aba, shortName string) *DrawdownBuilder {
+	b.setReceiver(aba, shortName)
+	return b
+}
+
+// SenderReference sets {3320} SenderReference
+func (b *DrawdownBuilder) SenderReference(ref string) *DrawdownBuilder {
+	b.setSenderReference(ref)
+	return b
+}
+
+// PreviousMessageIdentifier sets {3500} PreviousMessageIdentifier to the IMAD of the previous message
+func (b *DrawdownBuilder) PreviousMessageIdentifier(imad string) *DrawdownBuilder {
+	b.setPreviousMessageIdentifier(imad)
+	return b
+}
+
+// BeneficiaryIntermediaryFI sets {4000} BeneficiaryIntermediaryFI
+func (b *DrawdownBuilder) BeneficiaryIntermediaryFI(fi FinancialInstitution) *DrawdownBuilder {
+	b.setBeneficiaryIntermediaryFI(fi)
+	return b
+}
+
+// BeneficiaryFI sets {4100} BeneficiaryFI
+func (b *DrawdownBuilder) BeneficiaryFI(fi FinancialInstitution) *DrawdownBuilder {
+	b.setBeneficiaryFI(fi)
+	return b
+}
+
+// Beneficiary sets {4200} Beneficiary
+func (b *DrawdownBuilder) Beneficiary(p Personal) *DrawdownBuilder {
+	b.setBeneficiary(p)
+	return b
+}
+
+// BeneficiaryReference sets {4320} BeneficiaryReference
+func (b *DrawdownBuilder) BeneficiaryReference(ref string) *DrawdownBuilder {
+	b.setBeneficiaryReference(ref)
+	return b
+}
+
+// AccountDebitedDrawdown sets {4400} AccountDebitedDrawdown
+func (b *DrawdownBuilder) AccountDebitedDrawdown(tag *AccountDebitedDrawdown) *DrawdownBuilder {
+	CloneTag(&b.fwm.AccountDebitedDrawdown, tag)
+	return b
+}
+
+// Originator sets {5000} Originator
+func (b *DrawdownBuilder) Originator(p Personal) *DrawdownBuilder {
+	b.setOriginator(p)
+	return b
+}
+
+// OriginatorFI sets {5100} OriginatorFI
+func (b *DrawdownBuilder) OriginatorFI(fi FinancialInstitution) *DrawdownBuilder {
+	b.setOriginatorFI(fi)
+	return b
+}
+
+// InstructingFI sets {5200} InstructingFI
+func (b *DrawdownBuilder) InstructingFI(fi FinancialInstitution) *DrawdownBuilder {
+	b.setInstructingFI(fi)
+	return b
+}
+
+// AccountCreditedDrawdown sets {5400} AccountCreditedDrawdown to the ABA routing number of the account to credit
+func (b *DrawdownBuilder) AccountCreditedDrawdown(aba string) *DrawdownBuilder {
+	b.setAccountCreditedDrawdown(aba)
+	return b
+}
+
+// OriginatorToBeneficiary sets {6000} OriginatorToBeneficiary
+func (b *DrawdownBuilder) OriginatorToBeneficiary(tag *OriginatorToBeneficiary) *DrawdownBuilder {
+	CloneTag(&b.fwm.OriginatorToBeneficiary, tag)
+	return b
+}
+
+// FIReceiverFI sets {6100} FIReceiverFI
+func (b *DrawdownBuilder) FIReceiverFI(tag *FIReceiverFI) *DrawdownBuilder {
+	CloneTag(&b.fwm.FIReceiverFI, tag)
+	return b
+}
+
+// FIDrawdownDebitAccountAdvice sets {6110} FIDrawdownDebitAccountAdvice
+func (b *DrawdownBuilder) FIDrawdownDebitAccountAdvice(tag *FIDrawdownDebitAccountAdvice) *DrawdownBuilder {
+	CloneTag(&b.fwm.FIDrawdownDebitAccountAdvice, tag)
+	return b
+}
+
+// FIIntermediaryFI sets {6200} FIIntermediaryFI
+func (b *DrawdownBuilder) FIIntermediaryFI(tag *FIIntermediaryFI) *DrawdownBuilder {
+	CloneTag(&b.fwm.FIIntermediaryFI, tag)
+	return b
+}
+
+// FIIntermediaryFIAdvice sets {6210} FIIntermediaryFIAdvice
+func (b *DrawdownBuilder) FIIntermediaryFIAdvice(tag *FIIntermediaryFIAdvice) *DrawdownBuilder {
+	CloneTag(&b.fwm.FIIntermediaryFIAdvice, tag)
+	return b
+}
+
+// FIBeneficiaryFI sets {6300} FIBeneficiaryFI
+func (b *DrawdownBuilder) FIBeneficiaryFI(tag *FIBeneficiaryFI) *DrawdownBuilder {
+	CloneTag(&b.fwm.FIBeneficiaryFI, tag)
+	return b
+}
+
+// FIBeneficiaryFIAdvice sets {6310} FIBeneficiaryFIAdvice
+func (b *DrawdownBuilder) FIBeneficiaryFIAdvice(tag *FIBeneficiaryFIAdvice) *DrawdownBuilder {
+	CloneTag(&b.fwm.FIBeneficiaryFIAdvice, tag)
+	return b
+}
+
+// FIBeneficiary sets {6400} FIBeneficiary
+func (b *DrawdownBuilder) FIBeneficiary(tag *FIBeneficiary) *DrawdownBuilder {
+	CloneTag(&b.fwm.FIBeneficiary, tag)
+	return b
+}
+
+// FIBeneficiaryAdvice sets {6410} FIBeneficiaryAdvice
+func (b *DrawdownBuilder) FIBeneficiaryAdvice(tag *FIBeneficiaryAdvice) *DrawdownBuilder {
+	CloneTag(&b.fwm.FIBeneficiaryAdvice, tag)
+	return b
+}
+
+// FIPaymentMethodToBeneficiary sets {6420} FIPaymentMethodToBeneficiary
+func (b *DrawdownBuilder) FIPaymentMethodToBeneficiary(tag *FIPaymentMethodToBeneficiary) *DrawdownBuilder {
+	CloneTag(&b.fwm.FIPaymentMethodToBeneficiary, tag)
+	return b
+}
+
+// FIAdditionalFIToFI sets {6500} FIAdditionalFIToFI
+func (b *DrawdownBuilder) FIAdditionalFIToFI(tag *FIAdditionalFIToFI) *DrawdownBuilder {
+	CloneTag(&b.fwm.FIAdditionalFIToFI, tag)
+	return b
+}
+
+// Build returns the message when it passes Validate, otherwise the errors of the setters and of Validate in a
+// base.ErrorList
+func (b *DrawdownBuilder) Build() (*FEDWireMessage, error) {
+	return b.build()
+}
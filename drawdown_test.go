@@ -0,0 +1,153 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockDrawdownIMAD creates an IMAD for the answer to a drawdown request in the cycle of cycleDate
+func mockDrawdownIMAD(cycleDate string) *InputMessageAccountabilityData {
+	imad := NewInputMessageAccountabilityData()
+	imad.InputCycleDate = cycleDate
+	imad.InputSource = "Source08"
+	imad.InputSequenceNumber = "000002"
+	return imad
+}
+
+// TestNewDrawdownResponse pays a customer drawdown request from the debited account
+func TestNewDrawdownResponse(t *testing.T) {
+	request := readFEDWireMessage(t, "fedWireMessage-CustomerCorporateDrawDownRequest.txt")
+
+	fwm, err := NewDrawdownResponse(&request, mockDrawdownIMAD("20190410"))
+	require.NoError(t, err)
+	require.Equal(t, DrawdownResponse, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, FundsTransfer, fwm.TypeSubType.TypeCode)
+	require.Equal(t, FundsTransferRequestCredit, fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, "20190410Source08000001", fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, request.Amount.Amount, fwm.Amount.Amount)
+	require.Equal(t, request.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.SenderDepositoryInstitution.SenderABANumber)
+	require.Equal(t, request.AccountCreditedDrawdown.DrawdownCreditAccountNumber, fwm.ReceiverDepositoryInstitution.ReceiverABANumber)
+	require.Equal(t, request.AccountDebitedDrawdown.Identifier, fwm.Originator.Personal.Identifier)
+	require.Equal(t, request.AccountDebitedDrawdown.Name, fwm.Originator.Personal.Name)
+	require.Equal(t, request.Beneficiary.Personal, fwm.Beneficiary.Personal)
+	require.Equal(t, request.BeneficiaryFI.FinancialInstitution, fwm.BeneficiaryFI.FinancialInstitution)
+	require.Equal(t, request.BeneficiaryReference.BeneficiaryReference, fwm.BeneficiaryReference.BeneficiaryReference)
+	require.Nil(t, fwm.AccountDebitedDrawdown)
+	require.Nil(t, fwm.AccountCreditedDrawdown)
+	require.NoError(t, fwm.Validate())
+
+	request = readFEDWireMessage(t, "fedWireMessage-BankDrawDownRequest.txt")
+	fwm, err = NewDrawdownResponse(&request, mockDrawdownIMAD("20190411"))
+	require.NoError(t, err)
+	require.Equal(t, SettlementTransfer, fwm.TypeSubType.TypeCode)
+	require.Equal(t, DrawdownResponse, fwm.BusinessFunctionCode.BusinessFunctionCode)
+
+	_, err = NewDrawdownResponse(&request, mockDrawdownIMAD("20190409"))
+	require.True(t, errors.Is(err, ErrDrawdownMismatch))
+}
+
+// TestNewDrawdownRefusal builds the refusal of fedWireMessage-DrawdownRefusal.txt from its request
+func TestNewDrawdownRefusal(t *testing.T) {
+	expected := readFEDWireMessage(t, "fedWireMessage-DrawdownRefusal.txt")
+	request := readFEDWireMessage(t, "fedWireMessage-CustomerCorporateDrawDownRequest.txt")
+	CloneTag(&request.Beneficiary, expected.Beneficiary)
+	CloneTag(&request.Originator, expected.Originator)
+	CloneTag(&request.AccountDebitedDrawdown, expected.AccountDebitedDrawdown)
+	CloneTag(&request.AccountCreditedDrawdown, expected.AccountCreditedDrawdown)
+
+	fwm, err := NewDrawdownRefusal(&request, mockDrawdownIMAD("20190411"), "INSUFFICIENT FUNDS")
+	require.NoError(t, err)
+	fwm.SenderReference = NewSenderReference()
+	fwm.SenderReference.SenderReference = "Refusal 1"
+	require.Empty(t, Diff(&expected, fwm))
+	require.NoError(t, ValidateDrawdownResponse(&request, &expected))
+
+	fwm, err = NewDrawdownRefusal(&request, mockDrawdownIMAD("20190410"))
+	require.NoError(t, err)
+	require.Nil(t, fwm.FIAdditionalFIToFI)
+	require.Equal(t, CustomerCorporateDrawdownRequest, fwm.BusinessFunctionCode.BusinessFunctionCode)
+}
+
+// TestNewDrawdownResponse_Request only answers a drawdown request
+func TestNewDrawdownResponse_Request(t *testing.T) {
+	transfer := readFEDWireMessage(t, "fedWireMessage-BankTransfer.txt")
+	_, err := NewDrawdownResponse(&transfer, mockDrawdownIMAD("20190410"))
+	require.True(t, errors.Is(err, ErrDrawdownRequest))
+
+	_, err = NewDrawdownRefusal(nil, mockDrawdownIMAD("20190410"))
+	require.True(t, errors.Is(err, ErrFieldRequired))
+
+	request := readFEDWireMessage(t, "fedWireMessage-BankDrawDownRequest.txt")
+	_, err = NewDrawdownRefusal(&request, nil)
+	require.True(t, errors.Is(err, ErrFieldRequired))
+
+	request.AccountCreditedDrawdown = nil
+	_, err = NewDrawdownResponse(&request, mockDrawdownIMAD("20190410"))
+	require.True(t, errors.Is(err, ErrFieldRequired))
+}
+
+// TestValidateDrawdownResponse reports the first field of a response which does not match its request
+func TestValidateDrawdownResponse(t *testing.T) {
+	request := readFEDWireMessage(t, "fedWireMessage-BankDrawDownRequest.txt")
+	response, err := NewDrawdownResponse(&request, mockDrawdownIMAD("20190410"))
+	require.NoError(t, err)
+	refusal, err := NewDrawdownRefusal(&request, mockDrawdownIMAD("20190410"), "REFUSED")
+	require.NoError(t, err)
+
+	tests := []struct {
+		field  string
+		answer *FEDWireMessage
+		change func(fwm *FEDWireMessage)
+	}{
+		{"Amount", response, func(fwm *FEDWireMessage) { fwm.Amount.Amount = "000000001000" }},
+		{"PreviousMessageIdentifier", response, func(fwm *FEDWireMessage) {
+			fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = "20190410Source08000002"
+		}},
+		{"SenderABANumber", response, func(fwm *FEDWireMessage) { fwm.SenderDepositoryInstitution.SenderABANumber = "121042882" }},
+		{"ReceiverABANumber", response, func(fwm *FEDWireMessage) { fwm.ReceiverDepositoryInstitution.ReceiverABANumber = "231380104" }},
+		{"Originator", response, func(fwm *FEDWireMessage) { fwm.Originator.Personal.Identifier = "987654321" }},
+		{"Beneficiary", response, func(fwm *FEDWireMessage) { fwm.Beneficiary.Personal.Identifier = "5678" }},
+		{"Beneficiary", response, func(fwm *FEDWireMessage) {
+			fwm.Beneficiary.Personal.Identifier = "00" + fwm.Beneficiary.Personal.Identifier
+		}},
+		{"BeneficiaryReference", response, func(fwm *FEDWireMessage) { fwm.BeneficiaryReference = nil }},
+		{"TypeCode", response, func(fwm *FEDWireMessage) { fwm.TypeSubType.TypeCode = FundsTransfer }},
+		{"BusinessFunctionCode", response, func(fwm *FEDWireMessage) { fwm.BusinessFunctionCode.BusinessFunctionCode = BankTransfer }},
+		{"BusinessFunctionCode", refusal, func(fwm *FEDWireMessage) {
+			fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerCorporateDrawdownRequest
+		}},
+		{"ReceiverABANumber", refusal, func(fwm *FEDWireMessage) { fwm.ReceiverDepositoryInstitution.ReceiverABANumber = "123456789" }},
+		{"AccountDebitedDrawdown", refusal, func(fwm *FEDWireMessage) { fwm.AccountDebitedDrawdown.Identifier = "987654321" }},
+		{"AccountDebitedDrawdown", refusal, func(fwm *FEDWireMessage) {
+			fwm.AccountDebitedDrawdown.Identifier = "0" + fwm.AccountDebitedDrawdown.Identifier
+		}},
+		{"AccountCreditedDrawdown", refusal, func(fwm *FEDWireMessage) {
+			fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber = "231380104"
+		}},
+		{"InputCycleDate", refusal, func(fwm *FEDWireMessage) { fwm.InputMessageAccountabilityData.InputCycleDate = "20190409" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			require.NoError(t, ValidateDrawdownResponse(&request, tt.answer))
+			answer := tt.answer.Clone()
+			tt.change(answer)
+
+			err := ValidateDrawdownResponse(&request, answer)
+			require.True(t, errors.Is(err, ErrDrawdownMismatch), "%v", err)
+			var fe *FieldError
+			require.True(t, errors.As(err, &fe))
+			require.Equal(t, tt.field, fe.FieldName)
+		})
+	}
+
+	// padding is not a mismatch
+	answer := response.Clone()
+	answer.Amount.Amount = "1234567"
+	require.NoError(t, ValidateDrawdownResponse(&request, answer))
+}
@@ -86,3 +86,22 @@ func (eRate *ExchangeRate) Validate() error {
 func (eRate *ExchangeRate) ExchangeRateField() string {
 	return eRate.alphaField(eRate.ExchangeRate, 12)
 }
+
+// Rate returns ExchangeRate as a Decimal, e.g. 1,2345 is 1.2345
+func (eRate *ExchangeRate) Rate() (Decimal, error) {
+	d, err := ParseDecimal(eRate.ExchangeRate)
+	if err != nil {
+		return Decimal{}, fieldError("ExchangeRate", err, eRate.ExchangeRate)
+	}
+	return d, nil
+}
+
+// SetRate sets ExchangeRate, formatting it with a comma decimal marker, e.g. 1.2345 as 1,2345
+func (eRate *ExchangeRate) SetRate(d Decimal) error {
+	s := d.commaString()
+	if d.Units < 0 || len(s) > 12 {
+		return fieldError("ExchangeRate", ErrMoneyRange, d.String())
+	}
+	eRate.ExchangeRate = s
+	return nil
+}
@@ -1,6 +1,7 @@
 package wire
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
@@ -55,3 +56,20 @@ func TestExchangeRateTagError(t *testing.T) {
 
 	require.EqualError(t, err, fieldError("tag", ErrValidTagForType, eRate.tag).Error())
 }
+
+// TestExchangeRateRate converts ExchangeRate to and from a Decimal
+func TestExchangeRateRate(t *testing.T) {
+	eRate := mockExchangeRate()
+	d, err := eRate.Rate()
+	require.NoError(t, err)
+	require.Equal(t, Decimal{Units: 12345, Scale: 4}, d)
+
+	require.NoError(t, eRate.SetRate(Decimal{Units: 9876543, Scale: 6}))
+	require.Equal(t, "9,876543", eRate.ExchangeRate)
+	require.NoError(t, eRate.Validate())
+	require.True(t, errors.Is(eRate.SetRate(Decimal{Units: 1234567890123, Scale: 2}), ErrMoneyRange))
+
+	eRate.ExchangeRate = "1,--0.00"
+	_, err = eRate.Rate()
+	require.True(t, errors.Is(err, ErrNonAmount))
+}
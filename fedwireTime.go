@@ -0,0 +1,80 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"time"
+	// embed the time zone database so America/New_York loads in images without one, e.g. FROM scratch
+	_ "time/tzdata"
+)
+
+const (
+	// ccyymmddLayout is the time layout of a Fedwire CCYYMMDD date
+	ccyymmddLayout = "20060102"
+	// mmddLayout is the time layout of a Fedwire MMDD date
+	mmddLayout = "0102"
+	// hhmmLayout is the time layout of a Fedwire HHMM time
+	hhmmLayout = "1504"
+)
+
+// FedwireLocation is the Eastern time zone in which the Fedwire Funds Service assigns cycle dates, receipt and output
+// times, including daylight saving time.
+var FedwireLocation = fedwireLocation()
+
+// fedwireLocation loads America/New_York from the system or the embedded time zone database. The embedded database
+// holds every zone, so loading cannot fail.
+func fedwireLocation() *time.Location {
+	loc, _ := time.LoadLocation("America/New_York")
+	return loc
+}
+
+// parseCCYYMMDD returns midnight of a CCYYMMDD date in loc
+func parseCCYYMMDD(s string, loc *time.Location) (time.Time, error) {
+	t, err := time.ParseInLocation(ccyymmddLayout, s, loc)
+	if err != nil {
+		return time.Time{}, ErrValidDate
+	}
+	return t, nil
+}
+
+// parseMMDDHHMM returns the time of an MMDD date and HHMM time in FedwireLocation. The year is taken from the cycle
+// date, or is the year before when the date is after the cycle date, e.g. a 1231 output date of a 20200102 cycle
+// date is in 2019.
+func parseMMDDHHMM(cycleDate time.Time, mmdd, hhmm string) (time.Time, error) {
+	date, err := time.Parse(mmddLayout, mmdd)
+	if err != nil || len(mmdd) != 4 {
+		return time.Time{}, ErrValidDate
+	}
+	clock, err := time.Parse(hhmmLayout, hhmm)
+	if err != nil || len(hhmm) != 4 {
+		return time.Time{}, ErrValidTime
+	}
+	year := cycleDate.Year()
+	if mmdd > cycleDate.Format(mmddLayout) {
+		year--
+	}
+	t := time.Date(year, date.Month(), date.Day(), clock.Hour(), clock.Minute(), 0, 0, FedwireLocation)
+	if t.Month() != date.Month() {
+		// 0229 of a year which is not a leap year
+		return time.Time{}, ErrValidDate
+	}
+	return t, nil
+}
+
+// ReceiptDateTime returns the {1110} ReceiptTimeStamp in FedwireLocation with the year inferred from the
+// {1520} InputCycleDate
+func (fwm *FEDWireMessage) ReceiptDateTime() (time.Time, error) {
+	if fwm.ReceiptTimeStamp == nil {
+		return time.Time{}, fieldError("ReceiptTimeStamp", ErrFieldRequired)
+	}
+	if fwm.InputMessageAccountabilityData == nil {
+		return time.Time{}, fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+	}
+	cycleDate, err := fwm.InputMessageAccountabilityData.CycleDate()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return fwm.ReceiptTimeStamp.ReceiptDateTime(cycleDate)
+}
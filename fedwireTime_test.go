@@ -0,0 +1,22 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestFedwireLocation is America/New_York with daylight saving time, not a fixed offset
+func TestFedwireLocation(t *testing.T) {
+	require.Equal(t, "America/New_York", FedwireLocation.String())
+
+	_, winter := time.Date(2020, time.January, 2, 12, 0, 0, 0, FedwireLocation).Zone()
+	require.Equal(t, -5*60*60, winter)
+	_, summer := time.Date(2020, time.July, 2, 12, 0, 0, 0, FedwireLocation).Zone()
+	require.Equal(t, -4*60*60, summer)
+}
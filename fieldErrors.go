@@ -42,6 +42,8 @@ var (
 	ErrValidCentury = errors.New("is an invalid century")
 	// ErrValidDate is returned for an invalid date
 	ErrValidDate = errors.New("is an invalid date format")
+	// ErrValidTime is returned for an invalid time
+	ErrValidTime = errors.New("is an invalid time format")
 	// ErrInvalidProperty is returned for an invalid type property
 	ErrInvalidProperty = errors.New("is an invalid property")
 
@@ -122,6 +124,71 @@ var (
 
 	// ErrOptionFName is returned for an invalid name for OriginatorOptionF
 	ErrOptionFName = errors.New("is an invalid name for originator optionF")
+
+	// CoverPayment {7033} - {7072}
+
+	// ErrSwiftFieldOption is returned for a SwiftFieldTag which is not a SWIFT field option permitted for the tag
+	ErrSwiftFieldOption = errors.New("is not a SWIFT field option permitted for the tag")
+	// ErrSwiftFieldLine is returned for a SWIFT line which does not follow the format of the SWIFT field option
+	ErrSwiftFieldLine = errors.New("is an invalid line for the SWIFT field option")
+
+	// ISO 20022
+
+	// ErrISOBusinessFunctionCode is returned when a FEDWireMessage business function code has no mapping to the requested ISO 20022 message
+	ErrISOBusinessFunctionCode = errors.New("is not a business function code of the requested ISO 20022 message")
+	// ErrISOTypeSubType is returned when a FEDWireMessage type and subtype has no mapping to the requested ISO 20022 message
+	ErrISOTypeSubType = errors.New("is not a type and subtype of the requested ISO 20022 message")
+
+	// CSV
+
+	// ErrCSVColumn is returned when a CSV header contains a column which is not in CSVColumns
+	ErrCSVColumn = errors.New("is not a recognized CSV column")
+	// ErrCSVDuplicateColumn is returned when a CSV header contains the same column more than once
+	ErrCSVDuplicateColumn = errors.New("is a duplicate CSV column")
+
+	// Money
+
+	// ErrMoneyPrecision is returned when an amount has more decimal places than the currency or field allows
+	ErrMoneyPrecision = errors.New("has more decimal places than allowed")
+	// ErrMoneyRange is returned when an amount is negative or does not fit in the field
+	ErrMoneyRange = errors.New("is outside the range of the field")
+	// ErrMoneyCurrency is returned when an amount is not in the currency of the field
+	ErrMoneyCurrency = errors.New("is not the currency of the field")
+	// ErrMoneyCount is returned when more amounts are given than the tag holds
+	ErrMoneyCount = errors.New("is more amounts than the tag holds")
+
+	// Reversal
+
+	// ErrReversalSubType is returned when the original message of a reversal is not a basic funds transfer
+	ErrReversalSubType = errors.New("is not a basic funds transfer which can be reversed")
+	// ErrReversalCycleDate is returned when the cycle date of a reversal is not permitted by its subtype
+	ErrReversalCycleDate = errors.New("is not a cycle date permitted for the reversal subtype")
+
+	// Drawdown
+
+	// ErrDrawdownRequest is returned when a message answered as a drawdown request is not a DRB or DRC request for credit
+	ErrDrawdownRequest = errors.New("is not a drawdown request")
+	// ErrDrawdownMismatch is returned when a drawdown payment or refusal does not match its drawdown request
+	ErrDrawdownMismatch = errors.New("does not match the drawdown request")
+
+	// Field paths
+
+	// ErrFieldPath is returned for a path which is not the path of a field of a FEDWireMessage
+	ErrFieldPath = errors.New("is not a field path")
+
+	// Screening
+
+	// ErrSDNEntry is returned for a row of an SDN list without an entry number or name
+	ErrSDNEntry = errors.New("is not an SDN entry")
+	// ErrSDNDuplicateEntry is returned for an SDN entry number which is already in the list
+	ErrSDNDuplicateEntry = errors.New("is a duplicate SDN entry")
+	// ErrSDNAlias is returned for an alias without a name, or whose entry number is not in the list
+	ErrSDNAlias = errors.New("is not an alias of an SDN entry")
+
+	// Travel rule
+
+	// ErrTravelRuleAccount is returned when the originator is identified by something other than an account number
+	ErrTravelRuleAccount = errors.New("is not an account number identification")
 )
 
 // FieldError is returned for errors at a field level in a tag
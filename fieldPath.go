@@ -0,0 +1,180 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// CharacterClass is the class of characters Validate permits in a field
+type CharacterClass string
+
+const (
+	// CharacterClassAlphanumeric is the Fedwire character set of the tag format
+	CharacterClassAlphanumeric CharacterClass = "alphanumeric"
+	// CharacterClassNumeric is the digits 0-9
+	CharacterClassNumeric CharacterClass = "numeric"
+	// CharacterClassAmount is an amount of digits with a decimal comma or point, or an implied decimal amount
+	CharacterClassAmount CharacterClass = "amount"
+	// CharacterClassDate is a CCYYMMDD date
+	CharacterClassDate CharacterClass = "date"
+	// CharacterClassCurrency is an ISO 4217 currency code
+	CharacterClassCurrency CharacterClass = "currency"
+	// CharacterClassCode is one of the Codes of the field
+	CharacterClassCode CharacterClass = "code"
+)
+
+// fieldCharacterClasses holds the class of fields which are not alphanumeric or codes, keyed by struct and field name
+var fieldCharacterClasses = map[string]CharacterClass{
+	"AccountCreditedDrawdown.DrawdownCreditAccountNumber": CharacterClassNumeric,
+	"Amount.Amount":                                      CharacterClassAmount,
+	"CurrencyInstructedAmount.CurrencyCode":              CharacterClassCurrency,
+	"CurrencyInstructedAmount.Amount":                    CharacterClassAmount,
+	"DateRemittanceDocument.DateRemittanceDocument":      CharacterClassDate,
+	"ExchangeRate.ExchangeRate":                          CharacterClassAmount,
+	"InputMessageAccountabilityData.InputCycleDate":      CharacterClassDate,
+	"InputMessageAccountabilityData.InputSequenceNumber": CharacterClassNumeric,
+	"InstructedAmount.CurrencyCode":                      CharacterClassCurrency,
+	"InstructedAmount.Amount":                            CharacterClassAmount,
+	"PaymentNotification.PaymentNotificationIndicator":   CharacterClassNumeric,
+	"ReceiverDepositoryInstitution.ReceiverABANumber":    CharacterClassNumeric,
+	"RemittanceAmount.CurrencyCode":                      CharacterClassCurrency,
+	"RemittanceAmount.Amount":                            CharacterClassAmount,
+	"SenderDepositoryInstitution.SenderABANumber":        CharacterClassNumeric,
+}
+
+// FieldInfo describes a field of a FEDWireMessage by its path
+type FieldInfo struct {
+	// Path is the JSON path of the field, e.g. beneficiary.personal.name
+	Path string `json:"path"`
+	// Tag holding the field, e.g. {4200}, which is empty for the ID of the message
+	Tag string `json:"tag,omitempty"`
+	// MaxWidth is the width the tag writes the field to, or 0 when the width is not fixed by the tag
+	MaxWidth int `json:"maxWidth,omitempty"`
+	// CharacterClass of the field
+	CharacterClass CharacterClass `json:"characterClass"`
+	// Codes permitted for a field of CharacterClassCode
+	Codes []string `json:"codes,omitempty"`
+}
+
+// fieldInfos holds the FieldInfo of every path in tag order, and fieldPaths the index of each path in fieldInfos and
+// csvColumns
+var (
+	fieldInfos = newFieldInfos()
+	fieldPaths = func() map[string]int {
+		paths := make(map[string]int, len(csvColumns))
+		for i := range csvColumns {
+			paths[csvColumns[i].name] = i
+		}
+		return paths
+	}()
+)
+
+// newFieldInfos returns the FieldInfo of every CSV column
+func newFieldInfos() []FieldInfo {
+	t := reflect.TypeOf(FEDWireMessage{})
+	widths := make(map[int]map[string]int)
+	tags := make(map[int]string)
+	infos := make([]FieldInfo, len(csvColumns))
+	for n, column := range csvColumns {
+		st, field := t, t.Field(column.index[0])
+		for _, i := range column.index[1:] {
+			st = field.Type
+			if st.Kind() == reflect.Ptr {
+				st = st.Elem()
+			}
+			field = st.Field(i)
+		}
+
+		info := FieldInfo{Path: column.name, CharacterClass: CharacterClassAlphanumeric}
+		if top := t.Field(column.index[0]).Type; top.Kind() == reflect.Ptr {
+			if _, ok := widths[column.index[0]]; !ok {
+				widths[column.index[0]] = jsonSchemaWidths(top.Elem())
+				tags[column.index[0]] = newTag(top.Elem()).Elem().FieldByName("tag").String()
+			}
+			info.Tag = tags[column.index[0]]
+			info.MaxWidth = widths[column.index[0]][field.Name]
+		}
+		key := st.Name() + "." + field.Name
+		info.Codes = fieldCodes(key)
+		if class, ok := fieldCharacterClasses[key]; ok {
+			info.CharacterClass = class
+		} else if len(info.Codes) > 0 {
+			info.CharacterClass = CharacterClassCode
+		}
+		infos[n] = info
+	}
+	return infos
+}
+
+// newTag returns a new tag of type t, which is set by the tag's JSON decoding
+func newTag(t reflect.Type) reflect.Value {
+	v := reflect.New(t)
+	if u, ok := v.Interface().(json.Unmarshaler); ok {
+		u.UnmarshalJSON([]byte("{}"))
+	}
+	return v
+}
+
+// Paths returns the path of every field of every tag in FEDWireMessage in tag order, which are the CSVColumns.
+// Each path is the JSON path of its field, e.g. "amount.amount" or "beneficiary.personal.address.addressLineOne".
+func Paths() []string {
+	return CSVColumns()
+}
+
+// Fields returns the FieldInfo of every path in the order of Paths
+func Fields() []FieldInfo {
+	infos := make([]FieldInfo, len(fieldInfos))
+	for i, info := range fieldInfos {
+		info.Codes = append([]string(nil), info.Codes...)
+		infos[i] = info
+	}
+	return infos
+}
+
+// LookupField returns the FieldInfo of path, or an ErrFieldPath when path is not one of Paths
+func LookupField(path string) (FieldInfo, error) {
+	i, ok := fieldPaths[path]
+	if !ok {
+		return FieldInfo{}, fieldError("path", ErrFieldPath, path)
+	}
+	info := fieldInfos[i]
+	info.Codes = append([]string(nil), info.Codes...)
+	return info, nil
+}
+
+// Get returns the value of the field at path, which is "" when its tag is not in the message
+func (fwm *FEDWireMessage) Get(path string) (string, error) {
+	i, ok := fieldPaths[path]
+	if !ok {
+		return "", fieldError("path", ErrFieldPath, path)
+	}
+	return csvValue(reflect.ValueOf(fwm), csvColumns[i].index), nil
+}
+
+// Set sets the field at path to value, adding its tag to the message when it is not present and value is not blank.
+// The value is not validated, call Validate once the fields are set.
+func (fwm *FEDWireMessage) Set(path, value string) error {
+	i, ok := fieldPaths[path]
+	if !ok {
+		return fieldError("path", ErrFieldPath, path)
+	}
+	v := reflect.ValueOf(fwm).Elem()
+	for _, index := range csvColumns[i].index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if value == "" {
+					return nil
+				}
+				v.Set(newTag(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(index)
+	}
+	v.SetString(value)
+	return nil
+}
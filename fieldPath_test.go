@@ -0,0 +1,124 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFEDWireMessage_Get(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+
+	name, err := fwm.Get("beneficiary.personal.name")
+	require.NoError(t, err)
+	require.Equal(t, fwm.Beneficiary.Personal.Name, name)
+
+	line, err := fwm.Get("originator.personal.address.addressLineOne")
+	require.NoError(t, err)
+	require.Equal(t, fwm.Originator.Personal.Address.AddressLineOne, line)
+
+	// a tag which is not in the message
+	fwm.OrderingCustomer = nil
+	tag, err := fwm.Get("orderingCustomer.coverPayment.swiftFieldTag")
+	require.NoError(t, err)
+	require.Equal(t, "", tag)
+
+	_, err = fwm.Get("beneficiary.personal.nickname")
+	require.True(t, errors.Is(err, ErrFieldPath))
+}
+
+func TestFEDWireMessage_Set(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+
+	require.NoError(t, fwm.Set("beneficiary.personal.name", "Jane Doe"))
+	require.Equal(t, "Jane Doe", fwm.Beneficiary.Personal.Name)
+
+	// the tag is added when it is not in the message
+	fwm.BeneficiaryReference = nil
+	require.NoError(t, fwm.Set("beneficiaryReference.beneficiaryReference", "Invoice 1234"))
+	require.NotNil(t, fwm.BeneficiaryReference)
+	require.Equal(t, "{4320}Invoice 1234*", fwm.BeneficiaryReference.String())
+	require.NoError(t, fwm.Validate())
+
+	// unless the value is blank
+	fwm.SenderReference = nil
+	require.NoError(t, fwm.Set("senderReference.senderReference", ""))
+	require.Nil(t, fwm.SenderReference)
+
+	err := fwm.Set("beneficiary.personal", "Jane Doe")
+	require.True(t, errors.Is(err, ErrFieldPath))
+}
+
+func TestPaths(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.txt")
+	copied := FEDWireMessage{}
+	for _, path := range Paths() {
+		value, err := fwm.Get(path)
+		require.NoError(t, err, path)
+		require.NoError(t, copied.Set(path, value), path)
+	}
+	require.Empty(t, Diff(&fwm, &copied))
+}
+
+func TestLookupField(t *testing.T) {
+	require.Len(t, Fields(), len(Paths()))
+
+	info, err := LookupField("beneficiary.personal.name")
+	require.NoError(t, err)
+	require.Equal(t, FieldInfo{
+		Path:           "beneficiary.personal.name",
+		Tag:            TagBeneficiary,
+		MaxWidth:       35,
+		CharacterClass: CharacterClassAlphanumeric,
+	}, info)
+
+	info, err = LookupField("beneficiary.personal.identificationCode")
+	require.NoError(t, err)
+	require.Equal(t, CharacterClassCode, info.CharacterClass)
+	require.Equal(t, 1, info.MaxWidth)
+	for _, code := range IdentificationCodes() {
+		require.Contains(t, info.Codes, code.Value)
+	}
+
+	// the codes are those of the field's catalog, in catalog order
+	info, err = LookupField("fiBeneficiaryAdvice.advice.adviceCode")
+	require.NoError(t, err)
+	var adviceCodes []string
+	for _, code := range AdviceCodes() {
+		adviceCodes = append(adviceCodes, code.Value)
+	}
+	require.Equal(t, adviceCodes, info.Codes)
+
+	info, err = LookupField("amount.amount")
+	require.NoError(t, err)
+	require.Equal(t, CharacterClassAmount, info.CharacterClass)
+	require.Equal(t, 12, info.MaxWidth)
+
+	info, err = LookupField("senderDepositoryInstitution.senderABANumber")
+	require.NoError(t, err)
+	require.Equal(t, CharacterClassNumeric, info.CharacterClass)
+	require.Equal(t, 9, info.MaxWidth)
+
+	info, err = LookupField("inputMessageAccountabilityData.inputCycleDate")
+	require.NoError(t, err)
+	require.Equal(t, CharacterClassDate, info.CharacterClass)
+
+	info, err = LookupField("id")
+	require.NoError(t, err)
+	require.Equal(t, "", info.Tag)
+	require.Equal(t, 0, info.MaxWidth)
+
+	// the codes returned are a copy
+	info, _ = LookupField("typeSubType.typeCode")
+	info.Codes[0] = "99"
+	info, _ = LookupField("typeSubType.typeCode")
+	require.Equal(t, FundsTransfer, info.Codes[0])
+
+	_, err = LookupField("amount")
+	require.True(t, errors.Is(err, ErrFieldPath))
+}
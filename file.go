@@ -61,3 +61,25 @@ func FileFromJSON(bs []byte) (*File, error) {
 	}
 	return file, nil
 }
+
+// FileFromJSONStrict returns a *File from JSON which conforms to NewJSONSchema.
+//
+// Unlike FileFromJSON unknown fields are rejected, and every schema violation is returned in a base.ErrorList
+// naming the JSON path of the value. The File returned may still not be valid and callers should confirm with
+// Validate().
+func FileFromJSONStrict(bs []byte) (*File, error) {
+	if len(bs) == 0 {
+		return nil, nil
+	}
+	if err := NewJSONSchema().Validate(bs); err != nil {
+		return nil, err
+	}
+
+	file := NewFile()
+	dec := json.NewDecoder(bytes.NewReader(bs))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(file); err != nil {
+		return nil, fmt.Errorf("problem reading File: %v", err)
+	}
+	return file, nil
+}
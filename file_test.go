@@ -5,10 +5,12 @@
 package wire
 
 import (
+	"errors"
 	"io/ioutil"
 	"path/filepath"
 	"testing"
 
+	"github.com/moov-io/base"
 	"github.com/stretchr/testify/require"
 )
 
@@ -27,3 +29,33 @@ func TestFile__FileFromJSON(t *testing.T) {
 	require.Empty(t, file.ID, "id should not have been set")
 	require.NotNil(t, file.FEDWireMessage.FIAdditionalFIToFI, "FIAdditionalFIToFI shouldn't be nil")
 }
+
+func TestFile__FileFromJSONStrict(t *testing.T) {
+	bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-BankTransfer.json"))
+	require.NoError(t, err)
+
+	file, err := FileFromJSONStrict(bs)
+	require.NoError(t, err)
+	require.NoError(t, file.Validate())
+	require.Equal(t, TagFIAdditionalFIToFI, file.FEDWireMessage.FIAdditionalFIToFI.tag)
+
+	// fedWireMessage-CustomerTransferPlusUnstructuredAddenda.json holds a blank transactionTypeCode of spaces and an
+	// addendaLength which is not a field
+	bs, err = ioutil.ReadFile(filepath.Join("test", "testdata", "fedWireMessage-CustomerTransferPlusUnstructuredAddenda.json"))
+	require.NoError(t, err)
+	_, err = FileFromJSON(bs)
+	require.NoError(t, err)
+	file, err = FileFromJSONStrict(bs)
+	require.Nil(t, file)
+	var list base.ErrorList
+	require.True(t, errors.As(err, &list))
+	require.Len(t, list, 2)
+	require.True(t, errors.Is(list[0], ErrJSONSchemaEnum))
+	require.Contains(t, list[0].Error(), "fedWireMessage.businessFunctionCode.transactionTypeCode")
+	require.True(t, errors.Is(list[1], ErrJSONSchemaUnknownField))
+	require.Contains(t, list[1].Error(), "fedWireMessage.unstructuredAddenda.addendaLength")
+
+	file, err = FileFromJSONStrict(nil)
+	require.Nil(t, file)
+	require.NoError(t, err)
+}
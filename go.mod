@@ -1,6 +1,6 @@
 module github.com/moov-io/wire
 
-go 1.12
+go 1.15
 
 require (
 	github.com/antihax/optional v1.0.0
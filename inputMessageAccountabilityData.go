@@ -7,6 +7,7 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"time"
 )
 
 // InputMessageAccountabilityData (IMAD) {1520}
@@ -130,3 +131,17 @@ func (imad *InputMessageAccountabilityData) InputSourceField() string {
 func (imad *InputMessageAccountabilityData) InputSequenceNumberField() string {
 	return imad.alphaField(imad.InputSequenceNumber, 6)
 }
+
+// CycleDate returns InputCycleDate as midnight in FedwireLocation
+func (imad *InputMessageAccountabilityData) CycleDate() (time.Time, error) {
+	t, err := parseCCYYMMDD(imad.InputCycleDate, FedwireLocation)
+	if err != nil {
+		return t, fieldError("InputCycleDate", err, imad.InputCycleDate)
+	}
+	return t, nil
+}
+
+// SetCycleDate sets InputCycleDate to the calendar date of t
+func (imad *InputMessageAccountabilityData) SetCycleDate(t time.Time) {
+	imad.InputCycleDate = t.Format(ccyymmddLayout)
+}
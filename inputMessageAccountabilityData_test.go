@@ -1,6 +1,7 @@
 package wire
 
 import (
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -97,3 +98,19 @@ func TestInputMessageAccountabilityDataInputCycleDateError(t *testing.T) {
 
 	require.EqualError(t, imad.Validate(), fieldError("InputCycleDate", ErrValidDate, imad.InputCycleDate).Error())
 }
+
+// TestInputMessageAccountabilityDataCycleDate converts InputCycleDate to and from a time.Time
+func TestInputMessageAccountabilityDataCycleDate(t *testing.T) {
+	imad := mockInputMessageAccountabilityData()
+	imad.InputCycleDate = "20190502"
+	date, err := imad.CycleDate()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.May, 2, 0, 0, 0, 0, FedwireLocation), date)
+
+	imad.SetCycleDate(time.Date(2020, time.February, 29, 23, 0, 0, 0, time.UTC))
+	require.Equal(t, "20200229", imad.InputCycleDate)
+
+	imad.InputCycleDate = "20190230"
+	_, err = imad.CycleDate()
+	require.True(t, errors.Is(err, ErrValidDate))
+}
@@ -114,6 +114,9 @@ func (iAccount *InstitutionAccount) Validate() error {
 	if err := iAccount.isAlphanumeric(iAccount.CoverPayment.SwiftLineFive); err != nil {
 		return fieldError("SwiftLineFive", err, iAccount.CoverPayment.SwiftLineFive)
 	}
+	if err := iAccount.validateCoverPayment(TagInstitutionAccount, iAccount.CoverPayment); err != nil {
+		return err
+	}
 	return nil
 }
 
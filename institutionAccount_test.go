@@ -10,8 +10,8 @@ import (
 //  InstitutionAccount creates a InstitutionAccount
 func mockInstitutionAccount() *InstitutionAccount {
 	iAccount := NewInstitutionAccount()
-	iAccount.CoverPayment.SwiftFieldTag = "Swift Field Tag"
-	iAccount.CoverPayment.SwiftLineOne = "Swift Line One"
+	iAccount.CoverPayment.SwiftFieldTag = "57D"
+	iAccount.CoverPayment.SwiftLineOne = "/123456789"
 	iAccount.CoverPayment.SwiftLineTwo = "Swift Line Two"
 	iAccount.CoverPayment.SwiftLineThree = "Swift Line Three"
 	iAccount.CoverPayment.SwiftLineFour = "Swift Line Four"
@@ -118,3 +118,23 @@ func TestInstitutionAccountTagError(t *testing.T) {
 
 	require.EqualError(t, iAccount.Validate(), fieldError("tag", ErrValidTagForType, iAccount.tag).Error())
 }
+
+// TestInstitutionAccountSwiftFieldOption validates InstitutionAccount SwiftFieldTag is a SWIFT field option permitted for the tag
+func TestInstitutionAccountSwiftFieldOption(t *testing.T) {
+	iAccount := mockInstitutionAccount()
+	iAccount.CoverPayment.SwiftFieldTag = "56A"
+
+	err := iAccount.Validate()
+
+	require.EqualError(t, err, fieldError("SwiftFieldTag", ErrSwiftFieldOption, iAccount.CoverPayment.SwiftFieldTag).Error())
+}
+
+// TestInstitutionAccountSwiftFieldLine validates InstitutionAccount SWIFT lines follow the format of the SWIFT field option
+func TestInstitutionAccountSwiftFieldLine(t *testing.T) {
+	iAccount := mockInstitutionAccount()
+	iAccount.CoverPayment.SwiftFieldTag = "57A"
+
+	err := iAccount.Validate()
+
+	require.EqualError(t, err, fieldError("SwiftLineThree", ErrSwiftFieldLine, iAccount.CoverPayment.SwiftLineThree).Error())
+}
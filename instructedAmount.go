@@ -113,3 +113,21 @@ func (ia *InstructedAmount) CurrencyCodeField() string {
 func (ia *InstructedAmount) AmountField() string {
 	return ia.alphaField(ia.Amount, 15)
 }
+
+// Money returns the instructed amount, e.g. USD and 1234,56 is USD 1234.56
+func (ia *InstructedAmount) Money() (Money, error) {
+	return ParseMoney(ia.CurrencyCode, ia.Amount)
+}
+
+// SetMoney sets CurrencyCode and Amount, formatting Amount with a comma decimal marker, e.g. 1234,56
+func (ia *InstructedAmount) SetMoney(m Money) error {
+	if err := ia.isCurrencyCode(m.Currency); err != nil {
+		return fieldError("CurrencyCode", err, m.Currency)
+	}
+	amount, err := m.wireAmount(15)
+	if err != nil {
+		return err
+	}
+	ia.CurrencyCode, ia.Amount = m.Currency, amount
+	return nil
+}
@@ -1,6 +1,7 @@
 package wire
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
@@ -84,3 +85,23 @@ func TestInstructedAmountTagError(t *testing.T) {
 
 	require.EqualError(t, ia.Validate(), fieldError("tag", ErrValidTagForType, ia.tag).Error())
 }
+
+// TestInstructedAmountMoney converts the instructed amount to and from Money
+func TestInstructedAmountMoney(t *testing.T) {
+	ia := mockInstructedAmount()
+	m, err := ia.Money()
+	require.NoError(t, err)
+	require.Equal(t, Money{Currency: "USD", Amount: Decimal{Units: 456789, Scale: 2}}, m)
+
+	require.NoError(t, ia.SetMoney(NewMoney("BHD", 1234567)))
+	require.Equal(t, "BHD", ia.CurrencyCode)
+	require.Equal(t, "1234,567", ia.Amount)
+	require.NoError(t, ia.Validate())
+
+	require.True(t, errors.Is(ia.SetMoney(NewMoney("XZP", 1)), ErrNonCurrencyCode))
+	require.True(t, errors.Is(ia.SetMoney(NewMoney("USD", 1234567890123456)), ErrMoneyRange))
+
+	ia.Amount = "X,"
+	_, err = ia.Money()
+	require.True(t, errors.Is(err, ErrNonAmount))
+}
@@ -114,6 +114,9 @@ func (ii *IntermediaryInstitution) Validate() error {
 	if err := ii.isAlphanumeric(ii.CoverPayment.SwiftLineFive); err != nil {
 		return fieldError("SwiftLineFive", err, ii.CoverPayment.SwiftLineFive)
 	}
+	if err := ii.validateCoverPayment(TagIntermediaryInstitution, ii.CoverPayment); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -10,8 +10,8 @@ import (
 //  IntermediaryInstitution creates a IntermediaryInstitution
 func mockIntermediaryInstitution() *IntermediaryInstitution {
 	ii := NewIntermediaryInstitution()
-	ii.CoverPayment.SwiftFieldTag = "Swift Field Tag"
-	ii.CoverPayment.SwiftLineOne = "Swift Line One"
+	ii.CoverPayment.SwiftFieldTag = "56D"
+	ii.CoverPayment.SwiftLineOne = "/123456789"
 	ii.CoverPayment.SwiftLineTwo = "Swift Line Two"
 	ii.CoverPayment.SwiftLineThree = "Swift Line Three"
 	ii.CoverPayment.SwiftLineFour = "Swift Line Four"
@@ -118,3 +118,23 @@ func TestIntermediaryInstitutionTagError(t *testing.T) {
 
 	require.EqualError(t, ii.Validate(), fieldError("tag", ErrValidTagForType, ii.tag).Error())
 }
+
+// TestIntermediaryInstitutionSwiftFieldOption validates IntermediaryInstitution SwiftFieldTag is a SWIFT field option permitted for the tag
+func TestIntermediaryInstitutionSwiftFieldOption(t *testing.T) {
+	ii := mockIntermediaryInstitution()
+	ii.CoverPayment.SwiftFieldTag = "57A"
+
+	err := ii.Validate()
+
+	require.EqualError(t, err, fieldError("SwiftFieldTag", ErrSwiftFieldOption, ii.CoverPayment.SwiftFieldTag).Error())
+}
+
+// TestIntermediaryInstitutionSwiftFieldLine validates IntermediaryInstitution SWIFT lines follow the format of the SWIFT field option
+func TestIntermediaryInstitutionSwiftFieldLine(t *testing.T) {
+	ii := mockIntermediaryInstitution()
+	ii.CoverPayment.SwiftFieldTag = "56A"
+
+	err := ii.Validate()
+
+	require.EqualError(t, err, fieldError("SwiftLineThree", ErrSwiftFieldLine, ii.CoverPayment.SwiftLineThree).Error())
+}
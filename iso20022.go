@@ -0,0 +1,1139 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ISO 20022 message components shared by the Fedwire <-> ISO 20022 converters.
+//
+// Fields are declared in XML schema sequence order so marshaled documents are schema valid. Only the
+// elements a Fedwire message can populate are modeled.
+//
+// Mapping conventions used by every converter:
+//   * {1520} IMAD is the group header MsgId (CCYYMMDD + source + sequence, 22 characters)
+//   * {1510} TypeSubType is PmtTpInf/CtgyPurp/Prtry (e.g. 1000)
+//   * {3600} BusinessFunctionCode is PmtTpInf/LclInstrm/Prtry (e.g. BTR)
+//   * {3100} and {3400} are InstgAgt and InstdAgt identified by USABA clearing system member id
+//   * FinancialInstitution and Personal identification codes map to BICFI (B), ClrSysMmbId USABA (F),
+//     ClrSysMmbId USPID (C), an account (D) or Othr with the Fedwire code as the proprietary scheme name
+//   * {6xxx} FI to FI information is carried in InstrForNxtAgt using SWIFT field 72 style code words
+//     (/REC/, /INT/, /ACC/, /BNF/ ...) with "//" continuation lines.
+
+const (
+	// isoDateFormat is the ISO 20022 ISODate layout
+	isoDateFormat = "2006-01-02"
+	// isoDateTimeFormat is the ISO 20022 ISODateTime layout
+	isoDateTimeFormat = "2006-01-02T15:04:05"
+	// isoNotProvided is used for mandatory references that are not present in the Fedwire message
+	isoNotProvided = "NOTPROVIDED"
+	// isoClearingSystemFedwire is the ISO code for the Fedwire Funds Service
+	isoClearingSystemFedwire = "FDW"
+	// isoClearingABA is the ISO clearing system identification code of a Fed routing number
+	isoClearingABA = "USABA"
+	// isoClearingCHIPS is the ISO clearing system identification code of a CHIPS participant
+	isoClearingCHIPS = "USPID"
+	// isoCurrencyUSD is the settlement currency of every Fedwire funds transfer
+	isoCurrencyUSD = "USD"
+)
+
+var (
+	// ErrISODocument is returned when an ISO 20022 document is missing elements required for conversion
+	ErrISODocument = errors.New("is missing from the ISO 20022 document")
+	// ErrISOCancellationStatus is returned when a camt.029 does not refuse a cancellation, accepted cancellations are sent as a pacs.004 reversal transfer
+	ErrISOCancellationStatus = errors.New("is not a refused cancellation")
+	// ErrISOStatus is returned when an ISO 20022 status has no mapping to a Fedwire message
+	ErrISOStatus = errors.New("is an unsupported ISO 20022 status")
+	// ErrISOMessageStatusIndicator is returned when a {1100} MessageStatusIndicator has no mapping to an ISO 20022 status
+	ErrISOMessageStatusIndicator = errors.New("has no mapping to an ISO 20022 status")
+	// ErrISOTransactionCount is returned when an ISO 20022 document does not hold exactly one transaction
+	ErrISOTransactionCount = errors.New("must contain exactly one transaction")
+)
+
+// ISODocument is an ISO 20022 document which can be written as XML
+type ISODocument interface {
+	XML() ([]byte, error)
+}
+
+// ISOGroupHeader is the GrpHdr of an ISO 20022 payments message
+type ISOGroupHeader struct {
+	MessageID            string             `xml:"MsgId"`
+	CreationDateTime     string             `xml:"CreDtTm"`
+	NumberOfTransactions string             `xml:"NbOfTxs,omitempty"`
+	SettlementInfo       *ISOSettlementInfo `xml:"SttlmInf,omitempty"`
+	InstructingAgent     *ISOAgent          `xml:"InstgAgt,omitempty"`
+	InstructedAgent      *ISOAgent          `xml:"InstdAgt,omitempty"`
+}
+
+// ISOSettlementInfo is the SttlmInf of an ISO 20022 group header
+type ISOSettlementInfo struct {
+	SettlementMethod string   `xml:"SttlmMtd"`
+	ClearingSystem   *ISOCode `xml:"ClrSys,omitempty"`
+}
+
+// ISOCode is an ISO 20022 choice between an external code and a proprietary value
+type ISOCode struct {
+	Code        string `xml:"Cd,omitempty"`
+	Proprietary string `xml:"Prtry,omitempty"`
+}
+
+// ISOAmount is an ISO 20022 ActiveCurrencyAndAmount
+type ISOAmount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+// ISOAmountChoice is an ISO 20022 AmountType choice
+type ISOAmountChoice struct {
+	InstructedAmount *ISOAmount `xml:"InstdAmt,omitempty"`
+}
+
+// ISODateChoice is an ISO 20022 DateAndDateTime choice
+type ISODateChoice struct {
+	Date     string `xml:"Dt,omitempty"`
+	DateTime string `xml:"DtTm,omitempty"`
+}
+
+// ISOPaymentID is the PmtId of an ISO 20022 transaction
+type ISOPaymentID struct {
+	InstructionID string `xml:"InstrId,omitempty"`
+	EndToEndID    string `xml:"EndToEndId"`
+	TransactionID string `xml:"TxId,omitempty"`
+}
+
+// ISOPaymentTypeInfo is the PmtTpInf of an ISO 20022 transaction
+type ISOPaymentTypeInfo struct {
+	LocalInstrument *ISOCode `xml:"LclInstrm,omitempty"`
+	CategoryPurpose *ISOCode `xml:"CtgyPurp,omitempty"`
+}
+
+// ISOAgent is an ISO 20022 BranchAndFinancialInstitutionIdentification
+type ISOAgent struct {
+	FinancialInstitutionID ISOFinancialInstitutionID `xml:"FinInstnId"`
+}
+
+// ISOFinancialInstitutionID is an ISO 20022 FinancialInstitutionIdentification
+type ISOFinancialInstitutionID struct {
+	BICFI                  string                     `xml:"BICFI,omitempty"`
+	ClearingSystemMemberID *ISOClearingSystemMemberID `xml:"ClrSysMmbId,omitempty"`
+	Name                   string                     `xml:"Nm,omitempty"`
+	PostalAddress          *ISOPostalAddress          `xml:"PstlAdr,omitempty"`
+	Other                  *ISOGenericID              `xml:"Othr,omitempty"`
+}
+
+// ISOClearingSystemMemberID is an ISO 20022 ClearingSystemMemberIdentification
+type ISOClearingSystemMemberID struct {
+	ClearingSystemID ISOCode `xml:"ClrSysId"`
+	MemberID         string  `xml:"MmbId"`
+}
+
+// ISOPostalAddress is an ISO 20022 PostalAddress
+type ISOPostalAddress struct {
+	AddressType        *ISOCode `xml:"AdrTp,omitempty"`
+	Department         string   `xml:"Dept,omitempty"`
+	SubDepartment      string   `xml:"SubDept,omitempty"`
+	StreetName         string   `xml:"StrtNm,omitempty"`
+	BuildingNumber     string   `xml:"BldgNb,omitempty"`
+	PostCode           string   `xml:"PstCd,omitempty"`
+	TownName           string   `xml:"TwnNm,omitempty"`
+	CountrySubDivision string   `xml:"CtrySubDvsn,omitempty"`
+	Country            string   `xml:"Ctry,omitempty"`
+	AddressLines       []string `xml:"AdrLine,omitempty"`
+}
+
+// ISOGenericID is an ISO 20022 generic identification with an optional scheme name and issuer
+type ISOGenericID struct {
+	ID         string   `xml:"Id"`
+	SchemeName *ISOCode `xml:"SchmeNm,omitempty"`
+	Issuer     string   `xml:"Issr,omitempty"`
+}
+
+// ISOAccount is an ISO 20022 CashAccount
+type ISOAccount struct {
+	ID ISOAccountID `xml:"Id"`
+}
+
+// ISOAccountID is an ISO 20022 AccountIdentification choice
+type ISOAccountID struct {
+	IBAN  string        `xml:"IBAN,omitempty"`
+	Other *ISOGenericID `xml:"Othr,omitempty"`
+}
+
+// ISOParty is an ISO 20022 PartyIdentification
+type ISOParty struct {
+	Name               string            `xml:"Nm,omitempty"`
+	PostalAddress      *ISOPostalAddress `xml:"PstlAdr,omitempty"`
+	ID                 *ISOPartyID       `xml:"Id,omitempty"`
+	CountryOfResidence string            `xml:"CtryOfRes,omitempty"`
+	ContactDetails     *ISOContact       `xml:"CtctDtls,omitempty"`
+}
+
+// ISOPartyID is an ISO 20022 Party choice between an organisation and a private person
+type ISOPartyID struct {
+	OrganisationID *ISOOrganisationID `xml:"OrgId,omitempty"`
+	PrivateID      *ISOPersonID       `xml:"PrvtId,omitempty"`
+}
+
+// ISOOrganisationID is an ISO 20022 OrganisationIdentification
+type ISOOrganisationID struct {
+	AnyBIC string         `xml:"AnyBIC,omitempty"`
+	Other  []ISOGenericID `xml:"Othr,omitempty"`
+}
+
+// ISOPersonID is an ISO 20022 PersonIdentification
+type ISOPersonID struct {
+	DateAndPlaceOfBirth *ISODateAndPlaceOfBirth `xml:"DtAndPlcOfBirth,omitempty"`
+	Other               []ISOGenericID          `xml:"Othr,omitempty"`
+}
+
+// ISODateAndPlaceOfBirth is an ISO 20022 DateAndPlaceOfBirth
+type ISODateAndPlaceOfBirth struct {
+	BirthDate       string `xml:"BirthDt"`
+	CityOfBirth     string `xml:"CityOfBirth"`
+	CountryOfBirth  string `xml:"CtryOfBirth"`
+	ProvinceOfBirth string `xml:"PrvcOfBirth,omitempty"`
+}
+
+// ISOContact is an ISO 20022 Contact
+type ISOContact struct {
+	Name         string `xml:"Nm,omitempty"`
+	PhoneNumber  string `xml:"PhneNb,omitempty"`
+	MobileNumber string `xml:"MobNb,omitempty"`
+	FaxNumber    string `xml:"FaxNb,omitempty"`
+	EmailAddress string `xml:"EmailAdr,omitempty"`
+	Other        string `xml:"Othr>Id,omitempty"`
+}
+
+// ISOInstruction is an ISO 20022 InstructionForCreditorAgent or InstructionForNextAgent
+type ISOInstruction struct {
+	Code                   string `xml:"Cd,omitempty"`
+	InstructionInformation string `xml:"InstrInf,omitempty"`
+}
+
+// ISORemittanceInfo is an ISO 20022 RemittanceInformation
+type ISORemittanceInfo struct {
+	Unstructured []string                  `xml:"Ustrd,omitempty"`
+	Structured   []ISOStructuredRemittance `xml:"Strd,omitempty"`
+}
+
+// isoXML marshals an ISO 20022 document with an XML declaration
+func isoXML(doc interface{}) ([]byte, error) {
+	bs, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), bs...), nil
+}
+
+// isoFromXML unmarshals an ISO 20022 document, returning a descriptive error on failure
+func isoFromXML(bs []byte, doc interface{}, name string) error {
+	if len(bs) == 0 {
+		return fmt.Errorf("problem reading %s: no XML data provided", name)
+	}
+	if err := xml.Unmarshal(bs, doc); err != nil {
+		return fmt.Errorf("problem reading %s: %v", name, err)
+	}
+	return nil
+}
+
+// isoCreationDateTime returns the CreDtTm of a newly generated ISO 20022 document
+func isoCreationDateTime() string {
+	return time.Now().UTC().Format(isoDateTimeFormat)
+}
+
+// isoDecimalFromImplied converts a Fedwire implied decimal amount (000001234567) into an ISO decimal (12345.67)
+func isoDecimalFromImplied(s string) string {
+	s = strings.TrimLeft(strings.TrimSpace(s), "0")
+	for len(s) < 3 {
+		s = "0" + s
+	}
+	return s[:len(s)-2] + "." + s[len(s)-2:]
+}
+
+// impliedFromISODecimal converts an ISO decimal amount (12345.67) into a Fedwire 12 digit implied decimal amount
+func impliedFromISODecimal(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	whole, fraction := s, ""
+	if idx := strings.IndexByte(s, '.'); idx >= 0 {
+		whole, fraction = s[:idx], s[idx+1:]
+	}
+	fraction = strings.TrimRight(fraction, "0")
+	if whole == "" || len(fraction) > 2 || numericRegex.MatchString(whole+fraction) {
+		return "", fieldError("Amount", ErrNonAmount, s)
+	}
+	fraction += strings.Repeat("0", 2-len(fraction))
+	digits := strings.TrimLeft(whole+fraction, "0")
+	if len(digits) > 12 {
+		return "", fieldError("Amount", ErrNonAmount, s)
+	}
+	return strings.Repeat("0", 12-len(digits)) + digits, nil
+}
+
+// isoDecimalFromComma converts a Fedwire decimal comma amount (1234,56) into an ISO decimal (1234.56)
+func isoDecimalFromComma(s string) string {
+	s = strings.Replace(strings.TrimSpace(s), ",", ".", 1)
+	if strings.HasPrefix(s, ".") {
+		s = "0" + s
+	}
+	whole := strings.TrimLeft(s, "0")
+	if whole == "" || strings.HasPrefix(whole, ".") {
+		whole = "0" + whole
+	}
+	return strings.TrimSuffix(whole, ".")
+}
+
+// commaFromISODecimal converts an ISO decimal amount (1234.56) into a Fedwire decimal comma amount (1234,56)
+func commaFromISODecimal(s string) string {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, ".") {
+		return s + ","
+	}
+	return strings.Replace(s, ".", ",", 1)
+}
+
+// isoDateFromCCYYMMDD converts a Fedwire CCYYMMDD date into an ISO date
+func isoDateFromCCYYMMDD(s string) string {
+	t, err := time.Parse("20060102", s)
+	if err != nil {
+		return ""
+	}
+	return t.Format(isoDateFormat)
+}
+
+// ccyymmddFromISODate converts an ISO date (or date time) into a Fedwire CCYYMMDD date
+func ccyymmddFromISODate(s string) string {
+	if len(s) > len(isoDateFormat) {
+		s = s[:len(isoDateFormat)]
+	}
+	t, err := time.Parse(isoDateFormat, s)
+	if err != nil {
+		return ""
+	}
+	return t.Format("20060102")
+}
+
+// isoMessageID returns the ISO MsgId for a message, which is its IMAD
+func (fwm *FEDWireMessage) isoMessageID() string {
+	if fwm.InputMessageAccountabilityData == nil {
+		return isoNotProvided
+	}
+	return strings.TrimSpace(fwm.InputMessageAccountabilityData.IMAD())
+}
+
+// imadFromISOMessageID parses an IMAD from an ISO MsgId. Identifiers which are not an IMAD return nil.
+func imadFromISOMessageID(id string) *InputMessageAccountabilityData {
+	if len(id) != 22 || numericRegex.MatchString(id[:8]+id[16:]) {
+		return nil
+	}
+	imad := NewInputMessageAccountabilityData()
+	imad.InputCycleDate = id[:8]
+	imad.InputSource = strings.TrimSpace(id[8:16])
+	imad.InputSequenceNumber = id[16:]
+	return imad
+}
+
+// isoPaymentTypeInfo maps {1510} and {3600} into PmtTpInf
+func (fwm *FEDWireMessage) isoPaymentTypeInfo() *ISOPaymentTypeInfo {
+	info := &ISOPaymentTypeInfo{}
+	if fwm.BusinessFunctionCode != nil {
+		info.LocalInstrument = &ISOCode{Proprietary: fwm.BusinessFunctionCode.BusinessFunctionCode}
+	}
+	if fwm.TypeSubType != nil {
+		info.CategoryPurpose = &ISOCode{Proprietary: fwm.TypeSubType.TypeCode + fwm.TypeSubType.SubTypeCode}
+	}
+	return info
+}
+
+// setFromISOPaymentTypeInfo populates {1510} and {3600} from PmtTpInf, defaulting the business function code
+func (fwm *FEDWireMessage) setFromISOPaymentTypeInfo(info *ISOPaymentTypeInfo, defaultBFC, defaultTypeSubType string) {
+	bfc, typeSubType := defaultBFC, defaultTypeSubType
+	if info != nil {
+		if info.LocalInstrument != nil && info.LocalInstrument.Proprietary != "" {
+			bfc = info.LocalInstrument.Proprietary
+		}
+		if info.CategoryPurpose != nil && len(info.CategoryPurpose.Proprietary) == 4 {
+			typeSubType = info.CategoryPurpose.Proprietary
+		}
+	}
+	fwm.BusinessFunctionCode = NewBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = bfc
+	fwm.TypeSubType = NewTypeSubType()
+	fwm.TypeSubType.TypeCode = typeSubType[:2]
+	fwm.TypeSubType.SubTypeCode = typeSubType[2:]
+}
+
+// isoSenderReceiverAgents maps {3100} and {3400} into the instructing and instructed agents
+func (fwm *FEDWireMessage) isoSenderReceiverAgents() (instructing, instructed *ISOAgent) {
+	if sdi := fwm.SenderDepositoryInstitution; sdi != nil {
+		instructing = isoAgentFromABA(sdi.SenderABANumber, sdi.SenderShortName)
+	}
+	if rdi := fwm.ReceiverDepositoryInstitution; rdi != nil {
+		instructed = isoAgentFromABA(rdi.ReceiverABANumber, rdi.ReceiverShortName)
+	}
+	return instructing, instructed
+}
+
+// setFromISOSenderReceiverAgents populates {3100} and {3400} from the instructing and instructed agents
+func (fwm *FEDWireMessage) setFromISOSenderReceiverAgents(instructing, instructed *ISOAgent) error {
+	if instructing == nil {
+		return fieldError("InstgAgt", ErrISODocument)
+	}
+	if instructed == nil {
+		return fieldError("InstdAgt", ErrISODocument)
+	}
+	fwm.SenderDepositoryInstitution = NewSenderDepositoryInstitution()
+	fwm.SenderDepositoryInstitution.SenderABANumber, fwm.SenderDepositoryInstitution.SenderShortName = abaFromISOAgent(instructing)
+	fwm.ReceiverDepositoryInstitution = NewReceiverDepositoryInstitution()
+	fwm.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.ReceiverDepositoryInstitution.ReceiverShortName = abaFromISOAgent(instructed)
+	return nil
+}
+
+// isoAgentFromABA returns an agent identified by a Fed routing number
+func isoAgentFromABA(aba, name string) *ISOAgent {
+	return &ISOAgent{FinancialInstitutionID: ISOFinancialInstitutionID{
+		ClearingSystemMemberID: &ISOClearingSystemMemberID{
+			ClearingSystemID: ISOCode{Code: isoClearingABA},
+			MemberID:         strings.TrimSpace(aba),
+		},
+		Name: strings.TrimSpace(name),
+	}}
+}
+
+// abaFromISOAgent returns the Fed routing number and short name of an agent
+func abaFromISOAgent(agent *ISOAgent) (aba, name string) {
+	fi := agent.FinancialInstitutionID
+	if fi.ClearingSystemMemberID != nil {
+		aba = fi.ClearingSystemMemberID.MemberID
+	}
+	return aba, fi.Name
+}
+
+// isoPartyFromABA returns a party identified by a Fed routing number, used where ISO 20022 identifies the sender or
+// receiver as a party rather than an agent
+func isoPartyFromABA(aba, name string) *ISOParty {
+	return &ISOParty{
+		Name: strings.TrimSpace(name),
+		ID: &ISOPartyID{OrganisationID: &ISOOrganisationID{Other: []ISOGenericID{
+			{ID: strings.TrimSpace(aba), SchemeName: &ISOCode{Proprietary: isoClearingABA}},
+		}}},
+	}
+}
+
+// abaFromISOParty returns the Fed routing number and name of a party identified by isoPartyFromABA
+func abaFromISOParty(party *ISOParty) (aba, name string) {
+	if party == nil {
+		return "", ""
+	}
+	if party.ID != nil && party.ID.OrganisationID != nil {
+		for _, id := range party.ID.OrganisationID.Other {
+			if id.SchemeName != nil && id.SchemeName.Proprietary == isoClearingABA {
+				aba = id.ID
+			}
+		}
+	}
+	return aba, party.Name
+}
+
+// isoPostalAddress maps three Fedwire address lines into an ISO postal address
+func isoPostalAddress(addr Address) *ISOPostalAddress {
+	var lines []string
+	for _, line := range []string{addr.AddressLineOne, addr.AddressLineTwo, addr.AddressLineThree} {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	return &ISOPostalAddress{AddressLines: lines}
+}
+
+// addressFromISOPostalAddress maps an ISO postal address into three Fedwire address lines. Structured
+// elements are folded into address lines when no unstructured lines are present.
+func addressFromISOPostalAddress(pa *ISOPostalAddress) Address {
+	var addr Address
+	if pa == nil {
+		return addr
+	}
+	lines := pa.AddressLines
+	if len(lines) == 0 {
+		street := strings.TrimSpace(pa.BuildingNumber + " " + pa.StreetName)
+		town := strings.TrimSpace(strings.Join(nonEmpty(pa.TownName, pa.CountrySubDivision, pa.PostCode), " "))
+		lines = nonEmpty(street, town, pa.Country)
+	}
+	setLines([]*string{&addr.AddressLineOne, &addr.AddressLineTwo, &addr.AddressLineThree}, lines)
+	return addr
+}
+
+// setLines assigns lines to targets in order, ignoring lines beyond the number of targets
+func setLines(targets []*string, lines []string) {
+	for i := 0; i < len(lines) && i < len(targets); i++ {
+		*targets[i] = lines[i]
+	}
+}
+
+// nonEmpty returns the values which are not blank
+func nonEmpty(values ...string) []string {
+	var out []string
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// isoAgentFromIdentified maps a Fedwire identification code, identifier, name and address into an ISO agent
+// and, for a Demand Deposit Account identifier, the account held with that agent.
+func isoAgentFromIdentified(code, identifier, name string, addr Address) (*ISOAgent, *ISOAccount) {
+	agent := &ISOAgent{FinancialInstitutionID: ISOFinancialInstitutionID{
+		Name:          strings.TrimSpace(name),
+		PostalAddress: isoPostalAddress(addr),
+	}}
+	identifier = strings.TrimSpace(identifier)
+	var account *ISOAccount
+	fi := &agent.FinancialInstitutionID
+	switch code {
+	case "":
+	case SWIFTBankIdentifierCode:
+		fi.BICFI = identifier
+	case FEDRoutingNumber:
+		fi.ClearingSystemMemberID = &ISOClearingSystemMemberID{ClearingSystemID: ISOCode{Code: isoClearingABA}, MemberID: identifier}
+	case CHIPSParticipant:
+		fi.ClearingSystemMemberID = &ISOClearingSystemMemberID{ClearingSystemID: ISOCode{Code: isoClearingCHIPS}, MemberID: identifier}
+	case DemandDepositAccountNumber:
+		account = isoAccount(identifier)
+	default:
+		fi.Other = &ISOGenericID{ID: identifier, SchemeName: &ISOCode{Proprietary: code}}
+	}
+	return agent, account
+}
+
+// identifiedFromISOAgent is the inverse of isoAgentFromIdentified
+func identifiedFromISOAgent(agent *ISOAgent, account *ISOAccount) (code, identifier, name string, addr Address) {
+	if agent == nil {
+		if id := isoAccountNumber(account); id != "" {
+			return DemandDepositAccountNumber, id, "", addr
+		}
+		return "", "", "", addr
+	}
+	fi := agent.FinancialInstitutionID
+	name, addr = fi.Name, addressFromISOPostalAddress(fi.PostalAddress)
+	switch {
+	case fi.BICFI != "":
+		code, identifier = SWIFTBankIdentifierCode, fi.BICFI
+	case fi.ClearingSystemMemberID != nil && fi.ClearingSystemMemberID.ClearingSystemID.Code == isoClearingCHIPS:
+		code, identifier = CHIPSParticipant, fi.ClearingSystemMemberID.MemberID
+	case fi.ClearingSystemMemberID != nil:
+		code, identifier = FEDRoutingNumber, fi.ClearingSystemMemberID.MemberID
+	case fi.Other != nil && fi.Other.SchemeName != nil && len(fi.Other.SchemeName.Proprietary) == 1:
+		code, identifier = fi.Other.SchemeName.Proprietary, fi.Other.ID
+	case isoAccountNumber(account) != "":
+		code, identifier = DemandDepositAccountNumber, isoAccountNumber(account)
+	}
+	return code, identifier, name, addr
+}
+
+// isoAccount returns an ISO account for an account number, using IBAN when the number is one
+func isoAccount(number string) *ISOAccount {
+	number = strings.TrimSpace(number)
+	if number == "" {
+		return nil
+	}
+	if ibanRegex.MatchString(number) {
+		return &ISOAccount{ID: ISOAccountID{IBAN: number}}
+	}
+	return &ISOAccount{ID: ISOAccountID{Other: &ISOGenericID{ID: number}}}
+}
+
+// isoAccountNumber returns the account number of an ISO account
+func isoAccountNumber(account *ISOAccount) string {
+	if account == nil {
+		return ""
+	}
+	if account.ID.IBAN != "" {
+		return account.ID.IBAN
+	}
+	if account.ID.Other != nil {
+		return account.ID.Other.ID
+	}
+	return ""
+}
+
+// isoAgentFromFI maps a FinancialInstitution into an ISO agent and account
+func isoAgentFromFI(fi FinancialInstitution) (*ISOAgent, *ISOAccount) {
+	return isoAgentFromIdentified(fi.IdentificationCode, fi.Identifier, fi.Name, fi.Address)
+}
+
+// fiFromISOAgent maps an ISO agent and account into a FinancialInstitution
+func fiFromISOAgent(agent *ISOAgent, account *ISOAccount) FinancialInstitution {
+	var fi FinancialInstitution
+	fi.IdentificationCode, fi.Identifier, fi.Name, fi.Address = identifiedFromISOAgent(agent, account)
+	return fi
+}
+
+// isoAgentFromSwift maps the SWIFT lines of a {7xxx} institution tag (52a, 56a, 57a) into an ISO agent and account
+func isoAgentFromSwift(cp CoverPayment) (*ISOAgent, *ISOAccount) {
+	p := decodeSwiftParty(cp.SwiftFieldTag, coverPaymentLines(cp))
+	agent := &ISOAgent{FinancialInstitutionID: ISOFinancialInstitutionID{
+		BICFI: p.BIC,
+		Name:  p.Name,
+	}}
+	if len(p.AddressLines) > 0 {
+		agent.FinancialInstitutionID.PostalAddress = &ISOPostalAddress{AddressLines: p.AddressLines}
+	}
+	switch p.ClearingCode {
+	case "":
+	case "FW":
+		agent.FinancialInstitutionID.ClearingSystemMemberID = &ISOClearingSystemMemberID{ClearingSystemID: ISOCode{Code: isoClearingABA}, MemberID: p.ClearingID}
+	case "CH", "CP":
+		agent.FinancialInstitutionID.ClearingSystemMemberID = &ISOClearingSystemMemberID{ClearingSystemID: ISOCode{Code: isoClearingCHIPS}, MemberID: p.ClearingID}
+	default:
+		agent.FinancialInstitutionID.ClearingSystemMemberID = &ISOClearingSystemMemberID{ClearingSystemID: ISOCode{Proprietary: p.ClearingCode}, MemberID: p.ClearingID}
+	}
+	return agent, isoAccount(p.Account)
+}
+
+// swiftFromISOAgent maps an ISO agent and account into the SWIFT lines of a {7xxx} institution tag
+func swiftFromISOAgent(field string, agent *ISOAgent, account *ISOAccount, maxLines int) CoverPayment {
+	var p swiftParty
+	if agent != nil {
+		fi := agent.FinancialInstitutionID
+		p.BIC, p.Name = fi.BICFI, fi.Name
+		if fi.PostalAddress != nil {
+			p.AddressLines = addressLinesFromISO(fi.PostalAddress)
+		}
+		if mmb := fi.ClearingSystemMemberID; mmb != nil {
+			p.ClearingID = mmb.MemberID
+			switch mmb.ClearingSystemID.Code {
+			case isoClearingABA:
+				p.ClearingCode = "FW"
+			case isoClearingCHIPS:
+				p.ClearingCode = "CH"
+			default:
+				p.ClearingCode = mmb.ClearingSystemID.Proprietary
+			}
+		}
+	}
+	p.Account = isoAccountNumber(account)
+	tag, lines := p.encode(field)
+	return newCoverPayment(tag, lines, maxLines)
+}
+
+// isoPartyFromSwift maps the SWIFT lines of a {7xxx} party tag (50a, 59a) into an ISO party and account
+func isoPartyFromSwift(cp CoverPayment) (*ISOParty, *ISOAccount) {
+	p := decodeSwiftParty(cp.SwiftFieldTag, coverPaymentLines(cp))
+	party := &ISOParty{Name: p.Name}
+	if len(p.AddressLines) > 0 || p.Country != "" || p.Town != "" {
+		party.PostalAddress = &ISOPostalAddress{TownName: p.Town, Country: p.Country, AddressLines: p.AddressLines}
+	}
+	switch {
+	case p.BIC != "":
+		party.ID = &ISOPartyID{OrganisationID: &ISOOrganisationID{AnyBIC: p.BIC}}
+	case p.PartyIdentifier != "":
+		// CODE/CC/IDENTIFIER
+		parts := strings.SplitN(p.PartyIdentifier, "/", 3)
+		id := ISOGenericID{ID: p.PartyIdentifier}
+		if len(parts) == 3 {
+			id = ISOGenericID{ID: parts[2], SchemeName: &ISOCode{Code: parts[0]}, Issuer: parts[1]}
+		}
+		party.ID = &ISOPartyID{PrivateID: &ISOPersonID{Other: []ISOGenericID{id}}}
+	}
+	return party, isoAccount(p.Account)
+}
+
+// swiftFromISOParty maps an ISO party and account into the SWIFT lines of a {7xxx} party tag
+func swiftFromISOParty(field string, party *ISOParty, account *ISOAccount, maxLines int) CoverPayment {
+	var p swiftParty
+	if party != nil {
+		p.Name = party.Name
+		if pa := party.PostalAddress; pa != nil {
+			p.Country, p.Town = pa.Country, pa.TownName
+			p.AddressLines = pa.AddressLines
+			if len(p.AddressLines) == 0 {
+				p.AddressLines = nonEmpty(strings.TrimSpace(pa.BuildingNumber + " " + pa.StreetName))
+			}
+		}
+		if party.ID != nil {
+			if org := party.ID.OrganisationID; org != nil {
+				p.BIC = org.AnyBIC
+			}
+			if prvt := party.ID.PrivateID; prvt != nil && len(prvt.Other) > 0 {
+				id := prvt.Other[0]
+				p.PartyIdentifier = id.ID
+				if id.SchemeName != nil && id.SchemeName.Code != "" {
+					p.PartyIdentifier = id.SchemeName.Code + "/" + id.Issuer + "/" + id.ID
+				}
+			}
+		}
+	}
+	p.Account = isoAccountNumber(account)
+	tag, lines := p.encode(field)
+	return newCoverPayment(tag, lines, maxLines)
+}
+
+// addressLinesFromISO returns the unstructured lines of an ISO postal address, folding structured elements
+func addressLinesFromISO(pa *ISOPostalAddress) []string {
+	addr := addressFromISOPostalAddress(pa)
+	return nonEmpty(addr.AddressLineOne, addr.AddressLineTwo, addr.AddressLineThree)
+}
+
+// fiToFICodeWords are the SWIFT field 72 style code words used to carry {6xxx} tags in ISO instructions
+var fiToFICodeWords = []struct {
+	code string
+	tag  string
+}{
+	{"REC", TagFIReceiverFI},
+	{"DDAD", TagFIDrawdownDebitAccountAdvice},
+	{"INT", TagFIIntermediaryFI},
+	{"INTA", TagFIIntermediaryFIAdvice},
+	{"ACC", TagFIBeneficiaryFI},
+	{"ACCA", TagFIBeneficiaryFIAdvice},
+	{"BNF", TagFIBeneficiary},
+	{"BNFA", TagFIBeneficiaryAdvice},
+	{"PMT", TagFIPaymentMethodToBeneficiary},
+	{"ADD", TagFIAdditionalFIToFI},
+}
+
+// trimTrailingEmpty drops blank lines from the end of lines, keeping the position of interior lines
+func trimTrailingEmpty(lines []string) []string {
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// codeWordInstructions encodes lines as "/CODE/first line" followed by "//continuation" instructions
+func codeWordInstructions(code string, lines []string) []ISOInstruction {
+	lines = trimTrailingEmpty(lines)
+	if len(lines) == 0 {
+		return nil
+	}
+	out := []ISOInstruction{{InstructionInformation: "/" + code + "/" + lines[0]}}
+	for _, line := range lines[1:] {
+		out = append(out, ISOInstruction{InstructionInformation: "//" + line})
+	}
+	return out
+}
+
+// decodeCodeWordInstructions groups "/CODE/" and "//" continuation instructions by code word
+func decodeCodeWordInstructions(instructions []ISOInstruction) map[string][]string {
+	out := make(map[string][]string)
+	current := ""
+	for _, instr := range instructions {
+		info := instr.InstructionInformation
+		switch {
+		case strings.HasPrefix(info, "//"):
+			if current != "" {
+				out[current] = append(out[current], info[2:])
+			}
+		case strings.HasPrefix(info, "/"):
+			if idx := strings.Index(info[1:], "/"); idx > 0 {
+				current = info[1 : idx+1]
+				out[current] = append(out[current], info[idx+2:])
+			}
+		}
+	}
+	return out
+}
+
+// isoFIToFIInstructions encodes the {6xxx} FI to FI tags of a message as ISO instructions
+func (fwm *FEDWireMessage) isoFIToFIInstructions() []ISOInstruction {
+	var out []ISOInstruction
+	for _, cw := range fiToFICodeWords {
+		out = append(out, codeWordInstructions(cw.code, fwm.fiToFILines(cw.tag))...)
+	}
+	return out
+}
+
+// fiToFILines returns the lines of a {6xxx} tag, with any advice or payment method code as the first line
+func (fwm *FEDWireMessage) fiToFILines(tag string) []string {
+	advice := func(a Advice) []string {
+		return []string{a.AdviceCode, a.LineOne, a.LineTwo, a.LineThree, a.LineFour, a.LineFive, a.LineSix}
+	}
+	fiToFI := func(f FIToFI) []string {
+		return []string{f.LineOne, f.LineTwo, f.LineThree, f.LineFour, f.LineFive, f.LineSix}
+	}
+	switch tag {
+	case TagFIReceiverFI:
+		if fwm.FIReceiverFI != nil {
+			return fiToFI(fwm.FIReceiverFI.FIToFI)
+		}
+	case TagFIDrawdownDebitAccountAdvice:
+		if fwm.FIDrawdownDebitAccountAdvice != nil {
+			return advice(fwm.FIDrawdownDebitAccountAdvice.Advice)
+		}
+	case TagFIIntermediaryFI:
+		if fwm.FIIntermediaryFI != nil {
+			return fiToFI(fwm.FIIntermediaryFI.FIToFI)
+		}
+	case TagFIIntermediaryFIAdvice:
+		if fwm.FIIntermediaryFIAdvice != nil {
+			return advice(fwm.FIIntermediaryFIAdvice.Advice)
+		}
+	case TagFIBeneficiaryFI:
+		if fwm.FIBeneficiaryFI != nil {
+			return fiToFI(fwm.FIBeneficiaryFI.FIToFI)
+		}
+	case TagFIBeneficiaryFIAdvice:
+		if fwm.FIBeneficiaryFIAdvice != nil {
+			return advice(fwm.FIBeneficiaryFIAdvice.Advice)
+		}
+	case TagFIBeneficiary:
+		if fwm.FIBeneficiary != nil {
+			return fiToFI(fwm.FIBeneficiary.FIToFI)
+		}
+	case TagFIBeneficiaryAdvice:
+		if fwm.FIBeneficiaryAdvice != nil {
+			return advice(fwm.FIBeneficiaryAdvice.Advice)
+		}
+	case TagFIPaymentMethodToBeneficiary:
+		if fwm.FIPaymentMethodToBeneficiary != nil {
+			return []string{fwm.FIPaymentMethodToBeneficiary.PaymentMethod, fwm.FIPaymentMethodToBeneficiary.AdditionalInformation}
+		}
+	case TagFIAdditionalFIToFI:
+		if fwm.FIAdditionalFIToFI != nil {
+			a := fwm.FIAdditionalFIToFI.AdditionalFIToFI
+			return []string{a.LineOne, a.LineTwo, a.LineThree, a.LineFour, a.LineFive, a.LineSix}
+		}
+	}
+	return nil
+}
+
+// setFromISOFIToFIInstructions populates the {6xxx} FI to FI tags from ISO instructions
+func (fwm *FEDWireMessage) setFromISOFIToFIInstructions(instructions []ISOInstruction) {
+	decoded := decodeCodeWordInstructions(instructions)
+	line := func(lines []string, i int) string {
+		if i < len(lines) {
+			return lines[i]
+		}
+		return ""
+	}
+	fiToFI := func(lines []string) FIToFI {
+		return FIToFI{LineOne: line(lines, 0), LineTwo: line(lines, 1), LineThree: line(lines, 2),
+			LineFour: line(lines, 3), LineFive: line(lines, 4), LineSix: line(lines, 5)}
+	}
+	advice := func(lines []string) Advice {
+		return Advice{AdviceCode: line(lines, 0), LineOne: line(lines, 1), LineTwo: line(lines, 2), LineThree: line(lines, 3),
+			LineFour: line(lines, 4), LineFive: line(lines, 5), LineSix: line(lines, 6)}
+	}
+	for _, cw := range fiToFICodeWords {
+		lines, ok := decoded[cw.code]
+		if !ok {
+			continue
+		}
+		switch cw.tag {
+		case TagFIReceiverFI:
+			fwm.FIReceiverFI = NewFIReceiverFI()
+			fwm.FIReceiverFI.FIToFI = fiToFI(lines)
+		case TagFIDrawdownDebitAccountAdvice:
+			fwm.FIDrawdownDebitAccountAdvice = NewFIDrawdownDebitAccountAdvice()
+			fwm.FIDrawdownDebitAccountAdvice.Advice = advice(lines)
+		case TagFIIntermediaryFI:
+			fwm.FIIntermediaryFI = NewFIIntermediaryFI()
+			fwm.FIIntermediaryFI.FIToFI = fiToFI(lines)
+		case TagFIIntermediaryFIAdvice:
+			fwm.FIIntermediaryFIAdvice = NewFIIntermediaryFIAdvice()
+			fwm.FIIntermediaryFIAdvice.Advice = advice(lines)
+		case TagFIBeneficiaryFI:
+			fwm.FIBeneficiaryFI = NewFIBeneficiaryFI()
+			fwm.FIBeneficiaryFI.FIToFI = fiToFI(lines)
+		case TagFIBeneficiaryFIAdvice:
+			fwm.FIBeneficiaryFIAdvice = NewFIBeneficiaryFIAdvice()
+			fwm.FIBeneficiaryFIAdvice.Advice = advice(lines)
+		case TagFIBeneficiary:
+			fwm.FIBeneficiary = NewFIBeneficiary()
+			fwm.FIBeneficiary.FIToFI = fiToFI(lines)
+		case TagFIBeneficiaryAdvice:
+			fwm.FIBeneficiaryAdvice = NewFIBeneficiaryAdvice()
+			fwm.FIBeneficiaryAdvice.Advice = advice(lines)
+		case TagFIPaymentMethodToBeneficiary:
+			fwm.FIPaymentMethodToBeneficiary = NewFIPaymentMethodToBeneficiary()
+			fwm.FIPaymentMethodToBeneficiary.PaymentMethod = line(lines, 0)
+			fwm.FIPaymentMethodToBeneficiary.AdditionalInformation = line(lines, 1)
+		case TagFIAdditionalFIToFI:
+			fwm.FIAdditionalFIToFI = NewFIAdditionalFIToFI()
+			f := fiToFI(lines)
+			fwm.FIAdditionalFIToFI.AdditionalFIToFI = AdditionalFIToFI{LineOne: f.LineOne, LineTwo: f.LineTwo, LineThree: f.LineThree,
+				LineFour: f.LineFour, LineFive: f.LineFive, LineSix: f.LineSix}
+		}
+	}
+}
+
+// isoOriginatorToBeneficiary maps {6000} into unstructured remittance information
+func (fwm *FEDWireMessage) isoOriginatorToBeneficiary() *ISORemittanceInfo {
+	if fwm.OriginatorToBeneficiary == nil {
+		return nil
+	}
+	otb := fwm.OriginatorToBeneficiary
+	lines := nonEmpty(otb.LineOne, otb.LineTwo, otb.LineThree, otb.LineFour)
+	if len(lines) == 0 {
+		return nil
+	}
+	return &ISORemittanceInfo{Unstructured: lines}
+}
+
+// setFromISOUnstructured populates {6000} from unstructured remittance information
+func (fwm *FEDWireMessage) setFromISOUnstructured(lines []string) {
+	lines = nonEmpty(lines...)
+	if len(lines) == 0 {
+		return
+	}
+	otb := NewOriginatorToBeneficiary()
+	setLines([]*string{&otb.LineOne, &otb.LineTwo, &otb.LineThree, &otb.LineFour}, lines)
+	fwm.OriginatorToBeneficiary = otb
+}
+
+// ISOAssignment is the Assgnmt of an ISO 20022 exceptions and investigations message
+type ISOAssignment struct {
+	ID               string         `xml:"Id"`
+	Assigner         ISOPartyChoice `xml:"Assgnr"`
+	Assignee         ISOPartyChoice `xml:"Assgne"`
+	CreationDateTime string         `xml:"CreDtTm"`
+}
+
+// ISOPartyChoice is an ISO 20022 Party40Choice between a party and a financial institution
+type ISOPartyChoice struct {
+	Party *ISOParty `xml:"Pty,omitempty"`
+	Agent *ISOAgent `xml:"Agt,omitempty"`
+}
+
+// ISOOriginalGroupInfo identifies the message an ISO 20022 message refers to
+type ISOOriginalGroupInfo struct {
+	OriginalMessageID        string `xml:"OrgnlMsgId"`
+	OriginalMessageNameID    string `xml:"OrgnlMsgNmId"`
+	OriginalCreationDateTime string `xml:"OrgnlCreDtTm,omitempty"`
+}
+
+// ISOReasonInfo is an ISO 20022 cancellation, return or status reason
+type ISOReasonInfo struct {
+	Reason         *ISOCode `xml:"Rsn,omitempty"`
+	AdditionalInfo []string `xml:"AddtlInf,omitempty"`
+}
+
+// ISOOriginalTransactionReference is the OrgnlTxRef of an ISO 20022 message, which holds the parties of the
+// transaction being referred to.
+type ISOOriginalTransactionReference struct {
+	Amount                 *ISOAmountChoice    `xml:"Amt,omitempty"`
+	RequestedExecutionDate *ISODateChoice      `xml:"ReqdExctnDt,omitempty"`
+	PaymentTypeInfo        *ISOPaymentTypeInfo `xml:"PmtTpInf,omitempty"`
+	RemittanceInfo         *ISORemittanceInfo  `xml:"RmtInf,omitempty"`
+	UltimateDebtor         *ISOPartyChoice     `xml:"UltmtDbtr,omitempty"`
+	Debtor                 *ISOPartyChoice     `xml:"Dbtr,omitempty"`
+	DebtorAccount          *ISOAccount         `xml:"DbtrAcct,omitempty"`
+	DebtorAgent            *ISOAgent           `xml:"DbtrAgt,omitempty"`
+	DebtorAgentAccount     *ISOAccount         `xml:"DbtrAgtAcct,omitempty"`
+	CreditorAgent          *ISOAgent           `xml:"CdtrAgt,omitempty"`
+	CreditorAgentAccount   *ISOAccount         `xml:"CdtrAgtAcct,omitempty"`
+	Creditor               *ISOPartyChoice     `xml:"Cdtr,omitempty"`
+	CreditorAccount        *ISOAccount         `xml:"CdtrAcct,omitempty"`
+}
+
+// isoFedwireSettlement returns the SttlmInf of a message settled over the Fedwire Funds Service
+func isoFedwireSettlement() *ISOSettlementInfo {
+	return &ISOSettlementInfo{
+		SettlementMethod: "CLRG",
+		ClearingSystem:   &ISOCode{Code: isoClearingSystemFedwire},
+	}
+}
+
+// isoOriginalMessageName returns the ISO message name of the payment a reversal refers to, which is pacs.008
+// for customer transfers and pacs.009 otherwise.
+func (fwm *FEDWireMessage) isoOriginalMessageName() string {
+	if fwm.BusinessFunctionCode != nil {
+		switch fwm.BusinessFunctionCode.BusinessFunctionCode {
+		case CustomerTransfer, CustomerTransferPlus:
+			return "pacs.008.001.08"
+		}
+	}
+	return "pacs.009.001.08"
+}
+
+// isoPartyFromIdentified maps a Fedwire identification code, identifier, name and address into an ISO party
+// and, for a Demand Deposit Account identifier, the party's account.
+func isoPartyFromIdentified(code, identifier, name string, addr Address) (*ISOParty, *ISOAccount) {
+	party := &ISOParty{
+		Name:          strings.TrimSpace(name),
+		PostalAddress: isoPostalAddress(addr),
+	}
+	identifier = strings.TrimSpace(identifier)
+	switch code {
+	case "":
+		return party, nil
+	case DemandDepositAccountNumber:
+		return party, isoAccount(identifier)
+	case SWIFTBankIdentifierCode:
+		party.ID = &ISOPartyID{OrganisationID: &ISOOrganisationID{AnyBIC: identifier}}
+	default:
+		party.ID = &ISOPartyID{OrganisationID: &ISOOrganisationID{Other: []ISOGenericID{
+			{ID: identifier, SchemeName: &ISOCode{Proprietary: code}},
+		}}}
+	}
+	return party, nil
+}
+
+// identifiedFromISOParty is the inverse of isoPartyFromIdentified
+func identifiedFromISOParty(party *ISOParty, account *ISOAccount) (code, identifier, name string, addr Address) {
+	if party == nil {
+		if id := isoAccountNumber(account); id != "" {
+			return DemandDepositAccountNumber, id, "", addr
+		}
+		return "", "", "", addr
+	}
+	name, addr = party.Name, addressFromISOPostalAddress(party.PostalAddress)
+	var other []ISOGenericID
+	if party.ID != nil && party.ID.OrganisationID != nil {
+		if party.ID.OrganisationID.AnyBIC != "" {
+			return SWIFTBankIdentifierCode, party.ID.OrganisationID.AnyBIC, name, addr
+		}
+		other = party.ID.OrganisationID.Other
+	}
+	if party.ID != nil && party.ID.PrivateID != nil {
+		other = append(other, party.ID.PrivateID.Other...)
+	}
+	for _, id := range other {
+		if id.SchemeName != nil && len(id.SchemeName.Proprietary) == 1 {
+			return id.SchemeName.Proprietary, id.ID, name, addr
+		}
+	}
+	if id := isoAccountNumber(account); id != "" {
+		return DemandDepositAccountNumber, id, name, addr
+	}
+	return "", "", name, addr
+}
+
+// personalFromISOPartyChoice maps a party or financial institution into a Personal
+func personalFromISOPartyChoice(choice *ISOPartyChoice, account *ISOAccount) Personal {
+	var p Personal
+	if choice != nil && choice.Agent != nil {
+		p.IdentificationCode, p.Identifier, p.Name, p.Address = identifiedFromISOAgent(choice.Agent, account)
+		return p
+	}
+	var party *ISOParty
+	if choice != nil {
+		party = choice.Party
+	}
+	p.IdentificationCode, p.Identifier, p.Name, p.Address = identifiedFromISOParty(party, account)
+	return p
+}
+
+// isoOriginalTransactionReference maps {4100}, {4200}, {5000}, {5100} and {6000} into an original transaction reference
+func (fwm *FEDWireMessage) isoOriginalTransactionReference() *ISOOriginalTransactionReference {
+	ref := &ISOOriginalTransactionReference{
+		RemittanceInfo: fwm.isoOriginatorToBeneficiary(),
+	}
+	if o := fwm.Originator; o != nil {
+		party, account := isoPartyFromIdentified(o.Personal.IdentificationCode, o.Personal.Identifier, o.Personal.Name, o.Personal.Address)
+		ref.Debtor, ref.DebtorAccount = &ISOPartyChoice{Party: party}, account
+	}
+	if fwm.OriginatorFI != nil {
+		ref.DebtorAgent, ref.DebtorAgentAccount = isoAgentFromFI(fwm.OriginatorFI.FinancialInstitution)
+	}
+	if fwm.BeneficiaryFI != nil {
+		ref.CreditorAgent, ref.CreditorAgentAccount = isoAgentFromFI(fwm.BeneficiaryFI.FinancialInstitution)
+	}
+	if b := fwm.Beneficiary; b != nil {
+		party, account := isoPartyFromIdentified(b.Personal.IdentificationCode, b.Personal.Identifier, b.Personal.Name, b.Personal.Address)
+		ref.Creditor, ref.CreditorAccount = &ISOPartyChoice{Party: party}, account
+	}
+	return ref
+}
+
+// setFromISOOriginalTransactionReference populates {4100}, {4200}, {5000}, {5100} and {6000} from an original transaction reference
+func (fwm *FEDWireMessage) setFromISOOriginalTransactionReference(ref *ISOOriginalTransactionReference) {
+	if ref == nil {
+		return
+	}
+	if ref.Debtor != nil || ref.DebtorAccount != nil {
+		fwm.Originator = NewOriginator()
+		fwm.Originator.Personal = personalFromISOPartyChoice(ref.Debtor, ref.DebtorAccount)
+	}
+	if ref.DebtorAgent != nil || ref.DebtorAgentAccount != nil {
+		fwm.OriginatorFI = NewOriginatorFI()
+		fwm.OriginatorFI.FinancialInstitution = fiFromISOAgent(ref.DebtorAgent, ref.DebtorAgentAccount)
+	}
+	if ref.CreditorAgent != nil || ref.CreditorAgentAccount != nil {
+		fwm.BeneficiaryFI = NewBeneficiaryFI()
+		fwm.BeneficiaryFI.FinancialInstitution = fiFromISOAgent(ref.CreditorAgent, ref.CreditorAgentAccount)
+	}
+	if ref.Creditor != nil || ref.CreditorAccount != nil {
+		fwm.Beneficiary = NewBeneficiary()
+		fwm.Beneficiary.Personal = personalFromISOPartyChoice(ref.Creditor, ref.CreditorAccount)
+	}
+	if ref.RemittanceInfo != nil {
+		fwm.setFromISOUnstructured(ref.RemittanceInfo.Unstructured)
+	}
+}
+
+// serviceMessageLines returns the non-empty lines of {9000}
+func (fwm *FEDWireMessage) serviceMessageLines() []string {
+	sm := fwm.ServiceMessage
+	if sm == nil {
+		return nil
+	}
+	return nonEmpty(sm.LineOne, sm.LineTwo, sm.LineThree, sm.LineFour, sm.LineFive, sm.LineSix,
+		sm.LineSeven, sm.LineEight, sm.LineNine, sm.LineTen, sm.LineEleven, sm.LineTwelve)
+}
+
+// setServiceMessageLines populates {9000} with up to twelve lines
+func (fwm *FEDWireMessage) setServiceMessageLines(lines []string) {
+	lines = nonEmpty(lines...)
+	if len(lines) == 0 {
+		return
+	}
+	sm := NewServiceMessage()
+	setLines([]*string{&sm.LineOne, &sm.LineTwo, &sm.LineThree, &sm.LineFour, &sm.LineFive, &sm.LineSix,
+		&sm.LineSeven, &sm.LineEight, &sm.LineNine, &sm.LineTen, &sm.LineEleven, &sm.LineTwelve}, lines)
+	fwm.ServiceMessage = sm
+}
+
+// previousMessageID returns {3500} PreviousMessageIdentifier
+func (fwm *FEDWireMessage) previousMessageID() string {
+	if fwm.PreviousMessageIdentifier == nil {
+		return ""
+	}
+	return strings.TrimSpace(fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+}
+
+// setPreviousMessageID populates {3500} PreviousMessageIdentifier
+func (fwm *FEDWireMessage) setPreviousMessageID(id string) {
+	if id = strings.TrimSpace(id); id == "" {
+		return
+	}
+	fwm.PreviousMessageIdentifier = NewPreviousMessageIdentifier()
+	fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = id
+}
+
+// isoCycleDate returns the ISO date of the IMAD cycle date of a message
+func (fwm *FEDWireMessage) isoCycleDate() string {
+	if fwm.InputMessageAccountabilityData == nil {
+		return ""
+	}
+	return isoDateFromCCYYMMDD(fwm.InputMessageAccountabilityData.InputCycleDate)
+}
+
+// setIMADFromISO populates {1520} from an ISO message identifier, falling back to an ISO date for the cycle date
+func (fwm *FEDWireMessage) setIMADFromISO(id, date string) {
+	fwm.InputMessageAccountabilityData = imadFromISOMessageID(id)
+	if fwm.InputMessageAccountabilityData == nil {
+		fwm.InputMessageAccountabilityData = NewInputMessageAccountabilityData()
+		fwm.InputMessageAccountabilityData.InputCycleDate = ccyymmddFromISODate(date)
+	}
+}
+
+// setISOSenderSupplied populates {1500} with the default format version and test/production code. ISO 20022 has no
+// element for the user request correlation, when correlation is empty the IMAD input source is used.
+func (fwm *FEDWireMessage) setISOSenderSupplied(correlation string) {
+	fwm.SenderSupplied = NewSenderSupplied()
+	if correlation == "" && fwm.InputMessageAccountabilityData != nil {
+		correlation = fwm.InputMessageAccountabilityData.InputSource
+	}
+	fwm.SenderSupplied.UserRequestCorrelation = correlation
+}
@@ -0,0 +1,79 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readFEDWireMessage(t *testing.T, name string) FEDWireMessage {
+	t.Helper()
+	f, err := os.Open(filepath.Join("test", "testdata", name))
+	require.NoError(t, err)
+	defer f.Close()
+	file, err := NewReader(f).Read()
+	require.NoError(t, err)
+	return file.FEDWireMessage
+}
+
+func TestISODecimalAmounts(t *testing.T) {
+	require.Equal(t, "12345.67", isoDecimalFromImplied("000001234567"))
+	require.Equal(t, "0.05", isoDecimalFromImplied("000000000005"))
+
+	amount, err := impliedFromISODecimal("12345.67")
+	require.NoError(t, err)
+	require.Equal(t, "000001234567", amount)
+	amount, err = impliedFromISODecimal("100")
+	require.NoError(t, err)
+	require.Equal(t, "000000010000", amount)
+	_, err = impliedFromISODecimal("1.234")
+	require.True(t, errors.Is(err, ErrNonAmount))
+
+	require.Equal(t, "1500.49", isoDecimalFromComma("000000001500,49"))
+	require.Equal(t, "1500,49", commaFromISODecimal("1500.49"))
+}
+
+func TestCodeWordInstructions(t *testing.T) {
+	instructions := codeWordInstructions("ACC", []string{"Line One", "", "Line Three", ""})
+	require.Len(t, instructions, 3)
+	require.Equal(t, "/ACC/Line One", instructions[0].InstructionInformation)
+	require.Equal(t, "//", instructions[1].InstructionInformation)
+
+	decoded := decodeCodeWordInstructions(append(instructions, codeWordInstructions("BNF", []string{"Beneficiary"})...))
+	require.Equal(t, []string{"Line One", "", "Line Three"}, decoded["ACC"])
+	require.Equal(t, []string{"Beneficiary"}, decoded["BNF"])
+}
+
+func TestISOPartyFromIdentified(t *testing.T) {
+	party, account := isoPartyFromIdentified(DemandDepositAccountNumber, "123456789", "Name", Address{AddressLineOne: "Address One"})
+	require.Equal(t, "123456789", isoAccountNumber(account))
+	code, identifier, name, addr := identifiedFromISOParty(party, account)
+	require.Equal(t, DemandDepositAccountNumber, code)
+	require.Equal(t, "123456789", identifier)
+	require.Equal(t, "Name", name)
+	require.Equal(t, "Address One", addr.AddressLineOne)
+
+	party, account = isoPartyFromIdentified(PassportNumber, "1234", "Name", Address{})
+	require.Nil(t, account)
+	code, identifier, _, _ = identifiedFromISOParty(party, account)
+	require.Equal(t, PassportNumber, code)
+	require.Equal(t, "1234", identifier)
+}
+
+func TestIMADFromISOMessageID(t *testing.T) {
+	imad := imadFromISOMessageID("20190410Source08000001")
+	require.NotNil(t, imad)
+	require.Equal(t, "20190410", imad.InputCycleDate)
+	require.Equal(t, "Source08", imad.InputSource)
+	require.Equal(t, "000001", imad.InputSequenceNumber)
+
+	require.Nil(t, imadFromISOMessageID("Previous Message Ident"))
+	require.Nil(t, imadFromISOMessageID(isoNotProvided))
+}
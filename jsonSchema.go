@@ -0,0 +1,297 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/base"
+)
+
+// JSONSchemaDraft is the JSON Schema version of NewJSONSchema
+const JSONSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+var (
+	// ErrJSONSchemaType is returned when a JSON value is not of the type of its schema
+	ErrJSONSchemaType = errors.New("is not of the type permitted by the JSON schema")
+	// ErrJSONSchemaUnknownField is returned for a JSON key which is not a field of the JSON schema
+	ErrJSONSchemaUnknownField = errors.New("is not a field of the JSON schema")
+	// ErrJSONSchemaMaxLength is returned when a JSON string is longer than the maxLength of its schema
+	ErrJSONSchemaMaxLength = errors.New("is longer than the maximum length of the JSON schema")
+	// ErrJSONSchemaEnum is returned when a JSON string is not one of the enum values of its schema
+	ErrJSONSchemaEnum = errors.New("is not one of the values permitted by the JSON schema")
+)
+
+// jsonSchemaEnums holds the values permitted for code fields, keyed by struct and field name. Empty is permitted as
+// the JSON encoding writes an empty string for each field without a value.
+var jsonSchemaEnums = newJSONSchemaEnums()
+
+// newJSONSchemaEnums returns the enums of the code fields, generated from their catalogs so the schema permits the
+// codes accepted by Validate and listed by Fields
+func newJSONSchemaEnums() map[string][]string {
+	enums := make(map[string][]string)
+	for _, keys := range []map[string][]string{fieldCatalogs, fieldConstants} {
+		for key := range keys {
+			enums[key] = append([]string{""}, fieldCodes(key)...)
+		}
+	}
+	return enums
+}
+
+// JSONSchema is a JSON Schema describing the JSON encoding of a File and its tags
+type JSONSchema struct {
+	// Schema is the JSON Schema version, set on the root schema
+	Schema string `json:"$schema,omitempty"`
+	// Title names the Go type described by the schema
+	Title string `json:"title,omitempty"`
+	// Type is the JSON type, or types, permitted
+	Type JSONSchemaTypes `json:"type"`
+	// Properties holds the schema of each field of an object
+	Properties map[string]*JSONSchema `json:"properties,omitempty"`
+	// Required lists the fields an object must contain
+	Required []string `json:"required,omitempty"`
+	// AdditionalProperties is false as an object may only contain its Properties
+	AdditionalProperties *bool `json:"additionalProperties,omitempty"`
+	// Enum lists the values permitted for a string
+	Enum []string `json:"enum,omitempty"`
+	// MaxLength is the maximum length of a string, taken from the width of the field in a Fedwire tag
+	MaxLength int `json:"maxLength,omitempty"`
+}
+
+// JSONSchemaTypes is one or more JSON types, written as a string when there is only one
+type JSONSchemaTypes []string
+
+// MarshalJSON writes a single type as a string and several types as an array
+func (t JSONSchemaTypes) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+// UnmarshalJSON reads either a single type or an array of types
+func (t *JSONSchemaTypes) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*t = JSONSchemaTypes{s}
+		return nil
+	}
+	var types []string
+	if err := json.Unmarshal(data, &types); err != nil {
+		return err
+	}
+	*t = types
+	return nil
+}
+
+// has reports if typ is one of the types
+func (t JSONSchemaTypes) has(typ string) bool {
+	for i := range t {
+		if t[i] == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// NewJSONSchema returns the JSON Schema of File generated from the Go types.
+//
+// Objects do not permit additional properties. Code fields enumerate the values of const.go accepted by Validate,
+// and fields written to a fixed width by their tag's XxxField() have that width as their maxLength.
+func NewJSONSchema() *JSONSchema {
+	s := jsonSchemaOf(reflect.TypeOf(File{}), nil)
+	s.Schema = JSONSchemaDraft
+	return s
+}
+
+// jsonSchemaOf returns the schema of a struct type. widths holds the widths of the fields of the enclosing tag.
+func jsonSchemaOf(t reflect.Type, widths map[string]int) *JSONSchema {
+	additional := false
+	s := &JSONSchema{
+		Title:                t.Name(),
+		Type:                 JSONSchemaTypes{"object"},
+		Properties:           make(map[string]*JSONSchema),
+		AdditionalProperties: &additional,
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		tag := strings.Split(field.Tag.Get("json"), ",")
+		name := tag[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		omitEmpty := strings.Contains(field.Tag.Get("json"), ",omitempty")
+
+		var property *JSONSchema
+		switch ft := field.Type; {
+		case ft.Kind() == reflect.Ptr && ft.Elem().Kind() == reflect.Struct:
+			property = jsonSchemaOf(ft.Elem(), jsonSchemaWidths(ft.Elem()))
+			if omitEmpty {
+				property.Type = append(property.Type, "null")
+			} else {
+				s.Required = append(s.Required, name)
+			}
+		case ft.Kind() == reflect.Struct:
+			property = jsonSchemaOf(ft, widths)
+			if !omitEmpty {
+				s.Required = append(s.Required, name)
+			}
+		case ft.Kind() == reflect.String:
+			property = &JSONSchema{
+				Type:      JSONSchemaTypes{"string"},
+				Enum:      jsonSchemaEnums[t.Name()+"."+field.Name],
+				MaxLength: widths[field.Name],
+			}
+		default:
+			continue
+		}
+		s.Properties[name] = property
+	}
+	return s
+}
+
+// jsonSchemaWidths returns the width of each string field of a tag, found by calling the tag's XxxField() method
+// with an over long value in field Xxx.
+func jsonSchemaWidths(t reflect.Type) map[string]int {
+	widths := make(map[string]int)
+	ptr := reflect.New(t)
+	for i := 0; i < ptr.NumMethod(); i++ {
+		method := ptr.Type().Method(i)
+		name := strings.TrimSuffix(method.Name, "Field")
+		if name == method.Name || method.Type.NumIn() != 1 || method.Type.NumOut() != 1 ||
+			method.Type.Out(0).Kind() != reflect.String {
+			continue
+		}
+		tag := reflect.New(t)
+		field, ok := jsonSchemaStringField(tag.Elem(), name)
+		if !ok {
+			continue
+		}
+		field.SetString(strings.Repeat("9", 1024))
+		widths[name] = utf8.RuneCountInString(tag.Method(i).Call(nil)[0].String())
+	}
+	return widths
+}
+
+// jsonSchemaStringField returns the exported string field with the name in v or the structs nested in v
+func jsonSchemaStringField(v reflect.Value, name string) (reflect.Value, bool) {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		switch field.Type.Kind() {
+		case reflect.String:
+			if field.Name == name {
+				return v.Field(i), true
+			}
+		case reflect.Struct:
+			if f, ok := jsonSchemaStringField(v.Field(i), name); ok {
+				return f, true
+			}
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// Validate checks the JSON document against the schema and returns a base.ErrorList with a FieldError for each
+// violation, named by the JSON path of the value (e.g. fedWireMessage.beneficiary.personal.identificationCode).
+func (s *JSONSchema) Validate(bs []byte) error {
+	var doc interface{}
+	if err := json.Unmarshal(bs, &doc); err != nil {
+		return err
+	}
+	var errs base.ErrorList
+	s.validate("", doc, &errs)
+	if errs.Empty() {
+		return nil
+	}
+	return errs
+}
+
+// validate adds an error to errs for each violation of the schema by v at path
+func (s *JSONSchema) validate(path string, v interface{}, errs *base.ErrorList) {
+	name := path
+	if name == "" {
+		name = "$"
+	}
+	switch value := v.(type) {
+	case nil:
+		if !s.Type.has("null") {
+			errs.Add(fieldError(name, ErrJSONSchemaType, "null"))
+		}
+	case map[string]interface{}:
+		if !s.Type.has("object") {
+			errs.Add(fieldError(name, ErrJSONSchemaType, "object"))
+			return
+		}
+		for _, required := range s.Required {
+			if _, ok := value[required]; !ok {
+				errs.Add(fieldError(jsonSchemaPath(path, required), ErrFieldRequired))
+			}
+		}
+		for _, key := range jsonSchemaSortedKeys(value) {
+			property, ok := s.Properties[key]
+			if !ok {
+				errs.Add(fieldError(jsonSchemaPath(path, key), ErrJSONSchemaUnknownField))
+				continue
+			}
+			property.validate(jsonSchemaPath(path, key), value[key], errs)
+		}
+	case string:
+		if !s.Type.has("string") {
+			errs.Add(fieldError(name, ErrJSONSchemaType, value))
+			return
+		}
+		if s.MaxLength > 0 && utf8.RuneCountInString(value) > s.MaxLength {
+			errs.Add(fieldError(name, ErrJSONSchemaMaxLength, value))
+		}
+		if len(s.Enum) > 0 && !jsonSchemaContains(s.Enum, value) {
+			errs.Add(fieldError(name, ErrJSONSchemaEnum, value))
+		}
+	default:
+		errs.Add(fieldError(name, ErrJSONSchemaType, fmt.Sprintf("%v", value)))
+	}
+}
+
+// jsonSchemaPath joins a JSON path and a key
+func jsonSchemaPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// jsonSchemaSortedKeys returns the keys of an object in order so errors are reported consistently
+func jsonSchemaSortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// jsonSchemaContains reports if s is one of values
+func jsonSchemaContains(values []string, s string) bool {
+	for i := range values {
+		if values[i] == s {
+			return true
+		}
+	}
+	return false
+}
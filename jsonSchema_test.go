@@ -0,0 +1,97 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewJSONSchema ensures the schema carries the enums of const.go and the widths of the tags
+func TestNewJSONSchema(t *testing.T) {
+	s := NewJSONSchema()
+	require.Equal(t, JSONSchemaDraft, s.Schema)
+	require.Equal(t, []string{"fedWireMessage"}, s.Required)
+
+	fwm := s.Properties["fedWireMessage"]
+	require.Contains(t, fwm.Required, "senderSupplied")
+	require.NotContains(t, fwm.Required, "beneficiary")
+	require.Equal(t, JSONSchemaTypes{"object", "null"}, fwm.Properties["beneficiary"].Type)
+	require.False(t, *fwm.Properties["beneficiary"].AdditionalProperties)
+
+	personal := fwm.Properties["beneficiary"].Properties["personal"]
+	require.Contains(t, personal.Properties["identificationCode"].Enum, DriversLicenseNumber)
+	require.Equal(t, 34, personal.Properties["identifier"].MaxLength)
+	require.Equal(t, 12, fwm.Properties["amount"].Properties["amount"].MaxLength)
+	require.Equal(t, 2048, fwm.Properties["relatedRemittance"].Properties["remittanceLocationElctronicAddress"].MaxLength)
+	require.Equal(t, []string{"", CreditIndicator, DebitIndicator}, fwm.Properties["adjustment"].Properties["creditDebitIndicator"].Enum)
+
+	// the enums are generated from the catalogs
+	adviceCodes := []string{""}
+	for _, code := range AdviceCodes() {
+		adviceCodes = append(adviceCodes, code.Value)
+	}
+	require.Equal(t, adviceCodes, fwm.Properties["fiBeneficiaryAdvice"].Properties["advice"].Properties["adviceCode"].Enum)
+	remittanceCodes := fwm.Properties["remittanceOriginator"].Properties["identificationCode"].Enum
+	for _, code := range append(OrganizationIdentificationCodes(), PrivateIdentificationCodes()...) {
+		require.Contains(t, remittanceCodes, code.Value)
+	}
+
+	for name, enum := range jsonSchemaEnums {
+		seen := make(map[string]bool)
+		for _, value := range enum {
+			require.False(t, seen[value], "%s has duplicate value %q", name, value)
+			seen[value] = true
+		}
+	}
+
+	bs, err := json.Marshal(s)
+	require.NoError(t, err)
+	var read JSONSchema
+	require.NoError(t, json.Unmarshal(bs, &read))
+	require.Equal(t, *s, read)
+}
+
+// TestJSONSchema_Validate accepts the JSON of valid messages and reports violations by JSON path
+func TestJSONSchema_Validate(t *testing.T) {
+	s := NewJSONSchema()
+	for _, name := range []string{"fedWireMessage-CustomerTransfer.txt", "fedWireMessage-CustomerTransferPlusCOVS.txt",
+		"fedWireMessage-CustomerTransferPlusStructuredRemittance.txt", "fedWireMessage-BankTransfer.txt"} {
+		bs, err := json.Marshal(File{FEDWireMessage: readFEDWireMessage(t, name)})
+		require.NoError(t, err)
+		require.NoError(t, s.Validate(bs), name)
+	}
+
+	err := s.Validate([]byte(`{"id": "", "fedWireMessage": {"amount": {"amount": "0000000012345"},
+		"beneficiary": {"personal": {"identificationCode": "Z"}}, "relatedRemittance": {"remittanceLocationElectronicAddress": ""},
+		"senderReference": 12}}`))
+	var list base.ErrorList
+	require.True(t, errors.As(err, &list))
+
+	expected := map[string]error{
+		"fedWireMessage.amount.amount":                                         ErrJSONSchemaMaxLength,
+		"fedWireMessage.beneficiary.personal.identificationCode":               ErrJSONSchemaEnum,
+		"fedWireMessage.relatedRemittance.remittanceLocationElectronicAddress": ErrJSONSchemaUnknownField,
+		"fedWireMessage.senderReference":                                       ErrJSONSchemaType,
+		"fedWireMessage.senderSupplied":                                        ErrFieldRequired,
+	}
+	found := make(map[string]error)
+	for _, err := range list {
+		var fe *FieldError
+		require.True(t, errors.As(err, &fe))
+		found[fe.FieldName] = fe.Err
+	}
+	for path, err := range expected {
+		require.Equal(t, err, found[path], path)
+	}
+
+	require.Error(t, s.Validate([]byte(`{`)))
+	require.True(t, errors.As(s.Validate([]byte(`[]`)), &list))
+	require.True(t, errors.Is(list[0], ErrJSONSchemaType))
+}
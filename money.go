@@ -0,0 +1,196 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/currency"
+)
+
+// maxDecimalDigits is the most digits a Decimal holds without overflowing an int64
+const maxDecimalDigits = 18
+
+// Decimal is an exact decimal number of Units × 10^-Scale, e.g. 1234.56 is Decimal{Units: 123456, Scale: 2}
+type Decimal struct {
+	// Units is the number in units of 10^-Scale
+	Units int64
+	// Scale is the number of decimal places
+	Scale int
+}
+
+// ParseDecimal parses a non-negative decimal number with at most one decimal marker, which may be either a period
+// (1234.56) or a comma (1234,56) as used by Fedwire.
+func ParseDecimal(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+	whole, fraction := s, ""
+	if idx := strings.IndexAny(s, ".,"); idx >= 0 {
+		whole, fraction = s[:idx], s[idx+1:]
+	}
+	digits := strings.TrimLeft(whole+fraction, "0")
+	if whole+fraction == "" || numericRegex.MatchString(whole+fraction) {
+		return Decimal{}, ErrNonAmount
+	}
+	if len(digits) > maxDecimalDigits {
+		return Decimal{}, ErrMoneyRange
+	}
+	units := int64(0)
+	if digits != "" {
+		var err error
+		if units, err = strconv.ParseInt(digits, 10, 64); err != nil {
+			return Decimal{}, ErrNonAmount
+		}
+	}
+	return Decimal{Units: units, Scale: len(fraction)}, nil
+}
+
+// String returns the decimal with a period decimal marker, e.g. 1234.56
+func (d Decimal) String() string {
+	s := strconv.FormatInt(d.Units, 10)
+	sign := ""
+	if d.Units < 0 {
+		sign, s = "-", s[1:]
+	}
+	if d.Scale <= 0 {
+		return sign + s + strings.Repeat("0", -d.Scale)
+	}
+	if len(s) <= d.Scale {
+		s = strings.Repeat("0", d.Scale-len(s)+1) + s
+	}
+	return sign + s[:len(s)-d.Scale] + "." + s[len(s)-d.Scale:]
+}
+
+// Rescale returns the decimal with scale decimal places. ErrMoneyPrecision is returned when non-zero decimal places
+// would be dropped and ErrMoneyRange when the units would overflow.
+func (d Decimal) Rescale(scale int) (Decimal, error) {
+	for d.Scale < scale {
+		if d.Units > math.MaxInt64/10 || d.Units < math.MinInt64/10 {
+			return Decimal{}, ErrMoneyRange
+		}
+		d.Units *= 10
+		d.Scale++
+	}
+	for d.Scale > scale {
+		if d.Units%10 != 0 {
+			return Decimal{}, ErrMoneyPrecision
+		}
+		d.Units /= 10
+		d.Scale--
+	}
+	return d, nil
+}
+
+// commaString returns the decimal with a comma decimal marker as used by Fedwire, e.g. 1234,56
+func (d Decimal) commaString() string {
+	return strings.Replace(d.String(), ".", ",", 1)
+}
+
+// Money is an exact amount of a currency
+type Money struct {
+	// Currency is the ISO 4217 currency code, e.g. USD
+	Currency string
+	// Amount of the currency
+	Amount Decimal
+}
+
+// NewMoney returns the Money of minorUnits of the currency, e.g. NewMoney("USD", 123456) is $1,234.56 and
+// NewMoney("JPY", 1234) is ¥1,234
+func NewMoney(code string, minorUnits int64) Money {
+	return Money{
+		Currency: code,
+		Amount:   Decimal{Units: minorUnits, Scale: CurrencyScale(code)},
+	}
+}
+
+// ParseMoney parses an amount of the currency with a period or comma decimal marker
+func ParseMoney(code, amount string) (Money, error) {
+	if _, err := currency.ParseISO(code); err != nil {
+		return Money{}, fieldError("CurrencyCode", ErrNonCurrencyCode, code)
+	}
+	d, err := ParseDecimal(amount)
+	if err != nil {
+		return Money{}, fieldError("Amount", err, amount)
+	}
+	return Money{Currency: code, Amount: d}, nil
+}
+
+// CurrencyScale returns the number of decimal places of the minor unit of an ISO 4217 currency, e.g. 2 for USD
+// and 0 for JPY. Unrecognized currencies return 2.
+func CurrencyScale(code string) int {
+	unit, err := currency.ParseISO(code)
+	if err != nil {
+		return 2
+	}
+	scale, _ := currency.Standard.Rounding(unit)
+	return scale
+}
+
+// MinorUnits returns the amount in minor units of the currency, e.g. cents of USD. ErrMoneyPrecision is returned
+// when the amount has fractions of a minor unit.
+func (m Money) MinorUnits() (int64, error) {
+	d, err := m.Amount.Rescale(CurrencyScale(m.Currency))
+	if err != nil {
+		return 0, fieldError("Amount", err, m.Amount.String())
+	}
+	return d.Units, nil
+}
+
+// String returns the currency and amount, e.g. USD 1234.56
+func (m Money) String() string {
+	return m.Currency + " " + m.Amount.String()
+}
+
+// fieldDecimal returns the amount with at least the decimal places of the currency's minor unit and at most
+// maxScale decimal places, ensuring it is not negative
+func (m Money) fieldDecimal(maxScale int) (Decimal, error) {
+	d := m.Amount
+	if d.Units < 0 {
+		return Decimal{}, fieldError("Amount", ErrMoneyRange, d.String())
+	}
+	scale := CurrencyScale(m.Currency)
+	if d.Scale > scale {
+		scale = d.Scale
+	}
+	if scale > maxScale {
+		scale = maxScale
+	}
+	d, err := d.Rescale(scale)
+	if err != nil {
+		return Decimal{}, fieldError("Amount", err, m.Amount.String())
+	}
+	return d, nil
+}
+
+// wireAmount formats an amount with a comma decimal marker and at least the decimal places of the currency's minor
+// unit, e.g. 1234,56 or 5,00, and ensures it is at most width characters.
+func (m Money) wireAmount(width int) (string, error) {
+	d, err := m.fieldDecimal(maxDecimalDigits)
+	if err != nil {
+		return "", err
+	}
+	s := d.commaString()
+	if len(s) > width {
+		return "", fieldError("Amount", ErrMoneyRange, s)
+	}
+	return s, nil
+}
+
+// parseWireMoney parses a Fedwire currency and comma decimal amount, e.g. USD1234,56
+func parseWireMoney(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 3 {
+		return Money{}, ErrNonAmount
+	}
+	if _, err := currency.ParseISO(s[:3]); err != nil {
+		return Money{}, ErrNonCurrencyCode
+	}
+	d, err := ParseDecimal(s[3:])
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Currency: s[:3], Amount: d}, nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseDecimal parses period and comma decimals exactly
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		s   string
+		d   Decimal
+		err error
+	}{
+		{s: "1234.56", d: Decimal{Units: 123456, Scale: 2}},
+		{s: "1234,56", d: Decimal{Units: 123456, Scale: 2}},
+		{s: "0,99", d: Decimal{Units: 99, Scale: 2}},
+		{s: "000001234567", d: Decimal{Units: 1234567}},
+		{s: "1234,", d: Decimal{Units: 1234}},
+		{s: ",5", d: Decimal{Units: 5, Scale: 1}},
+		{s: "0.00", d: Decimal{Scale: 2}},
+		{s: "999999999999999999", d: Decimal{Units: 999999999999999999}},
+		{s: "0.000000000000000000001", d: Decimal{Units: 1, Scale: 21}},
+		{s: "", err: ErrNonAmount},
+		{s: ",", err: ErrNonAmount},
+		{s: "1,2,3", err: ErrNonAmount},
+		{s: "-1", err: ErrNonAmount},
+		{s: "1e3", err: ErrNonAmount},
+		{s: "1000000000000000000", err: ErrMoneyRange},
+	}
+	for _, tt := range tests {
+		d, err := ParseDecimal(tt.s)
+		if tt.err != nil {
+			require.True(t, errors.Is(err, tt.err), tt.s)
+			continue
+		}
+		require.NoError(t, err, tt.s)
+		require.Equal(t, tt.d, d, tt.s)
+	}
+}
+
+// TestDecimalString formats decimals with a period decimal marker
+func TestDecimalString(t *testing.T) {
+	require.Equal(t, "1234.56", Decimal{Units: 123456, Scale: 2}.String())
+	require.Equal(t, "0.05", Decimal{Units: 5, Scale: 2}.String())
+	require.Equal(t, "-0.05", Decimal{Units: -5, Scale: 2}.String())
+	require.Equal(t, "1500", Decimal{Units: 1500}.String())
+	require.Equal(t, "1500", Decimal{Units: 15, Scale: -2}.String())
+	require.Equal(t, "0.000000000000000000001", Decimal{Units: 1, Scale: 21}.String())
+}
+
+// TestDecimalRescale never drops non-zero decimal places or overflows
+func TestDecimalRescale(t *testing.T) {
+	d, err := Decimal{Units: 5}.Rescale(2)
+	require.NoError(t, err)
+	require.Equal(t, Decimal{Units: 500, Scale: 2}, d)
+
+	d, err = Decimal{Units: 123400, Scale: 4}.Rescale(2)
+	require.NoError(t, err)
+	require.Equal(t, Decimal{Units: 1234, Scale: 2}, d)
+
+	_, err = Decimal{Units: 123401, Scale: 4}.Rescale(2)
+	require.True(t, errors.Is(err, ErrMoneyPrecision))
+	_, err = Decimal{Units: 999999999999999999}.Rescale(2)
+	require.True(t, errors.Is(err, ErrMoneyRange))
+}
+
+// TestMoney converts between amounts and minor units of the currency
+func TestMoney(t *testing.T) {
+	require.Equal(t, 2, CurrencyScale("USD"))
+	require.Equal(t, 0, CurrencyScale("JPY"))
+	require.Equal(t, 3, CurrencyScale("BHD"))
+	require.Equal(t, 2, CurrencyScale("XZP"))
+
+	m, err := ParseMoney("JPY", "1500")
+	require.NoError(t, err)
+	require.Equal(t, NewMoney("JPY", 1500), m)
+	require.Equal(t, "JPY 1500", m.String())
+
+	m, err = ParseMoney("USD", "1234,5")
+	require.NoError(t, err)
+	cents, err := m.MinorUnits()
+	require.NoError(t, err)
+	require.Equal(t, int64(123450), cents)
+
+	m, err = ParseMoney("JPY", "1500.5")
+	require.NoError(t, err)
+	_, err = m.MinorUnits()
+	require.True(t, errors.Is(err, ErrMoneyPrecision))
+
+	_, err = ParseMoney("XZP", "1")
+	require.True(t, errors.Is(err, ErrNonCurrencyCode))
+	_, err = ParseMoney("USD", "1.2.3")
+	require.True(t, errors.Is(err, ErrNonAmount))
+}
@@ -0,0 +1,249 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+)
+
+const (
+	// swiftBankOperationCredit is the 23B bank operation code of a customer credit transfer
+	swiftBankOperationCredit = "CRED"
+	// swiftChargesBeneficiary is the 71A code for charges borne by the beneficiary
+	swiftChargesBeneficiary = "BEN"
+	// swiftChargesShared is the 71A code for shared charges
+	swiftChargesShared = "SHA"
+)
+
+// ToMT103 converts a CTR or CTP customer transfer into a SWIFT MT103 single customer credit transfer.
+//
+// {3320} SenderReference is field 20, {1520} IMAD cycle date and {2000} Amount field 32A, {3710} InstructedAmount
+// field 33B and {3720} ExchangeRate field 36. {5000} Originator, or {5010} OriginatorOptionF, is field 50a, {5100}
+// OriginatorFI field 52a, {4000} BeneficiaryIntermediaryFI field 56a, {4100} BeneficiaryFI field 57a and {4200}
+// Beneficiary field 59a. {6000} OriginatorToBeneficiary is field 70, {3700} Charges fields 71A and 71F and {6500}
+// FIAdditionalFIToFI field 72. Field 71A defaults to SHA without {3700} Charges.
+//
+// The Fedwire tags and tag elements which have no MT103 field are returned as unmapped. The sender and receiver
+// ABAs are carried by the SWIFT header and are always returned as unmapped.
+func (fwm *FEDWireMessage) ToMT103() (*SwiftMessage, []SwiftUnmapped, error) {
+	if fwm.BusinessFunctionCode == nil {
+		return nil, nil, fieldError("BusinessFunctionCode", ErrFieldRequired)
+	}
+	bfc := fwm.BusinessFunctionCode.BusinessFunctionCode
+	switch {
+	case bfc == CustomerTransfer:
+	case bfc == CustomerTransferPlus && (fwm.LocalInstrument == nil || fwm.LocalInstrument.LocalInstrumentCode != SequenceBCoverPaymentStructured):
+	default:
+		return nil, nil, fieldError("BusinessFunctionCode", ErrSwiftBusinessFunctionCode, bfc)
+	}
+	valueDate, err := fwm.swiftValueDateAmount()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	msg := &SwiftMessage{MessageType: MT103}
+	msg.Fields = append(msg.Fields, fwm.swiftSenderReference(),
+		SwiftField{Tag: "23B", Lines: []string{swiftBankOperationCredit}}, valueDate)
+	if ia := fwm.InstructedAmount; ia != nil {
+		msg.Fields = append(msg.Fields, SwiftField{Tag: "33B", Lines: []string{ia.CurrencyCode + strings.TrimSpace(ia.Amount)}})
+	}
+	if er := fwm.ExchangeRate; er != nil {
+		msg.Fields = append(msg.Fields, SwiftField{Tag: "36", Lines: []string{strings.TrimSpace(er.ExchangeRate)}})
+	}
+
+	var unmapped []SwiftUnmapped
+	add := func(f *SwiftField, u []SwiftUnmapped) {
+		msg.Fields = appendSwiftField(msg.Fields, f)
+		unmapped = append(unmapped, u...)
+	}
+	switch {
+	case fwm.OriginatorOptionF != nil:
+		o := fwm.OriginatorOptionF
+		add(&SwiftField{Tag: "50F", Lines: nonEmpty(o.PartyIdentifier, o.Name, o.LineOne, o.LineTwo, o.LineThree)}, nil)
+		if fwm.Originator != nil {
+			unmapped = append(unmapped, SwiftUnmapped{Field: "Originator", Value: fwm.Originator.String()})
+		}
+	case fwm.Originator != nil:
+		add(swiftFieldFromPersonal("Originator.Personal", "50", fwm.Originator.Personal))
+	}
+	if fwm.OriginatorFI != nil {
+		add(swiftFieldFromFI("OriginatorFI.FinancialInstitution", "52", fwm.OriginatorFI.FinancialInstitution))
+	}
+	if fwm.BeneficiaryIntermediaryFI != nil {
+		add(swiftFieldFromFI("BeneficiaryIntermediaryFI.FinancialInstitution", "56", fwm.BeneficiaryIntermediaryFI.FinancialInstitution))
+	}
+	if fwm.BeneficiaryFI != nil {
+		add(swiftFieldFromFI("BeneficiaryFI.FinancialInstitution", "57", fwm.BeneficiaryFI.FinancialInstitution))
+	}
+	if fwm.Beneficiary != nil {
+		add(swiftFieldFromPersonal("Beneficiary.Personal", "59", fwm.Beneficiary.Personal))
+	}
+	if ob := fwm.OriginatorToBeneficiary; ob != nil {
+		add(swiftTextField("70", []string{ob.LineOne, ob.LineTwo, ob.LineThree, ob.LineFour}), nil)
+	}
+
+	charges := swiftChargesShared
+	if fwm.Charges != nil && fwm.Charges.ChargeDetails == CDBeneficiary {
+		charges = swiftChargesBeneficiary
+	}
+	msg.Fields = append(msg.Fields, SwiftField{Tag: "71A", Lines: []string{charges}})
+	if c := fwm.Charges; c != nil {
+		for _, amount := range nonEmpty(c.SendersChargesOne, c.SendersChargesTwo, c.SendersChargesThree, c.SendersChargesFour) {
+			msg.Fields = append(msg.Fields, SwiftField{Tag: "71F", Lines: []string{amount}})
+		}
+	}
+	if fi := fwm.FIAdditionalFIToFI; fi != nil {
+		add(swiftTextField("72", fiToFILinesOf(fi.AdditionalFIToFI)), nil)
+	}
+
+	unmapped = append(unmapped, fwm.swiftUnmappedTags("SenderSupplied", "TypeSubType", "InputMessageAccountabilityData",
+		"Amount", "BusinessFunctionCode", "LocalInstrument", "SenderReference", "Charges", "InstructedAmount", "ExchangeRate",
+		"Originator", "OriginatorOptionF", "OriginatorFI", "BeneficiaryIntermediaryFI", "BeneficiaryFI", "Beneficiary",
+		"OriginatorToBeneficiary", "FIAdditionalFIToFI")...)
+	return msg, unmapped, nil
+}
+
+// FEDWireMessageFromMT103 converts a SWIFT MT103 single customer credit transfer into a customer transfer.
+//
+// The fields are mapped as in ToMT103. The message is a CTR, or a CTP when field 50F holds more than the party
+// identifier, name and address of a {5000} Originator and is carried as {5010} OriginatorOptionF. 71A OUR has no Fedwire charge details and is returned as unmapped, as is every field without
+// a Fedwire tag. {1500} SenderSupplied, {3100} SenderDepositoryInstitution and {3400} ReceiverDepositoryInstitution
+// are not set, callers must add them before a Validate() call.
+func FEDWireMessageFromMT103(msg *SwiftMessage) (*FEDWireMessage, []SwiftUnmapped, error) {
+	if msg == nil {
+		return nil, nil, fieldError("SwiftMessage", ErrFieldRequired)
+	}
+	if msg.MessageType != MT103 {
+		return nil, nil, fieldError("MessageType", ErrSwiftMessageType, msg.MessageType)
+	}
+	fwm := &FEDWireMessage{}
+	fwm.TypeSubType = NewTypeSubType()
+	fwm.TypeSubType.TypeCode, fwm.TypeSubType.SubTypeCode = FundsTransfer, BasicFundsTransfer
+	fwm.BusinessFunctionCode = NewBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransfer
+	if err := fwm.setFromSwiftValueDateAmount(msg.Field("32A")); err != nil {
+		return nil, nil, err
+	}
+	fwm.setFromSwiftSenderReference(msg.Field("20"))
+
+	var unmapped []SwiftUnmapped
+	if f := msg.Field("23B"); f != nil && swiftFieldValue(f) != swiftBankOperationCredit {
+		unmapped = append(unmapped, SwiftUnmapped{Field: f.Tag, Value: swiftFieldValue(f)})
+	}
+	if f := msg.Field("33B"); f != nil {
+		if value := swiftFieldValue(f); len(value) > 3 {
+			fwm.InstructedAmount = NewInstructedAmount()
+			fwm.InstructedAmount.CurrencyCode, fwm.InstructedAmount.Amount = value[:3], value[3:]
+		}
+	}
+	if f := msg.Field("36"); f != nil {
+		fwm.ExchangeRate = NewExchangeRate()
+		fwm.ExchangeRate.ExchangeRate = swiftFieldValue(f)
+	}
+
+	if f := msg.Field("50a"); f != nil {
+		if _, option := swiftFieldOption(f.Tag); option == "F" && !swiftOptionFPersonal(f) {
+			o := NewOriginatorOptionF()
+			lines := nonEmpty(f.Lines...)
+			unmapped = append(unmapped, swiftTextLines(&SwiftField{Tag: f.Tag, Lines: lines},
+				[]*string{&o.PartyIdentifier, &o.Name, &o.LineOne, &o.LineTwo, &o.LineThree})...)
+			fwm.OriginatorOptionF = o
+			fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+		} else {
+			fwm.Originator = NewOriginator()
+			var u []SwiftUnmapped
+			fwm.Originator.Personal, u = personalFromSwiftField(f)
+			unmapped = append(unmapped, u...)
+		}
+	}
+	if f := msg.Field("52a"); f != nil {
+		fwm.OriginatorFI = NewOriginatorFI()
+		var u []SwiftUnmapped
+		fwm.OriginatorFI.FinancialInstitution, u = fiFromSwiftField(f)
+		unmapped = append(unmapped, u...)
+	}
+	if f := msg.Field("56a"); f != nil {
+		fwm.BeneficiaryIntermediaryFI = NewBeneficiaryIntermediaryFI()
+		var u []SwiftUnmapped
+		fwm.BeneficiaryIntermediaryFI.FinancialInstitution, u = fiFromSwiftField(f)
+		unmapped = append(unmapped, u...)
+	}
+	if f := msg.Field("57a"); f != nil {
+		fwm.BeneficiaryFI = NewBeneficiaryFI()
+		var u []SwiftUnmapped
+		fwm.BeneficiaryFI.FinancialInstitution, u = fiFromSwiftField(f)
+		unmapped = append(unmapped, u...)
+	}
+	if f := msg.Field("59a"); f != nil {
+		fwm.Beneficiary = NewBeneficiary()
+		var u []SwiftUnmapped
+		fwm.Beneficiary.Personal, u = personalFromSwiftField(f)
+		unmapped = append(unmapped, u...)
+	}
+	if f := msg.Field("70"); f != nil {
+		ob := NewOriginatorToBeneficiary()
+		unmapped = append(unmapped, swiftTextLines(f, []*string{&ob.LineOne, &ob.LineTwo, &ob.LineThree, &ob.LineFour})...)
+		fwm.OriginatorToBeneficiary = ob
+	}
+
+	unmapped = append(unmapped, fwm.setFromSwiftCharges(msg.Fields)...)
+	if f := msg.Field("72"); f != nil {
+		fwm.FIAdditionalFIToFI = NewFIAdditionalFIToFI()
+		var u []SwiftUnmapped
+		fwm.FIAdditionalFIToFI.AdditionalFIToFI, u = additionalFIToFIFromSwift(f)
+		unmapped = append(unmapped, u...)
+	}
+
+	unmapped = append(unmapped, swiftUnmappedFields(msg.Fields, "20", "23B", "32A", "33B", "36", "50a", "52a", "56a",
+		"57a", "59a", "70", "71A", "71F", "72")...)
+	return fwm, unmapped, nil
+}
+
+// setFromSwiftCharges populates {3700} Charges from the 71A and 71F fields and reports the charges without a Fedwire element
+func (fwm *FEDWireMessage) setFromSwiftCharges(fields []SwiftField) []SwiftUnmapped {
+	var unmapped []SwiftUnmapped
+	c := NewCharges()
+	switch code := swiftFieldValue(swiftFieldByTag(fields, "71A")); code {
+	case swiftChargesBeneficiary:
+		c.ChargeDetails = CDBeneficiary
+	case swiftChargesShared:
+		c.ChargeDetails = CDShared
+	case "":
+	default:
+		unmapped = append(unmapped, SwiftUnmapped{Field: "71A", Value: code})
+	}
+	var amounts []string
+	for _, f := range fields {
+		if f.Tag == "71F" {
+			amounts = append(amounts, swiftFieldValue(&f))
+		}
+	}
+	setLines([]*string{&c.SendersChargesOne, &c.SendersChargesTwo, &c.SendersChargesThree, &c.SendersChargesFour}, amounts)
+	if len(amounts) > 4 {
+		unmapped = append(unmapped, SwiftUnmapped{Field: "71F", Value: strings.Join(amounts[4:], "\n")})
+	}
+	if c.ChargeDetails == "" && len(amounts) == 0 {
+		return unmapped
+	}
+	if c.ChargeDetails == "" {
+		// Fedwire charges without charge details are not valid, sender's charges are only sent with BEN or SHA
+		unmapped = append(unmapped, SwiftUnmapped{Field: "71F", Value: strings.Join(amounts, "\n")})
+		return unmapped
+	}
+	fwm.Charges = c
+	return unmapped
+}
+
+// fiToFILinesOf returns the lines of an AdditionalFIToFI
+func fiToFILinesOf(a AdditionalFIToFI) []string {
+	return []string{a.LineOne, a.LineTwo, a.LineThree, a.LineFour, a.LineFive, a.LineSix}
+}
+
+// additionalFIToFIFromSwift fills an AdditionalFIToFI from a 72 field and reports the lines which do not fit
+func additionalFIToFIFromSwift(f *SwiftField) (AdditionalFIToFI, []SwiftUnmapped) {
+	var a AdditionalFIToFI
+	unmapped := swiftTextLines(f, []*string{&a.LineOne, &a.LineTwo, &a.LineThree, &a.LineFour, &a.LineFive, &a.LineSix})
+	return a, unmapped
+}
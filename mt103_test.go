@@ -0,0 +1,118 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestMT103_CustomerTransfer converts a customer transfer into an MT103 and back
+func TestMT103_CustomerTransfer(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+
+	msg, unmapped, err := fwm.ToMT103()
+	require.NoError(t, err)
+	require.Equal(t, []string{"190410USD12345,67"}, msg.Field("32A").Lines)
+	require.Equal(t, "50F", msg.Field("50a").Tag)
+	require.Equal(t, []string{"CCPT/1234", "1/Name", "2/Address One", "2/Address Two", "2/Address Three"}, msg.Field("50a").Lines)
+	require.Equal(t, "52D", msg.Field("52a").Tag)
+	require.Equal(t, []string{"BEN"}, msg.Field("71A").Lines)
+	require.Contains(t, unmapped, SwiftUnmapped{Field: "Beneficiary.Personal.Identifier", Value: "31234"})
+	require.Contains(t, unmapped, SwiftUnmapped{Field: "FIReceiverFI", Value: fwm.FIReceiverFI.String()})
+
+	read, err := ParseSwiftMessage(MT103, msg.String())
+	require.NoError(t, err)
+	out, unmapped, err := FEDWireMessageFromMT103(read)
+	require.NoError(t, err)
+	require.Empty(t, unmapped)
+	require.Equal(t, CustomerTransfer, out.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, fwm.InputMessageAccountabilityData.InputCycleDate, out.InputMessageAccountabilityData.InputCycleDate)
+	require.Equal(t, fwm.Amount.String(), out.Amount.String())
+	require.Equal(t, fwm.SenderReference.String(), out.SenderReference.String())
+	require.Equal(t, fwm.Charges.String(), out.Charges.String())
+	require.Equal(t, fwm.InstructedAmount.String(), out.InstructedAmount.String())
+	require.Equal(t, fwm.ExchangeRate.String(), out.ExchangeRate.String())
+	require.Equal(t, fwm.Originator.String(), out.Originator.String())
+	require.Equal(t, fwm.OriginatorFI.String(), out.OriginatorFI.String())
+	require.Equal(t, fwm.BeneficiaryIntermediaryFI.String(), out.BeneficiaryIntermediaryFI.String())
+	require.Equal(t, fwm.BeneficiaryFI.String(), out.BeneficiaryFI.String())
+	require.Equal(t, fwm.OriginatorToBeneficiary.String(), out.OriginatorToBeneficiary.String())
+	require.Equal(t, fwm.FIAdditionalFIToFI.String(), out.FIAdditionalFIToFI.String())
+	require.Equal(t, "Name", out.Beneficiary.Personal.Name)
+}
+
+// TestMT103_OriginatorOptionF carries a 50F ordering customer with a date of birth as {5010} OriginatorOptionF
+func TestMT103_OriginatorOptionF(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransferPlus.txt")
+	fwm.Originator = nil
+	fwm.OriginatorOptionF = NewOriginatorOptionF()
+	fwm.OriginatorOptionF.PartyIdentifier = "TXID/123-45-6789"
+	fwm.OriginatorOptionF.Name = "1/Name"
+	fwm.OriginatorOptionF.LineOne = "2/1000 Colonial Farm Rd"
+	fwm.OriginatorOptionF.LineTwo = "4/19800101"
+
+	msg, _, err := fwm.ToSwift()
+	require.NoError(t, err)
+	require.Equal(t, MT103, msg.MessageType)
+	require.Equal(t, []string{"TXID/123-45-6789", "1/Name", "2/1000 Colonial Farm Rd", "4/19800101"}, msg.Field("50a").Lines)
+
+	out, _, err := FEDWireMessageFromSwift(msg)
+	require.NoError(t, err)
+	require.Equal(t, CustomerTransferPlus, out.BusinessFunctionCode.BusinessFunctionCode)
+	require.Nil(t, out.Originator)
+	require.Equal(t, fwm.OriginatorOptionF.String(), out.OriginatorOptionF.String())
+}
+
+// TestMT103_FromText reads an MT103 and reports the fields without a Fedwire tag
+func TestMT103_FromText(t *testing.T) {
+	bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", "mt103-CustomerTransfer.txt"))
+	require.NoError(t, err)
+	msg, err := ParseSwiftMessage(MT103, string(bs))
+	require.NoError(t, err)
+
+	fwm, unmapped, err := FEDWireMessageFromMT103(msg)
+	require.NoError(t, err)
+	require.Equal(t, "20190410", fwm.InputMessageAccountabilityData.InputCycleDate)
+	require.Equal(t, "000000150000", fwm.Amount.Amount)
+	require.Equal(t, "INV-2019-0410", fwm.SenderReference.SenderReference)
+	require.Equal(t, DemandDepositAccountNumber, fwm.Originator.Personal.IdentificationCode)
+	require.Equal(t, "12345678", fwm.Originator.Personal.Identifier)
+	require.Equal(t, "JANE DOE", fwm.Originator.Personal.Name)
+	require.Equal(t, "NEW YORK NY", fwm.Originator.Personal.Address.AddressLineTwo)
+	require.Equal(t, SWIFTBankIdentifierCode, fwm.OriginatorFI.FinancialInstitution.IdentificationCode)
+	require.Equal(t, "DEUTDEFF", fwm.BeneficiaryFI.FinancialInstitution.Identifier)
+	require.Equal(t, "DE89370400440532013000", fwm.Beneficiary.Personal.Identifier)
+	require.Nil(t, fwm.Charges)
+	require.Equal(t, []SwiftUnmapped{
+		{Field: "71A", Value: "OUR"},
+		{Field: "23E", Value: "SDVA"},
+		{Field: "53A", Value: "CITIUS33"},
+	}, unmapped)
+}
+
+// TestMT103_Errors ensures messages without an MT103 mapping are rejected
+func TestMT103_Errors(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-BankTransfer.txt")
+	_, _, err := fwm.ToMT103()
+	require.True(t, errors.Is(err, ErrSwiftBusinessFunctionCode))
+
+	fwm = readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	fwm.InputMessageAccountabilityData = nil
+	_, _, err = fwm.ToMT103()
+	require.True(t, errors.Is(err, ErrFieldRequired))
+
+	msg := &SwiftMessage{MessageType: MT103, Fields: []SwiftField{{Tag: "32A", Lines: []string{"190410EUR1500,00"}}}}
+	_, _, err = FEDWireMessageFromMT103(msg)
+	require.True(t, errors.Is(err, ErrSwiftCurrency))
+
+	msg.MessageType = MT202
+	_, _, err = FEDWireMessageFromMT103(msg)
+	require.True(t, errors.Is(err, ErrSwiftMessageType))
+}
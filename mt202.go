@@ -0,0 +1,308 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+)
+
+// ToMT202 converts a BTR bank transfer into a SWIFT MT202 general financial institution transfer.
+//
+// {3320} SenderReference is field 20, {4320} BeneficiaryReference field 21, {1520} IMAD cycle date and {2000} Amount
+// field 32A. {5000} Originator is field 52a, {4000} BeneficiaryIntermediaryFI field 56a, {4100} BeneficiaryFI field
+// 57a, {4200} Beneficiary field 58a and {6500} FIAdditionalFIToFI field 72. Without {4200} Beneficiary the receiver
+// is the beneficiary institution and {3400} ReceiverDepositoryInstitution is field 58a.
+//
+// The Fedwire tags and tag elements which have no MT202 field are returned as unmapped.
+func (fwm *FEDWireMessage) ToMT202() (*SwiftMessage, []SwiftUnmapped, error) {
+	if fwm.BusinessFunctionCode == nil {
+		return nil, nil, fieldError("BusinessFunctionCode", ErrFieldRequired)
+	}
+	if bfc := fwm.BusinessFunctionCode.BusinessFunctionCode; bfc != BankTransfer {
+		return nil, nil, fieldError("BusinessFunctionCode", ErrSwiftBusinessFunctionCode, bfc)
+	}
+	msg, unmapped, err := fwm.swiftSequenceA(MT202)
+	if err != nil {
+		return nil, nil, err
+	}
+	return msg, append(unmapped, fwm.swiftUnmappedTags(swiftSequenceATags(fwm)...)...), nil
+}
+
+// ToMT202COV converts a CTP customer transfer with local instrument COVS into a SWIFT MT202COV.
+//
+// Sequence A is mapped as in ToMT202. Sequence B is the underlying customer credit transfer carried by the {7xxx}
+// cover payment tags: {7050} OrderingCustomer is field 50a, {7052} OrderingInstitution field 52a, {7056}
+// IntermediaryInstitution field 56a, {7057} InstitutionAccount field 57a, {7059} BeneficiaryCustomer field 59a,
+// {7070} Remittance field 70, {7072} SenderToReceiver field 72 and {7033} CurrencyInstructedAmount field 33B. The
+// SWIFT field tag of a cover payment tag is used as the field option, an unknown tag is replaced by the default
+// option of the field and returned as unmapped.
+func (fwm *FEDWireMessage) ToMT202COV() (*SwiftMessage, []SwiftUnmapped, error) {
+	if fwm.BusinessFunctionCode == nil {
+		return nil, nil, fieldError("BusinessFunctionCode", ErrFieldRequired)
+	}
+	bfc := fwm.BusinessFunctionCode.BusinessFunctionCode
+	if bfc != CustomerTransferPlus || fwm.LocalInstrument == nil || fwm.LocalInstrument.LocalInstrumentCode != SequenceBCoverPaymentStructured {
+		return nil, nil, fieldError("BusinessFunctionCode", ErrSwiftBusinessFunctionCode, bfc)
+	}
+	msg, unmapped, err := fwm.swiftSequenceA(MT202COV)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	add := func(field, defaultTag string, cp *CoverPayment) {
+		if cp == nil {
+			return
+		}
+		tag := strings.ToUpper(strings.Trim(strings.TrimSpace(cp.SwiftFieldTag), ":"))
+		if !swiftTagMatches(tag, field+"a") || len(tag) > 3 {
+			if cp.SwiftFieldTag != "" {
+				unmapped = append(unmapped, SwiftUnmapped{Field: swiftCoverPaymentTags[field] + ".SwiftFieldTag", Value: cp.SwiftFieldTag})
+			}
+			tag = defaultTag
+		}
+		if lines := coverPaymentLines(*cp); len(lines) > 0 {
+			msg.SequenceB = append(msg.SequenceB, SwiftField{Tag: tag, Lines: lines})
+		}
+	}
+	if fwm.OrderingCustomer != nil {
+		add("50", "50K", &fwm.OrderingCustomer.CoverPayment)
+	}
+	if fwm.OrderingInstitution != nil {
+		add("52", "52D", &fwm.OrderingInstitution.CoverPayment)
+	}
+	if fwm.IntermediaryInstitution != nil {
+		add("56", "56D", &fwm.IntermediaryInstitution.CoverPayment)
+	}
+	if fwm.InstitutionAccount != nil {
+		add("57", "57D", &fwm.InstitutionAccount.CoverPayment)
+	}
+	if fwm.BeneficiaryCustomer != nil {
+		add("59", "59", &fwm.BeneficiaryCustomer.CoverPayment)
+	}
+	if fwm.Remittance != nil {
+		add("70", "70", &fwm.Remittance.CoverPayment)
+	}
+	if fwm.SenderToReceiver != nil {
+		add("72", "72", &fwm.SenderToReceiver.CoverPayment)
+	}
+	if cia := fwm.CurrencyInstructedAmount; cia != nil && strings.TrimSpace(cia.Amount) != "" {
+		amount := commaFromISODecimal(isoDecimalFromComma(cia.Amount))
+		msg.SequenceB = append(msg.SequenceB, SwiftField{Tag: "33B", Lines: []string{cia.CurrencyCode + amount}})
+	}
+
+	mapped := append(swiftSequenceATags(fwm), "LocalInstrument", "OrderingCustomer", "OrderingInstitution",
+		"IntermediaryInstitution", "InstitutionAccount", "BeneficiaryCustomer", "Remittance", "SenderToReceiver",
+		"CurrencyInstructedAmount")
+	return msg, append(unmapped, fwm.swiftUnmappedTags(mapped...)...), nil
+}
+
+// swiftCoverPaymentTags names the {7xxx} cover payment tag of each MT202COV sequence B field
+var swiftCoverPaymentTags = map[string]string{
+	"50": "OrderingCustomer",
+	"52": "OrderingInstitution",
+	"56": "IntermediaryInstitution",
+	"57": "InstitutionAccount",
+	"59": "BeneficiaryCustomer",
+	"70": "Remittance",
+	"72": "SenderToReceiver",
+}
+
+// swiftSequenceATags returns the Fedwire tags mapped into the fields of an MT202 or MT202COV sequence A
+func swiftSequenceATags(fwm *FEDWireMessage) []string {
+	tags := []string{"SenderSupplied", "TypeSubType", "InputMessageAccountabilityData", "Amount", "BusinessFunctionCode",
+		"SenderReference", "BeneficiaryReference", "Originator", "BeneficiaryIntermediaryFI", "BeneficiaryFI",
+		"Beneficiary", "FIAdditionalFIToFI"}
+	if fwm.Beneficiary == nil {
+		tags = append(tags, "ReceiverDepositoryInstitution")
+	}
+	return tags
+}
+
+// swiftSequenceA returns an MT202 or MT202COV holding the fields of sequence A
+func (fwm *FEDWireMessage) swiftSequenceA(messageType string) (*SwiftMessage, []SwiftUnmapped, error) {
+	valueDate, err := fwm.swiftValueDateAmount()
+	if err != nil {
+		return nil, nil, err
+	}
+	related := swiftNoReference
+	if fwm.BeneficiaryReference != nil && strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference) != "" {
+		related = strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference)
+	}
+
+	msg := &SwiftMessage{MessageType: messageType}
+	msg.Fields = append(msg.Fields, fwm.swiftSenderReference(), SwiftField{Tag: "21", Lines: []string{related}}, valueDate)
+
+	var unmapped []SwiftUnmapped
+	add := func(f *SwiftField, u []SwiftUnmapped) {
+		msg.Fields = appendSwiftField(msg.Fields, f)
+		unmapped = append(unmapped, u...)
+	}
+	if fwm.Originator != nil {
+		add(swiftFieldFromPersonal("Originator.Personal", "52", fwm.Originator.Personal))
+	}
+	if fwm.BeneficiaryIntermediaryFI != nil {
+		add(swiftFieldFromFI("BeneficiaryIntermediaryFI.FinancialInstitution", "56", fwm.BeneficiaryIntermediaryFI.FinancialInstitution))
+	}
+	if fwm.BeneficiaryFI != nil {
+		add(swiftFieldFromFI("BeneficiaryFI.FinancialInstitution", "57", fwm.BeneficiaryFI.FinancialInstitution))
+	}
+	switch {
+	case fwm.Beneficiary != nil:
+		add(swiftFieldFromPersonal("Beneficiary.Personal", "58", fwm.Beneficiary.Personal))
+	case fwm.ReceiverDepositoryInstitution != nil:
+		rdi := fwm.ReceiverDepositoryInstitution
+		add(swiftFieldFromIdentified("ReceiverDepositoryInstitution", "58", FEDRoutingNumber, rdi.ReceiverABANumber, rdi.ReceiverShortName, Address{}))
+	}
+	if fi := fwm.FIAdditionalFIToFI; fi != nil {
+		add(swiftTextField("72", fiToFILinesOf(fi.AdditionalFIToFI)), nil)
+	}
+	return msg, unmapped, nil
+}
+
+// FEDWireMessageFromMT202 converts a SWIFT MT202 general financial institution transfer into a BTR bank transfer.
+//
+// The fields are mapped as in ToMT202, every field without a Fedwire tag is returned as unmapped. {1500}
+// SenderSupplied, {3100} SenderDepositoryInstitution and {3400} ReceiverDepositoryInstitution are not set, callers
+// must add them before a Validate() call.
+func FEDWireMessageFromMT202(msg *SwiftMessage) (*FEDWireMessage, []SwiftUnmapped, error) {
+	if msg == nil {
+		return nil, nil, fieldError("SwiftMessage", ErrFieldRequired)
+	}
+	if msg.MessageType != MT202 {
+		return nil, nil, fieldError("MessageType", ErrSwiftMessageType, msg.MessageType)
+	}
+	fwm, unmapped, err := fedWireMessageFromSwiftSequenceA(msg.Fields, BankTransfer)
+	if err != nil {
+		return nil, nil, err
+	}
+	return fwm, unmapped, nil
+}
+
+// FEDWireMessageFromMT202COV converts a SWIFT MT202COV into a CTP customer transfer with local instrument COVS.
+//
+// Sequence A is mapped as in FEDWireMessageFromMT202 and sequence B as in ToMT202COV, every field without a Fedwire
+// tag is returned as unmapped. {5000} Originator is field 52a of sequence A, without it callers must add
+// {5000} Originator along with {1500} SenderSupplied, {3100} SenderDepositoryInstitution and {3400}
+// ReceiverDepositoryInstitution before a Validate() call.
+func FEDWireMessageFromMT202COV(msg *SwiftMessage) (*FEDWireMessage, []SwiftUnmapped, error) {
+	if msg == nil {
+		return nil, nil, fieldError("SwiftMessage", ErrFieldRequired)
+	}
+	if msg.MessageType != MT202COV {
+		return nil, nil, fieldError("MessageType", ErrSwiftMessageType, msg.MessageType)
+	}
+	fwm, unmapped, err := fedWireMessageFromSwiftSequenceA(msg.Fields, CustomerTransferPlus)
+	if err != nil {
+		return nil, nil, err
+	}
+	fwm.LocalInstrument = NewLocalInstrument()
+	fwm.LocalInstrument.LocalInstrumentCode = SequenceBCoverPaymentStructured
+
+	set := func(field string, max int) *CoverPayment {
+		f := swiftFieldByTag(msg.SequenceB, field)
+		if f == nil {
+			return nil
+		}
+		lines := nonEmpty(f.Lines...)
+		if len(lines) > max {
+			unmapped = append(unmapped, SwiftUnmapped{Field: f.Tag, Value: strings.Join(lines[max:], "\n")})
+		}
+		cp := newCoverPayment(f.Tag, lines, max)
+		return &cp
+	}
+	if cp := set("50a", 5); cp != nil {
+		fwm.OrderingCustomer = NewOrderingCustomer()
+		fwm.OrderingCustomer.CoverPayment = *cp
+	}
+	if cp := set("52a", 5); cp != nil {
+		fwm.OrderingInstitution = NewOrderingInstitution()
+		fwm.OrderingInstitution.CoverPayment = *cp
+	}
+	if cp := set("56a", 5); cp != nil {
+		fwm.IntermediaryInstitution = NewIntermediaryInstitution()
+		fwm.IntermediaryInstitution.CoverPayment = *cp
+	}
+	if cp := set("57a", 5); cp != nil {
+		fwm.InstitutionAccount = NewInstitutionAccount()
+		fwm.InstitutionAccount.CoverPayment = *cp
+	}
+	if cp := set("59a", 5); cp != nil {
+		fwm.BeneficiaryCustomer = NewBeneficiaryCustomer()
+		fwm.BeneficiaryCustomer.CoverPayment = *cp
+	}
+	if cp := set("70", 4); cp != nil {
+		fwm.Remittance = NewRemittance()
+		fwm.Remittance.CoverPayment = *cp
+	}
+	if cp := set("72", 6); cp != nil {
+		fwm.SenderToReceiver = NewSenderToReceiver()
+		fwm.SenderToReceiver.CoverPayment = *cp
+	}
+	if f := swiftFieldByTag(msg.SequenceB, "33B"); f != nil {
+		if value := swiftFieldValue(f); len(value) > 3 {
+			amount := commaFromISODecimal(isoDecimalFromComma(value[3:]))
+			if len(amount) < 15 {
+				amount = strings.Repeat("0", 15-len(amount)) + amount
+			}
+			fwm.CurrencyInstructedAmount = NewCurrencyInstructedAmount()
+			fwm.CurrencyInstructedAmount.SwiftFieldTag = f.Tag
+			fwm.CurrencyInstructedAmount.CurrencyCode = value[:3]
+			fwm.CurrencyInstructedAmount.Amount = amount
+		}
+	}
+	unmapped = append(unmapped, swiftUnmappedFields(msg.SequenceB, "50a", "52a", "56a", "57a", "59a", "70", "72", "33B")...)
+	return fwm, unmapped, nil
+}
+
+// fedWireMessageFromSwiftSequenceA converts the fields of an MT202 or MT202COV sequence A into a FEDWireMessage
+func fedWireMessageFromSwiftSequenceA(fields []SwiftField, bfc string) (*FEDWireMessage, []SwiftUnmapped, error) {
+	fwm := &FEDWireMessage{}
+	fwm.TypeSubType = NewTypeSubType()
+	fwm.TypeSubType.TypeCode, fwm.TypeSubType.SubTypeCode = FundsTransfer, BasicFundsTransfer
+	fwm.BusinessFunctionCode = NewBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = bfc
+	if err := fwm.setFromSwiftValueDateAmount(swiftFieldByTag(fields, "32A")); err != nil {
+		return nil, nil, err
+	}
+	fwm.setFromSwiftSenderReference(swiftFieldByTag(fields, "20"))
+	if ref := swiftFieldValue(swiftFieldByTag(fields, "21")); ref != "" && ref != swiftNoReference {
+		fwm.BeneficiaryReference = NewBeneficiaryReference()
+		fwm.BeneficiaryReference.BeneficiaryReference = ref
+	}
+
+	var unmapped []SwiftUnmapped
+	if f := swiftFieldByTag(fields, "52a"); f != nil {
+		fwm.Originator = NewOriginator()
+		var u []SwiftUnmapped
+		fwm.Originator.Personal, u = personalFromSwiftField(f)
+		unmapped = append(unmapped, u...)
+	}
+	if f := swiftFieldByTag(fields, "56a"); f != nil {
+		fwm.BeneficiaryIntermediaryFI = NewBeneficiaryIntermediaryFI()
+		var u []SwiftUnmapped
+		fwm.BeneficiaryIntermediaryFI.FinancialInstitution, u = fiFromSwiftField(f)
+		unmapped = append(unmapped, u...)
+	}
+	if f := swiftFieldByTag(fields, "57a"); f != nil {
+		fwm.BeneficiaryFI = NewBeneficiaryFI()
+		var u []SwiftUnmapped
+		fwm.BeneficiaryFI.FinancialInstitution, u = fiFromSwiftField(f)
+		unmapped = append(unmapped, u...)
+	}
+	if f := swiftFieldByTag(fields, "58a"); f != nil {
+		fwm.Beneficiary = NewBeneficiary()
+		var u []SwiftUnmapped
+		fwm.Beneficiary.Personal, u = personalFromSwiftField(f)
+		unmapped = append(unmapped, u...)
+	}
+	if f := swiftFieldByTag(fields, "72"); f != nil {
+		fwm.FIAdditionalFIToFI = NewFIAdditionalFIToFI()
+		var u []SwiftUnmapped
+		fwm.FIAdditionalFIToFI.AdditionalFIToFI, u = additionalFIToFIFromSwift(f)
+		unmapped = append(unmapped, u...)
+	}
+	unmapped = append(unmapped, swiftUnmappedFields(fields, "20", "21", "32A", "52a", "56a", "57a", "58a", "72")...)
+	return fwm, unmapped, nil
+}
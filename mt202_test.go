@@ -0,0 +1,105 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestMT202_BankTransfer converts a bank transfer into an MT202 and back
+func TestMT202_BankTransfer(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-BankTransfer.txt")
+	fwm.Originator.Personal = Personal{IdentificationCode: SWIFTBankIdentifierCode, Identifier: "WFBIUS6S"}
+	fwm.Beneficiary.Personal = Personal{IdentificationCode: FEDRoutingNumber, Identifier: "231380104", Name: "Citadel"}
+
+	msg, unmapped, err := fwm.ToSwift()
+	require.NoError(t, err)
+	require.Equal(t, MT202, msg.MessageType)
+	require.Equal(t, []string{"Reference"}, msg.Field("21").Lines)
+	require.Equal(t, SwiftField{Tag: "52A", Lines: []string{"WFBIUS6S"}}, *msg.Field("52a"))
+	require.Equal(t, SwiftField{Tag: "58D", Lines: []string{"//FW231380104", "Citadel"}}, *msg.Field("58a"))
+	require.Contains(t, unmapped, SwiftUnmapped{Field: "OriginatorFI", Value: fwm.OriginatorFI.String()})
+
+	read, err := ParseSwiftMessage(MT202, msg.String())
+	require.NoError(t, err)
+	out, unmapped, err := FEDWireMessageFromMT202(read)
+	require.NoError(t, err)
+	require.Empty(t, unmapped)
+	require.Equal(t, BankTransfer, out.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, fwm.Amount.String(), out.Amount.String())
+	require.Equal(t, fwm.SenderReference.String(), out.SenderReference.String())
+	require.Equal(t, fwm.BeneficiaryReference.String(), out.BeneficiaryReference.String())
+	require.Equal(t, fwm.Originator.String(), out.Originator.String())
+	require.Equal(t, fwm.BeneficiaryIntermediaryFI.String(), out.BeneficiaryIntermediaryFI.String())
+	require.Equal(t, fwm.BeneficiaryFI.String(), out.BeneficiaryFI.String())
+	require.Equal(t, fwm.Beneficiary.String(), out.Beneficiary.String())
+	require.Equal(t, fwm.FIAdditionalFIToFI.String(), out.FIAdditionalFIToFI.String())
+}
+
+// TestMT202_Receiver uses the receiver as the beneficiary institution of a bank transfer without a beneficiary
+func TestMT202_Receiver(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-BankTransfer.txt")
+	fwm.Beneficiary = nil
+
+	msg, unmapped, err := fwm.ToMT202()
+	require.NoError(t, err)
+	require.Equal(t, SwiftField{Tag: "58D", Lines: []string{"//FW231380104", "Citadel"}}, *msg.Field("58a"))
+	for _, u := range unmapped {
+		require.NotEqual(t, "ReceiverDepositoryInstitution", u.Field)
+	}
+}
+
+// TestMT202COV_CoverPayment converts a cover payment into an MT202COV and back
+func TestMT202COV_CoverPayment(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.txt")
+	fwm.OrderingCustomer.CoverPayment = newCoverPayment("50F", []string{"/123456789", "1/Jane Doe", "3/US/New York"}, 5)
+	fwm.InstitutionAccount.CoverPayment = newCoverPayment("57A", []string{"DEUTDEFF"}, 5)
+	fwm.CurrencyInstructedAmount.SwiftFieldTag = "33B"
+	fwm.Remittance.CoverPayment.SwiftFieldTag = "Swift"
+
+	msg, unmapped, err := fwm.ToSwift()
+	require.NoError(t, err)
+	require.Equal(t, MT202COV, msg.MessageType)
+	require.Equal(t, SwiftField{Tag: "50F", Lines: []string{"/123456789", "1/Jane Doe", "3/US/New York"}}, msg.SequenceB[0])
+	require.Equal(t, SwiftField{Tag: "57A", Lines: []string{"DEUTDEFF"}}, *swiftFieldByTag(msg.SequenceB, "57a"))
+	require.Equal(t, SwiftField{Tag: "33B", Lines: []string{"USD1500,49"}}, *swiftFieldByTag(msg.SequenceB, "33B"))
+	require.Contains(t, unmapped, SwiftUnmapped{Field: "Remittance.SwiftFieldTag", Value: "Swift"})
+
+	read, err := ParseSwiftMessage(MT202COV, msg.String())
+	require.NoError(t, err)
+	require.Len(t, read.SequenceB, len(msg.SequenceB))
+	out, unmapped, err := FEDWireMessageFromSwift(read)
+	require.NoError(t, err)
+	require.Empty(t, unmapped)
+	require.Equal(t, CustomerTransferPlus, out.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, SequenceBCoverPaymentStructured, out.LocalInstrument.LocalInstrumentCode)
+	require.Equal(t, fwm.BeneficiaryReference.String(), out.BeneficiaryReference.String())
+	require.Equal(t, fwm.OrderingCustomer.String(), out.OrderingCustomer.String())
+	require.Equal(t, fwm.InstitutionAccount.String(), out.InstitutionAccount.String())
+	require.Equal(t, fwm.CurrencyInstructedAmount.String(), out.CurrencyInstructedAmount.String())
+	require.Equal(t, "70", out.Remittance.CoverPayment.SwiftFieldTag)
+	require.Equal(t, fwm.Remittance.CoverPayment.SwiftLineFour, out.Remittance.CoverPayment.SwiftLineFour)
+	require.Equal(t, "72", out.SenderToReceiver.CoverPayment.SwiftFieldTag)
+	require.Equal(t, fwm.SenderToReceiver.CoverPayment.SwiftLineSix, out.SenderToReceiver.CoverPayment.SwiftLineSix)
+}
+
+// TestMT202_Errors ensures messages without an MT202 or MT202COV mapping are rejected
+func TestMT202_Errors(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	_, _, err := fwm.ToMT202()
+	require.True(t, errors.Is(err, ErrSwiftBusinessFunctionCode))
+	_, _, err = fwm.ToMT202COV()
+	require.True(t, errors.Is(err, ErrSwiftBusinessFunctionCode))
+
+	_, _, err = FEDWireMessageFromMT202COV(&SwiftMessage{MessageType: MT202})
+	require.True(t, errors.Is(err, ErrSwiftMessageType))
+	_, _, err = FEDWireMessageFromMT202(&SwiftMessage{MessageType: MT202})
+	require.True(t, errors.Is(err, ErrFieldRequired))
+	_, _, err = FEDWireMessageFromSwift(&SwiftMessage{MessageType: "101"})
+	require.True(t, errors.Is(err, ErrSwiftMessageType))
+}
@@ -114,6 +114,9 @@ func (oc *OrderingCustomer) Validate() error {
 	if err := oc.isAlphanumeric(oc.CoverPayment.SwiftLineFive); err != nil {
 		return fieldError("SwiftLineFive", err, oc.CoverPayment.SwiftLineFive)
 	}
+	if err := oc.validateCoverPayment(TagOrderingCustomer, oc.CoverPayment); err != nil {
+		return err
+	}
 	return nil
 }
 
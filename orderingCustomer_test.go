@@ -10,8 +10,8 @@ import (
 //  OrderingCustomer creates a OrderingCustomer
 func mockOrderingCustomer() *OrderingCustomer {
 	oc := NewOrderingCustomer()
-	oc.CoverPayment.SwiftFieldTag = "Swift Field Tag"
-	oc.CoverPayment.SwiftLineOne = "Swift Line One"
+	oc.CoverPayment.SwiftFieldTag = "50K"
+	oc.CoverPayment.SwiftLineOne = "/123456789"
 	oc.CoverPayment.SwiftLineTwo = "Swift Line Two"
 	oc.CoverPayment.SwiftLineThree = "Swift Line Three"
 	oc.CoverPayment.SwiftLineFour = "Swift Line Four"
@@ -118,3 +118,23 @@ func TestOrderingCustomerTagError(t *testing.T) {
 
 	require.EqualError(t, oc.Validate(), fieldError("tag", ErrValidTagForType, oc.tag).Error())
 }
+
+// TestOrderingCustomerSwiftFieldOption validates OrderingCustomer SwiftFieldTag is a SWIFT field option permitted for the tag
+func TestOrderingCustomerSwiftFieldOption(t *testing.T) {
+	oc := mockOrderingCustomer()
+	oc.CoverPayment.SwiftFieldTag = "52A"
+
+	err := oc.Validate()
+
+	require.EqualError(t, err, fieldError("SwiftFieldTag", ErrSwiftFieldOption, oc.CoverPayment.SwiftFieldTag).Error())
+}
+
+// TestOrderingCustomerSwiftFieldLine validates OrderingCustomer SWIFT lines follow the format of the SWIFT field option
+func TestOrderingCustomerSwiftFieldLine(t *testing.T) {
+	oc := mockOrderingCustomer()
+	oc.CoverPayment.SwiftLineOne = "Swift Line One"
+
+	err := oc.Validate()
+
+	require.EqualError(t, err, fieldError("SwiftLineFive", ErrSwiftFieldLine, oc.CoverPayment.SwiftLineFive).Error())
+}
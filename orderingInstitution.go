@@ -113,6 +113,9 @@ func (oi *OrderingInstitution) Validate() error {
 	if err := oi.isAlphanumeric(oi.CoverPayment.SwiftLineFive); err != nil {
 		return fieldError("SwiftLineFive", err, oi.CoverPayment.SwiftLineFive)
 	}
+	if err := oi.validateCoverPayment(TagOrderingInstitution, oi.CoverPayment); err != nil {
+		return err
+	}
 	return nil
 }
 
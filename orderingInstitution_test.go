@@ -10,8 +10,8 @@ import (
 //  OrderingInstitution creates a OrderingInstitution
 func mockOrderingInstitution() *OrderingInstitution {
 	oi := NewOrderingInstitution()
-	oi.CoverPayment.SwiftFieldTag = "Swift Field Tag"
-	oi.CoverPayment.SwiftLineOne = "Swift Line One"
+	oi.CoverPayment.SwiftFieldTag = "52D"
+	oi.CoverPayment.SwiftLineOne = "/123456789"
 	oi.CoverPayment.SwiftLineTwo = "Swift Line Two"
 	oi.CoverPayment.SwiftLineThree = "Swift Line Three"
 	oi.CoverPayment.SwiftLineFour = "Swift Line Four"
@@ -118,3 +118,23 @@ func TestOrderingInstitutionTagError(t *testing.T) {
 
 	require.EqualError(t, oi.Validate(), fieldError("tag", ErrValidTagForType, oi.tag).Error())
 }
+
+// TestOrderingInstitutionSwiftFieldOption validates OrderingInstitution SwiftFieldTag is a SWIFT field option permitted for the tag
+func TestOrderingInstitutionSwiftFieldOption(t *testing.T) {
+	oi := mockOrderingInstitution()
+	oi.CoverPayment.SwiftFieldTag = "50K"
+
+	err := oi.Validate()
+
+	require.EqualError(t, err, fieldError("SwiftFieldTag", ErrSwiftFieldOption, oi.CoverPayment.SwiftFieldTag).Error())
+}
+
+// TestOrderingInstitutionSwiftFieldLine validates OrderingInstitution SWIFT lines follow the format of the SWIFT field option
+func TestOrderingInstitutionSwiftFieldLine(t *testing.T) {
+	oi := mockOrderingInstitution()
+	oi.CoverPayment.SwiftFieldTag = "52A"
+
+	err := oi.Validate()
+
+	require.EqualError(t, err, fieldError("SwiftLineThree", ErrSwiftFieldLine, oi.CoverPayment.SwiftLineThree).Error())
+}
@@ -7,6 +7,7 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"time"
 )
 
 // OutputMessageAccountabilityData is the Output Message Accountability Data (OMAD) of the wire
@@ -130,3 +131,40 @@ func (omad *OutputMessageAccountabilityData) OutputTimeField() string {
 func (omad *OutputMessageAccountabilityData) OutputFRBApplicationIdentificationField() string {
 	return omad.alphaField(omad.OutputFRBApplicationIdentification, 4)
 }
+
+// CycleDate returns OutputCycleDate as midnight in FedwireLocation
+func (omad *OutputMessageAccountabilityData) CycleDate() (time.Time, error) {
+	t, err := parseCCYYMMDD(omad.OutputCycleDate, FedwireLocation)
+	if err != nil {
+		return t, fieldError("OutputCycleDate", err, omad.OutputCycleDate)
+	}
+	return t, nil
+}
+
+// SetCycleDate sets OutputCycleDate to the calendar date of t
+func (omad *OutputMessageAccountabilityData) SetCycleDate(t time.Time) {
+	omad.OutputCycleDate = t.Format(ccyymmddLayout)
+}
+
+// OutputDateTime returns OutputDate and OutputTime in FedwireLocation. The year is inferred from OutputCycleDate.
+func (omad *OutputMessageAccountabilityData) OutputDateTime() (time.Time, error) {
+	cycleDate, err := omad.CycleDate()
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, err := parseMMDDHHMM(cycleDate, omad.OutputDate, omad.OutputTime)
+	switch err {
+	case nil:
+		return t, nil
+	case ErrValidTime:
+		return t, fieldError("OutputTime", err, omad.OutputTime)
+	}
+	return t, fieldError("OutputDate", err, omad.OutputDate)
+}
+
+// SetOutputDateTime sets OutputDate and OutputTime to t in FedwireLocation
+func (omad *OutputMessageAccountabilityData) SetOutputDateTime(t time.Time) {
+	t = t.In(FedwireLocation)
+	omad.OutputDate = t.Format(mmddLayout)
+	omad.OutputTime = t.Format(hhmmLayout)
+}
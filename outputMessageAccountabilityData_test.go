@@ -1,8 +1,10 @@
 package wire
 
 import (
+	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -62,3 +64,38 @@ func TestOutputMessageAccountabilityDataTagError(t *testing.T) {
 
 	require.EqualError(t, omad.Validate(), fieldError("tag", ErrValidTagForType, omad.tag).Error())
 }
+
+// TestOutputMessageAccountabilityDataOutputDateTime converts OutputDate and OutputTime to and from a time.Time
+func TestOutputMessageAccountabilityDataOutputDateTime(t *testing.T) {
+	omad := mockOutputMessageAccountabilityData()
+	date, err := omad.CycleDate()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.May, 2, 0, 0, 0, 0, FedwireLocation), date)
+
+	output, err := omad.OutputDateTime()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.May, 2, 12, 30, 0, 0, FedwireLocation), output)
+
+	// the evening before the first cycle date of the year is in the previous year
+	omad.OutputCycleDate = "20200102"
+	omad.OutputDate = "1231"
+	omad.OutputTime = "2100"
+	output, err = omad.OutputDateTime()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.December, 31, 21, 0, 0, 0, FedwireLocation), output)
+
+	omad.SetOutputDateTime(time.Date(2020, time.July, 1, 16, 45, 0, 0, time.UTC))
+	require.Equal(t, "0701", omad.OutputDate)
+	require.Equal(t, "1245", omad.OutputTime)
+
+	omad.OutputTime = "2460"
+	_, err = omad.OutputDateTime()
+	require.True(t, errors.Is(err, ErrValidTime))
+	omad.OutputTime = "1200"
+	omad.OutputDate = "0229"
+	_, err = omad.OutputDateTime()
+	require.True(t, errors.Is(err, ErrValidDate))
+	omad.OutputCycleDate = ""
+	_, err = omad.OutputDateTime()
+	require.True(t, errors.Is(err, ErrValidDate))
+}
@@ -0,0 +1,283 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+)
+
+// Pacs002Namespace is the XML namespace of the supported pacs.002 FIToFIPaymentStatusReport version
+const Pacs002Namespace = "urn:iso:std:iso:20022:tech:xsd:pacs.002.001.10"
+
+const (
+	// isoStatusPending is the pacs.002 status of a payment in process or intercepted
+	isoStatusPending = "PDNG"
+	// isoStatusSettled is the pacs.002 status of a payment settled with accounting
+	isoStatusSettled = "ACSC"
+	// errorCategoryDataError is the {1130} ErrorCategory of a message rejected for a syntax or data error
+	errorCategoryDataError = "E"
+	// errorCategoryInProcess is the {1130} ErrorCategory of a message in process or intercepted
+	errorCategoryInProcess = "I"
+)
+
+// isoMessageStatuses maps {1100} MessageStatusIndicator values to pacs.002 transaction statuses. Both the outgoing
+// (0, 2, 3, 7) and incoming (N, S) indicators are mapped, a status converts back to the outgoing indicator.
+var isoMessageStatuses = map[string]string{
+	"0": isoStatusPending,
+	"2": isoStatusSettled,
+	"3": isoStatusRejected,
+	"7": isoStatusAccepted,
+	"N": isoStatusSettled,
+	"S": isoStatusAccepted,
+}
+
+// Pacs002Document is an ISO 20022 pacs.002 FIToFIPaymentStatusReport document
+type Pacs002Document struct {
+	XMLName      xml.Name                         `xml:"urn:iso:std:iso:20022:tech:xsd:pacs.002.001.10 Document"`
+	StatusReport Pacs002FIToFIPaymentStatusReport `xml:"FIToFIPmtStsRpt"`
+}
+
+// Pacs002FIToFIPaymentStatusReport is the FIToFIPmtStsRpt of a pacs.002 document
+type Pacs002FIToFIPaymentStatusReport struct {
+	GroupHeader       ISOGroupHeader           `xml:"GrpHdr"`
+	OriginalGroupInfo ISOOriginalGroupInfo     `xml:"OrgnlGrpInfAndSts"`
+	Transactions      []Pacs002TransactionInfo `xml:"TxInfAndSts"`
+}
+
+// Pacs002TransactionInfo is the TxInfAndSts of a pacs.002 document, which holds the status of one payment
+type Pacs002TransactionInfo struct {
+	StatusID                string          `xml:"StsId,omitempty"`
+	OriginalInstructionID   string          `xml:"OrgnlInstrId,omitempty"`
+	OriginalEndToEndID      string          `xml:"OrgnlEndToEndId,omitempty"`
+	TransactionStatus       string          `xml:"TxSts"`
+	StatusReason            []ISOReasonInfo `xml:"StsRsnInf,omitempty"`
+	AcceptanceDateTime      string          `xml:"AccptncDtTm,omitempty"`
+	ClearingSystemReference string          `xml:"ClrSysRef,omitempty"`
+}
+
+// Pacs002FromXML reads a pacs.002 document
+func Pacs002FromXML(bs []byte) (*Pacs002Document, error) {
+	doc := &Pacs002Document{}
+	if err := isoFromXML(bs, doc, "pacs.002"); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// XML returns the pacs.002 document as XML
+func (doc *Pacs002Document) XML() ([]byte, error) {
+	return isoXML(doc)
+}
+
+// ToISOStatusReport converts the Fed-appended tags of a message into an ISO 20022 status report. A message rejected
+// for a data error ({1130} ErrorCategory E) is converted into an admi.002 MessageReject, any other message into a
+// pacs.002 FIToFIPaymentStatusReport.
+func (fwm *FEDWireMessage) ToISOStatusReport() (ISODocument, error) {
+	if fwm.ErrorWire != nil && fwm.ErrorWire.ErrorCategory == errorCategoryDataError {
+		return fwm.ToAdmi002()
+	}
+	return fwm.ToPacs002()
+}
+
+// ToPacs002 converts the Fed-appended tags of a message into a pacs.002 FIToFIPaymentStatusReport.
+//
+// {1100} MessageStatusIndicator is the transaction status, a message with {1130} ErrorWire is rejected (RJCT) and the
+// error category and code are the proprietary status reason with the error description as additional information.
+// {1120} OutputMessageAccountabilityData is the message identifier and clearing system reference, its output date and
+// time the creation date time. {1110} ReceiptTimeStamp is the acceptance date time. {1520} IMAD is the original message
+// identifier. The format version, test/production and duplication codes of {1100} and the application identifications
+// of {1110} and {1120} have no ISO 20022 element and are not converted.
+func (fwm *FEDWireMessage) ToPacs002() (*Pacs002Document, error) {
+	if fwm.MessageDisposition == nil && fwm.ErrorWire == nil {
+		return nil, fieldError("MessageDisposition", ErrFieldRequired)
+	}
+	tx := Pacs002TransactionInfo{
+		ClearingSystemReference: fwm.isoOutputMessageID(),
+	}
+	switch {
+	case fwm.ErrorWire != nil && fwm.ErrorWire.ErrorCategory != errorCategoryInProcess:
+		tx.TransactionStatus = isoStatusRejected
+	case fwm.ErrorWire != nil:
+		tx.TransactionStatus = isoStatusPending
+	default:
+		status, ok := isoMessageStatuses[fwm.MessageDisposition.MessageStatusIndicator]
+		if !ok {
+			return nil, fieldError("MessageStatusIndicator", ErrISOMessageStatusIndicator, fwm.MessageDisposition.MessageStatusIndicator)
+		}
+		tx.TransactionStatus = status
+	}
+	if fwm.ErrorWire != nil {
+		tx.StatusReason = []ISOReasonInfo{isoErrorWireReason(fwm.ErrorWire)}
+	}
+	if fwm.SenderReference != nil {
+		tx.OriginalInstructionID = strings.TrimSpace(fwm.SenderReference.SenderReference)
+	}
+	if fwm.BeneficiaryReference != nil {
+		tx.OriginalEndToEndID = strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference)
+	}
+	if rts := fwm.ReceiptTimeStamp; rts != nil && fwm.InputMessageAccountabilityData != nil {
+		tx.AcceptanceDateTime = isoDateTimeFromMMDD(fwm.InputMessageAccountabilityData.InputCycleDate, rts.ReceiptDate, rts.ReceiptTime)
+	}
+
+	hdr := ISOGroupHeader{
+		MessageID:        tx.ClearingSystemReference,
+		CreationDateTime: fwm.isoOutputDateTime(),
+	}
+	if hdr.MessageID == "" {
+		hdr.MessageID = fwm.isoMessageID()
+	}
+	if hdr.CreationDateTime == "" {
+		hdr.CreationDateTime = isoCreationDateTime()
+	}
+	doc := &Pacs002Document{
+		StatusReport: Pacs002FIToFIPaymentStatusReport{
+			GroupHeader: hdr,
+			OriginalGroupInfo: ISOOriginalGroupInfo{
+				OriginalMessageID:     fwm.isoMessageID(),
+				OriginalMessageNameID: fwm.isoOriginalMessageName(),
+			},
+			Transactions: []Pacs002TransactionInfo{tx},
+		},
+	}
+	return doc, nil
+}
+
+// FEDWireMessageFromPacs002 converts a pacs.002 FIToFIPaymentStatusReport into the Fed-appended tags of the message
+// it reports on.
+//
+// The document must hold exactly one transaction. The returned message holds {1520} IMAD, {3320} SenderReference and
+// {4320} BeneficiaryReference of the original message along with {1100} MessageDisposition, {1110} ReceiptTimeStamp,
+// {1120} OutputMessageAccountabilityData and {1130} ErrorWire; callers copy these tags onto the message they sent.
+func FEDWireMessageFromPacs002(doc *Pacs002Document) (*FEDWireMessage, error) {
+	if doc == nil {
+		return nil, fieldError("Document", ErrISODocument)
+	}
+	rpt := doc.StatusReport
+	if n := len(rpt.Transactions); n != 1 {
+		return nil, fieldError("TxInfAndSts", ErrISOTransactionCount, n)
+	}
+	tx := rpt.Transactions[0]
+
+	indicator := ""
+	switch tx.TransactionStatus {
+	case isoStatusPending:
+		indicator = "0"
+	case isoStatusSettled:
+		indicator = "2"
+	case isoStatusRejected:
+		indicator = "3"
+	case isoStatusAccepted:
+		indicator = "7"
+	default:
+		return nil, fieldError("TxSts", ErrISOStatus, tx.TransactionStatus)
+	}
+
+	fwm := &FEDWireMessage{}
+	fwm.setIMADFromISO(rpt.OriginalGroupInfo.OriginalMessageID, "")
+	fwm.MessageDisposition = NewMessageDisposition()
+	fwm.MessageDisposition.MessageStatusIndicator = indicator
+	if tx.OriginalInstructionID != "" {
+		fwm.SenderReference = NewSenderReference()
+		fwm.SenderReference.SenderReference = tx.OriginalInstructionID
+	}
+	if tx.OriginalEndToEndID != "" && tx.OriginalEndToEndID != isoNotProvided {
+		fwm.BeneficiaryReference = NewBeneficiaryReference()
+		fwm.BeneficiaryReference.BeneficiaryReference = tx.OriginalEndToEndID
+	}
+	if tx.AcceptanceDateTime != "" {
+		fwm.ReceiptTimeStamp = NewReceiptTimeStamp()
+		fwm.ReceiptTimeStamp.ReceiptDate, fwm.ReceiptTimeStamp.ReceiptTime = mmddHHMMFromISODateTime(tx.AcceptanceDateTime)
+	}
+	fwm.OutputMessageAccountabilityData = outputMessageAccountabilityDataFromISO(tx.ClearingSystemReference, rpt.GroupHeader.CreationDateTime)
+	if len(tx.StatusReason) > 0 && tx.TransactionStatus != isoStatusSettled && tx.TransactionStatus != isoStatusAccepted {
+		fwm.ErrorWire = errorWireFromISOReason(tx.StatusReason[0])
+	}
+	return fwm, nil
+}
+
+// isoOutputMessageID returns the identifier of {1120} OutputMessageAccountabilityData, the output cycle date,
+// destination and sequence number in the layout of an IMAD.
+func (fwm *FEDWireMessage) isoOutputMessageID() string {
+	omad := fwm.OutputMessageAccountabilityData
+	if omad == nil {
+		return ""
+	}
+	return omad.OutputCycleDateField() + omad.OutputDestinationIDField() + omad.OutputSequenceNumberField()
+}
+
+// isoOutputDateTime returns the output date and time of {1120} OutputMessageAccountabilityData as an ISO date time
+func (fwm *FEDWireMessage) isoOutputDateTime() string {
+	omad := fwm.OutputMessageAccountabilityData
+	if omad == nil {
+		return ""
+	}
+	return isoDateTimeFromMMDD(omad.OutputCycleDate, omad.OutputDate, omad.OutputTime)
+}
+
+// outputMessageAccountabilityDataFromISO populates {1120} from the identifier built by isoOutputMessageID and an
+// ISO date time, it returns nil without an identifier.
+func outputMessageAccountabilityDataFromISO(id, dateTime string) *OutputMessageAccountabilityData {
+	if len(id) < 16 {
+		return nil
+	}
+	omad := NewOutputMessageAccountabilityData()
+	omad.OutputCycleDate = strings.TrimSpace(id[:8])
+	omad.OutputDestinationID = strings.TrimSpace(id[8:16])
+	omad.OutputSequenceNumber = strings.TrimSpace(id[16:])
+	omad.OutputDate, omad.OutputTime = mmddHHMMFromISODateTime(dateTime)
+	return omad
+}
+
+// isoDateTimeFromMMDD returns the ISO date time of a Fedwire MMDD date and HHMM time. The year is taken from the
+// cycle date, or the year before when the date is after the cycle date. It returns an empty string when the date or
+// time is incomplete.
+func isoDateTimeFromMMDD(cycleDate, mmdd, hhmm string) string {
+	if len(cycleDate) != 8 || len(mmdd) != 4 || len(hhmm) != 4 {
+		return ""
+	}
+	year, err := strconv.Atoi(cycleDate[:4])
+	if err != nil {
+		return ""
+	}
+	if mmdd > cycleDate[4:] {
+		year--
+	}
+	return strconv.Itoa(year) + "-" + mmdd[:2] + "-" + mmdd[2:] + "T" + hhmm[:2] + ":" + hhmm[2:] + ":00"
+}
+
+// mmddHHMMFromISODateTime returns the Fedwire MMDD date and HHMM time of an ISO date time
+func mmddHHMMFromISODateTime(s string) (mmdd, hhmm string) {
+	if len(s) < 16 {
+		return "", ""
+	}
+	return s[5:7] + s[8:10], s[11:13] + s[14:16]
+}
+
+// isoErrorWireReason maps {1130} ErrorWire into a status reason, the error category and code are the proprietary reason
+func isoErrorWireReason(ew *ErrorWire) ISOReasonInfo {
+	reason := ISOReasonInfo{Reason: &ISOCode{Proprietary: ew.ErrorCategory + ew.ErrorCode}}
+	if desc := strings.TrimSpace(ew.ErrorDescription); desc != "" {
+		reason.AdditionalInfo = []string{desc}
+	}
+	return reason
+}
+
+// errorWireFromISOReason is the inverse of isoErrorWireReason
+func errorWireFromISOReason(reason ISOReasonInfo) *ErrorWire {
+	ew := NewErrorWire()
+	if reason.Reason != nil {
+		code := reason.Reason.Proprietary
+		if code == "" {
+			code = reason.Reason.Code
+		}
+		if code != "" {
+			ew.ErrorCategory, ew.ErrorCode = code[:1], code[1:]
+		}
+	}
+	ew.ErrorDescription = strings.Join(reason.AdditionalInfo, " ")
+	return ew
+}
@@ -0,0 +1,128 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// acknowledgedBankTransfer returns a bank transfer with the tags the Fed appends to a settled message
+func acknowledgedBankTransfer(t *testing.T) FEDWireMessage {
+	fwm := readFEDWireMessage(t, "fedWireMessage-BankTransfer.txt")
+	fwm.MessageDisposition = NewMessageDisposition()
+	fwm.MessageDisposition.MessageStatusIndicator = "2"
+	fwm.ReceiptTimeStamp = NewReceiptTimeStamp()
+	fwm.ReceiptTimeStamp.ReceiptDate = "0410"
+	fwm.ReceiptTimeStamp.ReceiptTime = "1030"
+	fwm.OutputMessageAccountabilityData = NewOutputMessageAccountabilityData()
+	fwm.OutputMessageAccountabilityData.OutputCycleDate = "20190410"
+	fwm.OutputMessageAccountabilityData.OutputDestinationID = "FRBDEST1"
+	fwm.OutputMessageAccountabilityData.OutputSequenceNumber = "000042"
+	fwm.OutputMessageAccountabilityData.OutputDate = "0410"
+	fwm.OutputMessageAccountabilityData.OutputTime = "1031"
+	return fwm
+}
+
+// TestPacs002_Settled converts the status of a settled message into pacs.002 and back
+func TestPacs002_Settled(t *testing.T) {
+	fwm := acknowledgedBankTransfer(t)
+
+	report, err := fwm.ToISOStatusReport()
+	require.NoError(t, err)
+	doc, ok := report.(*Pacs002Document)
+	require.True(t, ok)
+	rpt := doc.StatusReport
+	require.Equal(t, "20190410FRBDEST1000042", rpt.GroupHeader.MessageID)
+	require.Equal(t, "2019-04-10T10:31:00", rpt.GroupHeader.CreationDateTime)
+	require.Equal(t, "20190410Source08000001", rpt.OriginalGroupInfo.OriginalMessageID)
+	require.Equal(t, "ACSC", rpt.Transactions[0].TransactionStatus)
+	require.Equal(t, "2019-04-10T10:30:00", rpt.Transactions[0].AcceptanceDateTime)
+
+	bs, err := doc.XML()
+	require.NoError(t, err)
+	read, err := Pacs002FromXML(bs)
+	require.NoError(t, err)
+
+	out, err := FEDWireMessageFromPacs002(read)
+	require.NoError(t, err)
+	require.Equal(t, fwm.InputMessageAccountabilityData.String(), out.InputMessageAccountabilityData.String())
+	require.Equal(t, fwm.MessageDisposition.String(), out.MessageDisposition.String())
+	require.Equal(t, fwm.ReceiptTimeStamp.String(), out.ReceiptTimeStamp.String())
+	require.Equal(t, fwm.OutputMessageAccountabilityData.String(), out.OutputMessageAccountabilityData.String())
+	require.Equal(t, fwm.SenderReference.String(), out.SenderReference.String())
+	require.Nil(t, out.ErrorWire)
+}
+
+// TestPacs002_Rejected converts a message rejected for insufficient balance into a pacs.002 rejection and back
+func TestPacs002_Rejected(t *testing.T) {
+	fwm := acknowledgedBankTransfer(t)
+	fwm.MessageDisposition.MessageStatusIndicator = "3"
+	fwm.ErrorWire = NewErrorWire()
+	fwm.ErrorWire.ErrorCategory = "F"
+	fwm.ErrorWire.ErrorCode = "001"
+	fwm.ErrorWire.ErrorDescription = "INSUFFICIENT BALANCE"
+
+	report, err := fwm.ToISOStatusReport()
+	require.NoError(t, err)
+	doc := report.(*Pacs002Document)
+	tx := doc.StatusReport.Transactions[0]
+	require.Equal(t, "RJCT", tx.TransactionStatus)
+	require.Equal(t, "F001", tx.StatusReason[0].Reason.Proprietary)
+	require.Equal(t, []string{"INSUFFICIENT BALANCE"}, tx.StatusReason[0].AdditionalInfo)
+
+	out, err := FEDWireMessageFromPacs002(doc)
+	require.NoError(t, err)
+	require.Equal(t, fwm.MessageDisposition.String(), out.MessageDisposition.String())
+	require.Equal(t, fwm.ErrorWire.String(), out.ErrorWire.String())
+}
+
+// TestPacs002_FromXML reads a pacs.002 for a settled message
+func TestPacs002_FromXML(t *testing.T) {
+	bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", "pacs002-Settled.xml"))
+	require.NoError(t, err)
+	doc, err := Pacs002FromXML(bs)
+	require.NoError(t, err)
+
+	fwm, err := FEDWireMessageFromPacs002(doc)
+	require.NoError(t, err)
+	require.Equal(t, "2", fwm.MessageDisposition.MessageStatusIndicator)
+	require.Equal(t, "20190410", fwm.InputMessageAccountabilityData.InputCycleDate)
+	require.Equal(t, "{1110}04101030    ", fwm.ReceiptTimeStamp.String())
+	require.Equal(t, "FRBDEST1", fwm.OutputMessageAccountabilityData.OutputDestinationID)
+	require.Equal(t, "000042", fwm.OutputMessageAccountabilityData.OutputSequenceNumber)
+	require.Equal(t, "1031", fwm.OutputMessageAccountabilityData.OutputTime)
+}
+
+// TestPacs002_Status ensures unmapped statuses are rejected in both directions
+func TestPacs002_Status(t *testing.T) {
+	fwm := acknowledgedBankTransfer(t)
+	fwm.MessageDisposition.MessageStatusIndicator = "Z"
+	_, err := fwm.ToPacs002()
+	require.True(t, errors.Is(err, ErrISOMessageStatusIndicator))
+
+	fwm.MessageDisposition = nil
+	_, err = fwm.ToPacs002()
+	require.True(t, errors.Is(err, ErrFieldRequired))
+
+	doc := &Pacs002Document{}
+	doc.StatusReport.Transactions = []Pacs002TransactionInfo{{TransactionStatus: "ACWC"}}
+	_, err = FEDWireMessageFromPacs002(doc)
+	require.True(t, errors.Is(err, ErrISOStatus))
+}
+
+func TestISODateTimeFromMMDD(t *testing.T) {
+	require.Equal(t, "2019-04-10T10:30:00", isoDateTimeFromMMDD("20190410", "0410", "1030"))
+	require.Equal(t, "2018-12-31T21:00:00", isoDateTimeFromMMDD("20190102", "1231", "2100"))
+	require.Equal(t, "", isoDateTimeFromMMDD("20190410", "", ""))
+
+	mmdd, hhmm := mmddHHMMFromISODateTime("2019-04-10T10:30:00")
+	require.Equal(t, "0410", mmdd)
+	require.Equal(t, "1030", hhmm)
+}
@@ -0,0 +1,180 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// Pacs004Namespace is the XML namespace of the supported pacs.004 PaymentReturn version
+const Pacs004Namespace = "urn:iso:std:iso:20022:tech:xsd:pacs.004.001.09"
+
+// Pacs004Document is an ISO 20022 pacs.004 PaymentReturn document
+type Pacs004Document struct {
+	XMLName       xml.Name             `xml:"urn:iso:std:iso:20022:tech:xsd:pacs.004.001.09 Document"`
+	PaymentReturn Pacs004PaymentReturn `xml:"PmtRtr"`
+}
+
+// Pacs004PaymentReturn is the PmtRtr of a pacs.004 document
+type Pacs004PaymentReturn struct {
+	GroupHeader  ISOGroupHeader           `xml:"GrpHdr"`
+	Transactions []Pacs004TransactionInfo `xml:"TxInf"`
+}
+
+// Pacs004TransactionInfo is the TxInf of a pacs.004 document, which identifies the returned payment
+type Pacs004TransactionInfo struct {
+	ReturnID                          string                           `xml:"RtrId,omitempty"`
+	OriginalGroupInfo                 *ISOOriginalGroupInfo            `xml:"OrgnlGrpInf,omitempty"`
+	OriginalInstructionID             string                           `xml:"OrgnlInstrId,omitempty"`
+	OriginalEndToEndID                string                           `xml:"OrgnlEndToEndId,omitempty"`
+	OriginalInterbankSettlementDate   string                           `xml:"OrgnlIntrBkSttlmDt,omitempty"`
+	PaymentTypeInfo                   *ISOPaymentTypeInfo              `xml:"PmtTpInf,omitempty"`
+	ReturnedInterbankSettlementAmount ISOAmount                        `xml:"RtrdIntrBkSttlmAmt"`
+	InterbankSettlementDate           string                           `xml:"IntrBkSttlmDt,omitempty"`
+	InstructingAgent                  *ISOAgent                        `xml:"InstgAgt,omitempty"`
+	InstructedAgent                   *ISOAgent                        `xml:"InstdAgt,omitempty"`
+	ReturnReason                      []ISOReasonInfo                  `xml:"RtrRsnInf,omitempty"`
+	OriginalTransactionReference      *ISOOriginalTransactionReference `xml:"OrgnlTxRef,omitempty"`
+}
+
+// Pacs004FromXML reads a pacs.004 document
+func Pacs004FromXML(bs []byte) (*Pacs004Document, error) {
+	doc := &Pacs004Document{}
+	if err := isoFromXML(bs, doc, "pacs.004"); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// XML returns the pacs.004 document as XML
+func (doc *Pacs004Document) XML() ([]byte, error) {
+	return isoXML(doc)
+}
+
+// ToPacs004 converts a reversal transfer (SubTypeCode 02 or 08) into a pacs.004 PaymentReturn.
+//
+// {3500} PreviousMessageIdentifier, the IMAD of the reversed payment, is the original message identifier and its cycle
+// date is the original settlement date. {6500} FIAdditionalFIToFI lines are the return reason. ToPacs004 does not
+// validate the message, callers should make a Validate() call first.
+func (fwm *FEDWireMessage) ToPacs004() (*Pacs004Document, error) {
+	if fwm.TypeSubType == nil {
+		return nil, fieldError("TypeSubType", ErrFieldRequired)
+	}
+	switch fwm.TypeSubType.SubTypeCode {
+	case ReversalTransfer, ReversalPriorDayTransfer:
+	default:
+		return nil, fieldError("TypeSubType", ErrISOTypeSubType, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	}
+	previous := fwm.previousMessageID()
+	if previous == "" {
+		return nil, fieldError("PreviousMessageIdentifier", ErrFieldRequired)
+	}
+
+	tx := Pacs004TransactionInfo{
+		ReturnID: fwm.isoMessageID(),
+		OriginalGroupInfo: &ISOOriginalGroupInfo{
+			OriginalMessageID:     previous,
+			OriginalMessageNameID: fwm.isoOriginalMessageName(),
+		},
+		PaymentTypeInfo:                   fwm.isoPaymentTypeInfo(),
+		ReturnedInterbankSettlementAmount: ISOAmount{Currency: isoCurrencyUSD},
+		InterbankSettlementDate:           fwm.isoCycleDate(),
+		OriginalTransactionReference:      fwm.isoOriginalTransactionReference(),
+	}
+	if fwm.SenderReference != nil && strings.TrimSpace(fwm.SenderReference.SenderReference) != "" {
+		tx.ReturnID = strings.TrimSpace(fwm.SenderReference.SenderReference)
+	}
+	if fwm.BeneficiaryReference != nil {
+		tx.OriginalEndToEndID = strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference)
+	}
+	if imad := imadFromISOMessageID(previous); imad != nil {
+		tx.OriginalInterbankSettlementDate = isoDateFromCCYYMMDD(imad.InputCycleDate)
+	}
+	if fwm.Amount != nil {
+		tx.ReturnedInterbankSettlementAmount.Value = isoDecimalFromImplied(fwm.Amount.Amount)
+	}
+	tx.InstructingAgent, tx.InstructedAgent = fwm.isoSenderReceiverAgents()
+	if lines := nonEmpty(fwm.fiToFILines(TagFIAdditionalFIToFI)...); len(lines) > 0 {
+		tx.ReturnReason = []ISOReasonInfo{{AdditionalInfo: lines}}
+	}
+
+	doc := &Pacs004Document{
+		PaymentReturn: Pacs004PaymentReturn{
+			GroupHeader: ISOGroupHeader{
+				MessageID:            fwm.isoMessageID(),
+				CreationDateTime:     isoCreationDateTime(),
+				NumberOfTransactions: "1",
+				SettlementInfo:       isoFedwireSettlement(),
+			},
+			Transactions: []Pacs004TransactionInfo{tx},
+		},
+	}
+	return doc, nil
+}
+
+// FEDWireMessageFromPacs004 converts a pacs.004 PaymentReturn into a reversal transfer.
+//
+// The document must hold exactly one transaction. OrgnlGrpInf/OrgnlMsgId becomes {3500} PreviousMessageIdentifier.
+// Without PmtTpInf the message is a BTR bank transfer, with SubTypeCode 08 when the original settlement date is before
+// the return's settlement date and 02 otherwise. The returned message is not validated, callers should make a
+// Validate() call to confirm the message is a valid Fedwire message.
+func FEDWireMessageFromPacs004(doc *Pacs004Document) (*FEDWireMessage, error) {
+	if doc == nil {
+		return nil, fieldError("Document", ErrISODocument)
+	}
+	if n := len(doc.PaymentReturn.Transactions); n != 1 {
+		return nil, fieldError("TxInf", ErrISOTransactionCount, n)
+	}
+	hdr := doc.PaymentReturn.GroupHeader
+	tx := doc.PaymentReturn.Transactions[0]
+	if tx.OriginalGroupInfo == nil || tx.OriginalGroupInfo.OriginalMessageID == "" {
+		return nil, fieldError("OrgnlGrpInf", ErrISODocument)
+	}
+
+	fwm := &FEDWireMessage{}
+	fwm.setIMADFromISO(hdr.MessageID, tx.InterbankSettlementDate)
+	fwm.setISOSenderSupplied("")
+
+	subType := ReversalTransfer
+	if tx.OriginalInterbankSettlementDate != "" {
+		if ccyymmddFromISODate(tx.OriginalInterbankSettlementDate) < fwm.InputMessageAccountabilityData.InputCycleDate {
+			subType = ReversalPriorDayTransfer
+		}
+	}
+	fwm.setFromISOPaymentTypeInfo(tx.PaymentTypeInfo, BankTransfer, FundsTransfer+subType)
+
+	amount, err := impliedFromISODecimal(tx.ReturnedInterbankSettlementAmount.Value)
+	if err != nil {
+		return nil, err
+	}
+	fwm.Amount = NewAmount()
+	fwm.Amount.Amount = amount
+
+	if err := fwm.setFromISOSenderReceiverAgents(tx.InstructingAgent, tx.InstructedAgent); err != nil {
+		return nil, err
+	}
+	if tx.ReturnID != "" && tx.ReturnID != hdr.MessageID {
+		fwm.SenderReference = NewSenderReference()
+		fwm.SenderReference.SenderReference = tx.ReturnID
+	}
+	fwm.setPreviousMessageID(tx.OriginalGroupInfo.OriginalMessageID)
+	if tx.OriginalEndToEndID != "" && tx.OriginalEndToEndID != isoNotProvided {
+		fwm.BeneficiaryReference = NewBeneficiaryReference()
+		fwm.BeneficiaryReference.BeneficiaryReference = tx.OriginalEndToEndID
+	}
+	fwm.setFromISOOriginalTransactionReference(tx.OriginalTransactionReference)
+
+	var lines []string
+	for _, reason := range tx.ReturnReason {
+		lines = append(lines, reason.AdditionalInfo...)
+	}
+	if lines = nonEmpty(lines...); len(lines) > 0 {
+		fwm.FIAdditionalFIToFI = NewFIAdditionalFIToFI()
+		a := &fwm.FIAdditionalFIToFI.AdditionalFIToFI
+		setLines([]*string{&a.LineOne, &a.LineTwo, &a.LineThree, &a.LineFour, &a.LineFive, &a.LineSix}, lines)
+	}
+	return fwm, nil
+}
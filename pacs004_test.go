@@ -0,0 +1,77 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestPacs004_ReversalTransfer converts a reversal transfer into pacs.004 and back
+func TestPacs004_ReversalTransfer(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-ReversalTransfer.txt")
+
+	doc, err := fwm.ToPacs004()
+	require.NoError(t, err)
+	tx := doc.PaymentReturn.Transactions[0]
+	require.Equal(t, "20190410Source08000009", doc.PaymentReturn.GroupHeader.MessageID)
+	require.Equal(t, "Reversal 1", tx.ReturnID)
+	require.Equal(t, "20190410Source08000001", tx.OriginalGroupInfo.OriginalMessageID)
+	require.Equal(t, "pacs.009.001.08", tx.OriginalGroupInfo.OriginalMessageNameID)
+	require.Equal(t, "12345.67", tx.ReturnedInterbankSettlementAmount.Value)
+	require.Equal(t, []string{"REVERSAL OF DUPLICATE PAYMENT"}, tx.ReturnReason[0].AdditionalInfo)
+
+	bs, err := doc.XML()
+	require.NoError(t, err)
+	read, err := Pacs004FromXML(bs)
+	require.NoError(t, err)
+
+	out, err := FEDWireMessageFromPacs004(read)
+	require.NoError(t, err)
+	require.NoError(t, out.Validate())
+	require.Equal(t, fwm.TypeSubType.String(), out.TypeSubType.String())
+	require.Equal(t, fwm.InputMessageAccountabilityData.String(), out.InputMessageAccountabilityData.String())
+	require.Equal(t, fwm.Amount.String(), out.Amount.String())
+	require.Equal(t, fwm.SenderDepositoryInstitution.String(), out.SenderDepositoryInstitution.String())
+	require.Equal(t, fwm.ReceiverDepositoryInstitution.String(), out.ReceiverDepositoryInstitution.String())
+	require.Equal(t, fwm.BusinessFunctionCode.String(), out.BusinessFunctionCode.String())
+	require.Equal(t, fwm.SenderReference.String(), out.SenderReference.String())
+	require.Equal(t, fwm.PreviousMessageIdentifier.String(), out.PreviousMessageIdentifier.String())
+	require.Equal(t, fwm.Originator.String(), out.Originator.String())
+	require.Equal(t, fwm.Beneficiary.String(), out.Beneficiary.String())
+	require.Equal(t, fwm.FIAdditionalFIToFI.String(), out.FIAdditionalFIToFI.String())
+}
+
+// TestPacs004_FromXML reads a pacs.004 into a reversal transfer
+func TestPacs004_FromXML(t *testing.T) {
+	bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", "pacs004-ReversalTransfer.xml"))
+	require.NoError(t, err)
+	doc, err := Pacs004FromXML(bs)
+	require.NoError(t, err)
+
+	fwm, err := FEDWireMessageFromPacs004(doc)
+	require.NoError(t, err)
+	require.NoError(t, fwm.Validate())
+	require.Equal(t, BankTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, "1002", fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, "20190410Source08000001", fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, "Reversal 2", fwm.SenderReference.SenderReference)
+	require.Equal(t, "231380104", fwm.SenderDepositoryInstitution.SenderABANumber)
+	require.Equal(t, "REVERSAL OF DUPLICATE PAYMENT", fwm.FIAdditionalFIToFI.AdditionalFIToFI.LineOne)
+}
+
+// TestPacs004_TypeSubType ensures only reversal transfers are converted
+func TestPacs004_TypeSubType(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-RequestReversal.txt")
+	_, err := fwm.ToPacs004()
+	require.True(t, errors.Is(err, ErrISOTypeSubType))
+
+	_, err = FEDWireMessageFromPacs004(&Pacs004Document{})
+	require.True(t, errors.Is(err, ErrISOTransactionCount))
+}
@@ -0,0 +1,332 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+)
+
+// Pacs009Namespace is the XML namespace of the supported pacs.009 FinancialInstitutionCreditTransfer version
+const Pacs009Namespace = "urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08"
+
+// Pacs009Document is an ISO 20022 pacs.009 FinancialInstitutionCreditTransfer document
+type Pacs009Document struct {
+	XMLName          xml.Name                `xml:"urn:iso:std:iso:20022:tech:xsd:pacs.009.001.08 Document"`
+	FICreditTransfer Pacs009FICreditTransfer `xml:"FICdtTrf"`
+}
+
+// Pacs009FICreditTransfer is the FICdtTrf of a pacs.009 document
+type Pacs009FICreditTransfer struct {
+	GroupHeader                ISOGroupHeader                     `xml:"GrpHdr"`
+	CreditTransferTransactions []Pacs009CreditTransferTransaction `xml:"CdtTrfTxInf"`
+}
+
+// Pacs009CreditTransferTransaction is the CdtTrfTxInf of a pacs.009 document
+type Pacs009CreditTransferTransaction struct {
+	PaymentID                        ISOPaymentID                             `xml:"PmtId"`
+	PaymentTypeInfo                  *ISOPaymentTypeInfo                      `xml:"PmtTpInf,omitempty"`
+	InterbankSettlementAmount        ISOAmount                                `xml:"IntrBkSttlmAmt"`
+	InterbankSettlementDate          string                                   `xml:"IntrBkSttlmDt,omitempty"`
+	PreviousInstructingAgent         *ISOAgent                                `xml:"PrvsInstgAgt1,omitempty"`
+	PreviousInstructingAgentAccount  *ISOAccount                              `xml:"PrvsInstgAgt1Acct,omitempty"`
+	InstructingAgent                 *ISOAgent                                `xml:"InstgAgt,omitempty"`
+	InstructedAgent                  *ISOAgent                                `xml:"InstdAgt,omitempty"`
+	IntermediaryAgent                *ISOAgent                                `xml:"IntrmyAgt1,omitempty"`
+	IntermediaryAgentAccount         *ISOAccount                              `xml:"IntrmyAgt1Acct,omitempty"`
+	Debtor                           *ISOAgent                                `xml:"Dbtr"`
+	DebtorAccount                    *ISOAccount                              `xml:"DbtrAcct,omitempty"`
+	DebtorAgent                      *ISOAgent                                `xml:"DbtrAgt,omitempty"`
+	DebtorAgentAccount               *ISOAccount                              `xml:"DbtrAgtAcct,omitempty"`
+	CreditorAgent                    *ISOAgent                                `xml:"CdtrAgt,omitempty"`
+	CreditorAgentAccount             *ISOAccount                              `xml:"CdtrAgtAcct,omitempty"`
+	Creditor                         *ISOAgent                                `xml:"Cdtr"`
+	CreditorAccount                  *ISOAccount                              `xml:"CdtrAcct,omitempty"`
+	InstructionsForNextAgent         []ISOInstruction                         `xml:"InstrForNxtAgt,omitempty"`
+	RemittanceInfo                   *ISORemittanceInfo                       `xml:"RmtInf,omitempty"`
+	UnderlyingCustomerCreditTransfer *Pacs009UnderlyingCustomerCreditTransfer `xml:"UndrlygCstmrCdtTrf,omitempty"`
+}
+
+// Pacs009UnderlyingCustomerCreditTransfer is the UndrlygCstmrCdtTrf of a pacs.009 COV document, which carries
+// the {7xxx} cover payment tags of a CTP COVS message.
+type Pacs009UnderlyingCustomerCreditTransfer struct {
+	Debtor                   *ISOParty          `xml:"Dbtr"`
+	DebtorAccount            *ISOAccount        `xml:"DbtrAcct,omitempty"`
+	DebtorAgent              *ISOAgent          `xml:"DbtrAgt"`
+	DebtorAgentAccount       *ISOAccount        `xml:"DbtrAgtAcct,omitempty"`
+	IntermediaryAgent        *ISOAgent          `xml:"IntrmyAgt1,omitempty"`
+	IntermediaryAgentAccount *ISOAccount        `xml:"IntrmyAgt1Acct,omitempty"`
+	CreditorAgent            *ISOAgent          `xml:"CdtrAgt"`
+	CreditorAgentAccount     *ISOAccount        `xml:"CdtrAgtAcct,omitempty"`
+	Creditor                 *ISOParty          `xml:"Cdtr"`
+	CreditorAccount          *ISOAccount        `xml:"CdtrAcct,omitempty"`
+	InstructionsForNextAgent []ISOInstruction   `xml:"InstrForNxtAgt,omitempty"`
+	RemittanceInfo           *ISORemittanceInfo `xml:"RmtInf,omitempty"`
+	InstructedAmount         *ISOAmount         `xml:"InstdAmt,omitempty"`
+}
+
+// Pacs009FromXML reads a pacs.009 document
+func Pacs009FromXML(bs []byte) (*Pacs009Document, error) {
+	doc := &Pacs009Document{}
+	if err := isoFromXML(bs, doc, "pacs.009"); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// XML returns the pacs.009 document as XML
+func (doc *Pacs009Document) XML() ([]byte, error) {
+	return isoXML(doc)
+}
+
+// ToPacs009 converts a FEDWireMessage into a pacs.009 FinancialInstitutionCreditTransfer.
+//
+// Business function codes BTR, CKS, DEP, FFR and FFS are converted as pacs.009 core messages. A CTP message with
+// local instrument COVS is converted as a pacs.009 COV message whose underlying customer credit transfer carries
+// the {7xxx} tags. ToPacs009 does not validate the message, callers should make a Validate() call first.
+func (fwm *FEDWireMessage) ToPacs009() (*Pacs009Document, error) {
+	if fwm.BusinessFunctionCode == nil {
+		return nil, fieldError("BusinessFunctionCode", ErrFieldRequired)
+	}
+	bfc := fwm.BusinessFunctionCode.BusinessFunctionCode
+	switch bfc {
+	case BankTransfer, CheckSameDaySettlement, DepositSendersAccount, FEDFundsReturned, FEDFundsSold:
+	case CustomerTransferPlus:
+		if fwm.LocalInstrument == nil || fwm.LocalInstrument.LocalInstrumentCode != SequenceBCoverPaymentStructured {
+			return nil, fieldError("BusinessFunctionCode", ErrISOBusinessFunctionCode, bfc)
+		}
+	default:
+		return nil, fieldError("BusinessFunctionCode", ErrISOBusinessFunctionCode, bfc)
+	}
+
+	tx := Pacs009CreditTransferTransaction{
+		PaymentID:                 ISOPaymentID{EndToEndID: isoNotProvided},
+		PaymentTypeInfo:           fwm.isoPaymentTypeInfo(),
+		InterbankSettlementAmount: ISOAmount{Currency: isoCurrencyUSD},
+	}
+	if fwm.SenderSupplied != nil {
+		tx.PaymentID.TransactionID = strings.TrimSpace(fwm.SenderSupplied.UserRequestCorrelation)
+	}
+	if fwm.SenderReference != nil {
+		tx.PaymentID.InstructionID = strings.TrimSpace(fwm.SenderReference.SenderReference)
+	}
+	if fwm.BeneficiaryReference != nil && strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference) != "" {
+		tx.PaymentID.EndToEndID = strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference)
+	}
+	if fwm.Amount != nil {
+		tx.InterbankSettlementAmount.Value = isoDecimalFromImplied(fwm.Amount.Amount)
+	}
+	tx.InterbankSettlementDate = fwm.isoCycleDate()
+	tx.InstructingAgent, tx.InstructedAgent = fwm.isoSenderReceiverAgents()
+
+	if fwm.InstructingFI != nil {
+		tx.PreviousInstructingAgent, tx.PreviousInstructingAgentAccount = isoAgentFromFI(fwm.InstructingFI.FinancialInstitution)
+	}
+	if fwm.BeneficiaryIntermediaryFI != nil {
+		tx.IntermediaryAgent, tx.IntermediaryAgentAccount = isoAgentFromFI(fwm.BeneficiaryIntermediaryFI.FinancialInstitution)
+	}
+	if fwm.OriginatorFI != nil {
+		tx.DebtorAgent, tx.DebtorAgentAccount = isoAgentFromFI(fwm.OriginatorFI.FinancialInstitution)
+	}
+	if fwm.BeneficiaryFI != nil {
+		tx.CreditorAgent, tx.CreditorAgentAccount = isoAgentFromFI(fwm.BeneficiaryFI.FinancialInstitution)
+	}
+	// Dbtr and Cdtr are mandatory, the sender and receiver are the debtor and creditor when no originator or beneficiary is present
+	tx.Debtor, tx.Creditor = tx.InstructingAgent, tx.InstructedAgent
+	if o := fwm.Originator; o != nil {
+		tx.Debtor, tx.DebtorAccount = isoAgentFromIdentified(o.Personal.IdentificationCode, o.Personal.Identifier, o.Personal.Name, o.Personal.Address)
+	}
+	if b := fwm.Beneficiary; b != nil {
+		tx.Creditor, tx.CreditorAccount = isoAgentFromIdentified(b.Personal.IdentificationCode, b.Personal.Identifier, b.Personal.Name, b.Personal.Address)
+	}
+	tx.InstructionsForNextAgent = fwm.isoFIToFIInstructions()
+	tx.RemittanceInfo = fwm.isoOriginatorToBeneficiary()
+	if bfc == CustomerTransferPlus {
+		tx.UnderlyingCustomerCreditTransfer = fwm.isoUnderlyingCustomerCreditTransfer()
+	}
+
+	doc := &Pacs009Document{
+		FICreditTransfer: Pacs009FICreditTransfer{
+			GroupHeader: ISOGroupHeader{
+				MessageID:            fwm.isoMessageID(),
+				CreationDateTime:     isoCreationDateTime(),
+				NumberOfTransactions: "1",
+				SettlementInfo:       isoFedwireSettlement(),
+			},
+			CreditTransferTransactions: []Pacs009CreditTransferTransaction{tx},
+		},
+	}
+	return doc, nil
+}
+
+// isoUnderlyingCustomerCreditTransfer maps the {7xxx} cover payment tags into an underlying customer credit transfer
+func (fwm *FEDWireMessage) isoUnderlyingCustomerCreditTransfer() *Pacs009UnderlyingCustomerCreditTransfer {
+	cov := &Pacs009UnderlyingCustomerCreditTransfer{}
+	if fwm.OrderingCustomer != nil {
+		cov.Debtor, cov.DebtorAccount = isoPartyFromSwift(fwm.OrderingCustomer.CoverPayment)
+	}
+	if fwm.OrderingInstitution != nil {
+		cov.DebtorAgent, cov.DebtorAgentAccount = isoAgentFromSwift(fwm.OrderingInstitution.CoverPayment)
+	}
+	if fwm.IntermediaryInstitution != nil {
+		cov.IntermediaryAgent, cov.IntermediaryAgentAccount = isoAgentFromSwift(fwm.IntermediaryInstitution.CoverPayment)
+	}
+	if fwm.InstitutionAccount != nil {
+		cov.CreditorAgent, cov.CreditorAgentAccount = isoAgentFromSwift(fwm.InstitutionAccount.CoverPayment)
+	}
+	if fwm.BeneficiaryCustomer != nil {
+		cov.Creditor, cov.CreditorAccount = isoPartyFromSwift(fwm.BeneficiaryCustomer.CoverPayment)
+	}
+	if fwm.Remittance != nil {
+		if lines := coverPaymentLines(fwm.Remittance.CoverPayment); len(lines) > 0 {
+			cov.RemittanceInfo = &ISORemittanceInfo{Unstructured: lines}
+		}
+	}
+	if fwm.SenderToReceiver != nil {
+		for _, line := range coverPaymentLines(fwm.SenderToReceiver.CoverPayment) {
+			cov.InstructionsForNextAgent = append(cov.InstructionsForNextAgent, ISOInstruction{InstructionInformation: line})
+		}
+	}
+	if cia := fwm.CurrencyInstructedAmount; cia != nil && strings.TrimSpace(cia.Amount) != "" {
+		cov.InstructedAmount = &ISOAmount{Currency: cia.CurrencyCode, Value: isoDecimalFromComma(cia.Amount)}
+	}
+	return cov
+}
+
+// FEDWireMessageFromPacs009 converts a pacs.009 FinancialInstitutionCreditTransfer into a FEDWireMessage.
+//
+// The document must hold exactly one transaction. A document with an underlying customer credit transfer is
+// converted into a CTP message with local instrument COVS, otherwise the business function code is read from
+// PmtTpInf/LclInstrm/Prtry and defaults to BTR. The returned message is not validated, callers should make a
+// Validate() call to confirm the message is a valid Fedwire message.
+func FEDWireMessageFromPacs009(doc *Pacs009Document) (*FEDWireMessage, error) {
+	if doc == nil {
+		return nil, fieldError("Document", ErrISODocument)
+	}
+	if n := len(doc.FICreditTransfer.CreditTransferTransactions); n != 1 {
+		return nil, fieldError("CdtTrfTxInf", ErrISOTransactionCount, n)
+	}
+	hdr := doc.FICreditTransfer.GroupHeader
+	tx := doc.FICreditTransfer.CreditTransferTransactions[0]
+
+	fwm := &FEDWireMessage{}
+
+	defaultBFC := BankTransfer
+	if tx.UnderlyingCustomerCreditTransfer != nil {
+		defaultBFC = CustomerTransferPlus
+	}
+	fwm.setFromISOPaymentTypeInfo(tx.PaymentTypeInfo, defaultBFC, "1000")
+	if tx.UnderlyingCustomerCreditTransfer != nil {
+		fwm.BusinessFunctionCode.BusinessFunctionCode = CustomerTransferPlus
+		fwm.LocalInstrument = NewLocalInstrument()
+		fwm.LocalInstrument.LocalInstrumentCode = SequenceBCoverPaymentStructured
+	}
+
+	fwm.setIMADFromISO(hdr.MessageID, tx.InterbankSettlementDate)
+	fwm.setISOSenderSupplied(tx.PaymentID.TransactionID)
+
+	amount, err := impliedFromISODecimal(tx.InterbankSettlementAmount.Value)
+	if err != nil {
+		return nil, err
+	}
+	fwm.Amount = NewAmount()
+	fwm.Amount.Amount = amount
+
+	if err := fwm.setFromISOSenderReceiverAgents(tx.InstructingAgent, tx.InstructedAgent); err != nil {
+		return nil, err
+	}
+
+	if tx.PaymentID.InstructionID != "" {
+		fwm.SenderReference = NewSenderReference()
+		fwm.SenderReference.SenderReference = tx.PaymentID.InstructionID
+	}
+	if tx.PaymentID.EndToEndID != "" && tx.PaymentID.EndToEndID != isoNotProvided {
+		fwm.BeneficiaryReference = NewBeneficiaryReference()
+		fwm.BeneficiaryReference.BeneficiaryReference = tx.PaymentID.EndToEndID
+	}
+
+	if tx.PreviousInstructingAgent != nil || tx.PreviousInstructingAgentAccount != nil {
+		fwm.InstructingFI = NewInstructingFI()
+		fwm.InstructingFI.FinancialInstitution = fiFromISOAgent(tx.PreviousInstructingAgent, tx.PreviousInstructingAgentAccount)
+	}
+	if tx.IntermediaryAgent != nil || tx.IntermediaryAgentAccount != nil {
+		fwm.BeneficiaryIntermediaryFI = NewBeneficiaryIntermediaryFI()
+		fwm.BeneficiaryIntermediaryFI.FinancialInstitution = fiFromISOAgent(tx.IntermediaryAgent, tx.IntermediaryAgentAccount)
+	}
+	if tx.DebtorAgent != nil || tx.DebtorAgentAccount != nil {
+		fwm.OriginatorFI = NewOriginatorFI()
+		fwm.OriginatorFI.FinancialInstitution = fiFromISOAgent(tx.DebtorAgent, tx.DebtorAgentAccount)
+	}
+	if tx.CreditorAgent != nil || tx.CreditorAgentAccount != nil {
+		fwm.BeneficiaryFI = NewBeneficiaryFI()
+		fwm.BeneficiaryFI.FinancialInstitution = fiFromISOAgent(tx.CreditorAgent, tx.CreditorAgentAccount)
+	}
+	// A debtor or creditor which is the sender or receiver itself has no {5000} or {4200} tag
+	if tx.DebtorAccount != nil || (tx.Debtor != nil && !reflect.DeepEqual(tx.Debtor, tx.InstructingAgent)) {
+		fwm.Originator = NewOriginator()
+		fi := fiFromISOAgent(tx.Debtor, tx.DebtorAccount)
+		fwm.Originator.Personal = Personal{IdentificationCode: fi.IdentificationCode, Identifier: fi.Identifier, Name: fi.Name, Address: fi.Address}
+	}
+	if tx.CreditorAccount != nil || (tx.Creditor != nil && !reflect.DeepEqual(tx.Creditor, tx.InstructedAgent)) {
+		fwm.Beneficiary = NewBeneficiary()
+		fi := fiFromISOAgent(tx.Creditor, tx.CreditorAccount)
+		fwm.Beneficiary.Personal = Personal{IdentificationCode: fi.IdentificationCode, Identifier: fi.Identifier, Name: fi.Name, Address: fi.Address}
+	}
+	fwm.setFromISOFIToFIInstructions(tx.InstructionsForNextAgent)
+	if tx.RemittanceInfo != nil {
+		fwm.setFromISOUnstructured(tx.RemittanceInfo.Unstructured)
+	}
+	if cov := tx.UnderlyingCustomerCreditTransfer; cov != nil {
+		fwm.setFromISOUnderlyingCustomerCreditTransfer(cov)
+	}
+	return fwm, nil
+}
+
+// setFromISOUnderlyingCustomerCreditTransfer populates the {7xxx} cover payment tags from an underlying customer credit transfer
+func (fwm *FEDWireMessage) setFromISOUnderlyingCustomerCreditTransfer(cov *Pacs009UnderlyingCustomerCreditTransfer) {
+	if cov.Debtor != nil || cov.DebtorAccount != nil {
+		fwm.OrderingCustomer = NewOrderingCustomer()
+		fwm.OrderingCustomer.CoverPayment = swiftFromISOParty("50", cov.Debtor, cov.DebtorAccount, 5)
+	}
+	if cov.DebtorAgent != nil || cov.DebtorAgentAccount != nil {
+		fwm.OrderingInstitution = NewOrderingInstitution()
+		fwm.OrderingInstitution.CoverPayment = swiftFromISOAgent("52", cov.DebtorAgent, cov.DebtorAgentAccount, 5)
+	}
+	if cov.IntermediaryAgent != nil || cov.IntermediaryAgentAccount != nil {
+		fwm.IntermediaryInstitution = NewIntermediaryInstitution()
+		fwm.IntermediaryInstitution.CoverPayment = swiftFromISOAgent("56", cov.IntermediaryAgent, cov.IntermediaryAgentAccount, 5)
+	}
+	if cov.CreditorAgent != nil || cov.CreditorAgentAccount != nil {
+		fwm.InstitutionAccount = NewInstitutionAccount()
+		fwm.InstitutionAccount.CoverPayment = swiftFromISOAgent("57", cov.CreditorAgent, cov.CreditorAgentAccount, 5)
+	}
+	if cov.Creditor != nil || cov.CreditorAccount != nil {
+		fwm.BeneficiaryCustomer = NewBeneficiaryCustomer()
+		fwm.BeneficiaryCustomer.CoverPayment = swiftFromISOParty("59", cov.Creditor, cov.CreditorAccount, 5)
+	}
+	if cov.RemittanceInfo != nil && len(cov.RemittanceInfo.Unstructured) > 0 {
+		fwm.Remittance = NewRemittance()
+		fwm.Remittance.CoverPayment = newCoverPayment("70", cov.RemittanceInfo.Unstructured, 4)
+	}
+	if len(cov.InstructionsForNextAgent) > 0 {
+		var lines []string
+		for _, instr := range cov.InstructionsForNextAgent {
+			lines = append(lines, instr.InstructionInformation)
+		}
+		fwm.SenderToReceiver = NewSenderToReceiver()
+		fwm.SenderToReceiver.CoverPayment = newCoverPayment("72", lines, 6)
+	}
+	if cov.InstructedAmount != nil {
+		fwm.CurrencyInstructedAmount = NewCurrencyInstructedAmount()
+		fwm.CurrencyInstructedAmount.SwiftFieldTag = "33B"
+		fwm.CurrencyInstructedAmount.CurrencyCode = cov.InstructedAmount.Currency
+		amount := commaFromISODecimal(cov.InstructedAmount.Value)
+		if len(amount) < 15 {
+			amount = strings.Repeat("0", 15-len(amount)) + amount
+		}
+		fwm.CurrencyInstructedAmount.Amount = amount
+	}
+}
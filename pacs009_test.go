@@ -0,0 +1,129 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestPacs009_BankTransfer converts a bank transfer into pacs.009 and back
+func TestPacs009_BankTransfer(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-BankTransfer.txt")
+
+	doc, err := fwm.ToPacs009()
+	require.NoError(t, err)
+	tx := doc.FICreditTransfer.CreditTransferTransactions[0]
+	require.Equal(t, "20190410Source08000001", doc.FICreditTransfer.GroupHeader.MessageID)
+	require.Equal(t, "12345.67", tx.InterbankSettlementAmount.Value)
+	require.Equal(t, "2019-04-10", tx.InterbankSettlementDate)
+	require.Equal(t, "BTR", tx.PaymentTypeInfo.LocalInstrument.Proprietary)
+	require.Equal(t, "121042882", tx.InstructingAgent.FinancialInstitutionID.ClearingSystemMemberID.MemberID)
+	require.Equal(t, "123456789", tx.CreditorAgentAccount.ID.Other.ID)
+	require.Equal(t, "/REC/Line 1", tx.InstructionsForNextAgent[0].InstructionInformation)
+
+	bs, err := doc.XML()
+	require.NoError(t, err)
+	read, err := Pacs009FromXML(bs)
+	require.NoError(t, err)
+
+	out, err := FEDWireMessageFromPacs009(read)
+	require.NoError(t, err)
+	require.NoError(t, out.Validate())
+
+	require.Equal(t, fwm.InputMessageAccountabilityData.String(), out.InputMessageAccountabilityData.String())
+	require.Equal(t, fwm.Amount.String(), out.Amount.String())
+	require.Equal(t, fwm.SenderDepositoryInstitution.String(), out.SenderDepositoryInstitution.String())
+	require.Equal(t, fwm.ReceiverDepositoryInstitution.String(), out.ReceiverDepositoryInstitution.String())
+	require.Equal(t, fwm.BusinessFunctionCode.String(), out.BusinessFunctionCode.String())
+	require.Equal(t, fwm.SenderReference.String(), out.SenderReference.String())
+	require.Equal(t, fwm.BeneficiaryReference.String(), out.BeneficiaryReference.String())
+	require.Equal(t, fwm.BeneficiaryIntermediaryFI.String(), out.BeneficiaryIntermediaryFI.String())
+	require.Equal(t, fwm.BeneficiaryFI.String(), out.BeneficiaryFI.String())
+	require.Equal(t, fwm.Beneficiary.String(), out.Beneficiary.String())
+	require.Equal(t, fwm.Originator.String(), out.Originator.String())
+	require.Equal(t, fwm.OriginatorFI.String(), out.OriginatorFI.String())
+	require.Equal(t, fwm.InstructingFI.String(), out.InstructingFI.String())
+	require.Equal(t, fwm.OriginatorToBeneficiary.String(), out.OriginatorToBeneficiary.String())
+	require.Equal(t, fwm.FIReceiverFI.String(), out.FIReceiverFI.String())
+	require.Equal(t, fwm.FIIntermediaryFIAdvice.String(), out.FIIntermediaryFIAdvice.String())
+	require.Equal(t, fwm.FIBeneficiaryFIAdvice.String(), out.FIBeneficiaryFIAdvice.String())
+	require.Equal(t, fwm.FIPaymentMethodToBeneficiary.String(), out.FIPaymentMethodToBeneficiary.String())
+	require.Equal(t, fwm.FIAdditionalFIToFI.String(), out.FIAdditionalFIToFI.String())
+}
+
+// TestPacs009_FEDFundsSold converts a fed funds sold message, which has no originator or beneficiary
+func TestPacs009_FEDFundsSold(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-FEDFundsSold.txt")
+
+	doc, err := fwm.ToPacs009()
+	require.NoError(t, err)
+
+	out, err := FEDWireMessageFromPacs009(doc)
+	require.NoError(t, err)
+	require.Equal(t, FEDFundsSold, out.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, fwm.Originator == nil, out.Originator == nil)
+	require.Equal(t, fwm.Beneficiary == nil, out.Beneficiary == nil)
+	require.NoError(t, out.Validate())
+}
+
+// TestPacs009_CoverPayment reads a pacs.009 COV document into a CTP COVS message and back
+func TestPacs009_CoverPayment(t *testing.T) {
+	bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", "pacs009-CoverPayment.xml"))
+	require.NoError(t, err)
+	doc, err := Pacs009FromXML(bs)
+	require.NoError(t, err)
+
+	fwm, err := FEDWireMessageFromPacs009(doc)
+	require.NoError(t, err)
+	require.NoError(t, fwm.Validate())
+
+	require.Equal(t, CustomerTransferPlus, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, SequenceBCoverPaymentStructured, fwm.LocalInstrument.LocalInstrumentCode)
+	require.Equal(t, "User Req", fwm.SenderSupplied.UserRequestCorrelation)
+	require.Equal(t, "000001234567", fwm.Amount.Amount)
+	require.Equal(t, "{5000}F121042882*Wells Fargo NA*420 Montgomery Street*San Francisco CA 94104*", fwm.Originator.String())
+	require.Equal(t, "{4200}BDEUTDEFF*Deutsche Bank AG*Taunusanlage 12*Frankfurt am Main*", fwm.Beneficiary.String())
+	require.Equal(t, "{7050}50F*/123456789*1/Jane Doe*2/100 Main Street*3/US/New York*", fwm.OrderingCustomer.String())
+	require.Equal(t, "{7052}52A*WFBIUS6S*", fwm.OrderingInstitution.String())
+	require.Equal(t, "{7057}57A*DEUTDEFF*", fwm.InstitutionAccount.String())
+	require.Equal(t, "{7059}59*/DE89370400440532013000*Max Mustermann*Hauptstrasse 1*Berlin*", fwm.BeneficiaryCustomer.String())
+	require.Equal(t, "{7070}70*INVOICE 12345*PURCHASE ORDER 6789*", fwm.Remittance.String())
+	require.Equal(t, "{7072}72*/ACC/PLEASE ADVISE BENEFICIARY*", fwm.SenderToReceiver.String())
+	require.Equal(t, "{7033}33B*EUR000000011000,50*", fwm.CurrencyInstructedAmount.String())
+
+	out, err := fwm.ToPacs009()
+	require.NoError(t, err)
+	out.FICreditTransfer.GroupHeader.CreationDateTime = doc.FICreditTransfer.GroupHeader.CreationDateTime
+	require.Equal(t, doc.FICreditTransfer.GroupHeader, out.FICreditTransfer.GroupHeader)
+	require.Equal(t, doc.FICreditTransfer.CreditTransferTransactions[0].UnderlyingCustomerCreditTransfer,
+		out.FICreditTransfer.CreditTransferTransactions[0].UnderlyingCustomerCreditTransfer)
+}
+
+// TestPacs009_BusinessFunctionCode ensures messages without a pacs.009 mapping are rejected
+func TestPacs009_BusinessFunctionCode(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	_, err := fwm.ToPacs009()
+	require.True(t, errors.Is(err, ErrISOBusinessFunctionCode))
+
+	fwm = readFEDWireMessage(t, "fedWireMessage-CustomerTransferPlus.txt")
+	_, err = fwm.ToPacs009()
+	require.True(t, errors.Is(err, ErrISOBusinessFunctionCode))
+	require.Contains(t, err.Error(), "is not a business function code")
+	require.NotEqual(t, ErrISOTypeSubType.Error(), ErrISOBusinessFunctionCode.Error())
+}
+
+// TestPacs009_TransactionCount ensures a document must hold exactly one transaction
+func TestPacs009_TransactionCount(t *testing.T) {
+	_, err := FEDWireMessageFromPacs009(&Pacs009Document{})
+	require.True(t, errors.Is(err, ErrISOTransactionCount))
+
+	_, err = Pacs009FromXML(nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,283 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// Pain013Namespace is the XML namespace of the supported pain.013 CreditorPaymentActivationRequest version
+const Pain013Namespace = "urn:iso:std:iso:20022:tech:xsd:pain.013.001.07"
+
+// isoPain013MessageName is the message name of a drawdown request
+const isoPain013MessageName = "pain.013.001.07"
+
+// isoPaymentMethodTransfer is the PmtMtd of a credit transfer
+const isoPaymentMethodTransfer = "TRF"
+
+// Pain013Document is an ISO 20022 pain.013 CreditorPaymentActivationRequest document
+type Pain013Document struct {
+	XMLName                  xml.Name                                `xml:"urn:iso:std:iso:20022:tech:xsd:pain.013.001.07 Document"`
+	PaymentActivationRequest Pain013CreditorPaymentActivationRequest `xml:"CdtrPmtActvtnReq"`
+}
+
+// Pain013CreditorPaymentActivationRequest is the CdtrPmtActvtnReq of a pain.013 document
+type Pain013CreditorPaymentActivationRequest struct {
+	GroupHeader  Pain013GroupHeader          `xml:"GrpHdr"`
+	PaymentInfos []Pain013PaymentInstruction `xml:"PmtInf"`
+}
+
+// Pain013GroupHeader is the GrpHdr of a pain.013 document
+type Pain013GroupHeader struct {
+	MessageID            string   `xml:"MsgId"`
+	CreationDateTime     string   `xml:"CreDtTm"`
+	NumberOfTransactions string   `xml:"NbOfTxs"`
+	InitiatingParty      ISOParty `xml:"InitgPty"`
+}
+
+// Pain013PaymentInstruction is the PmtInf of a pain.013 document, which identifies the account to debit
+type Pain013PaymentInstruction struct {
+	PaymentInfoID              string                             `xml:"PmtInfId"`
+	PaymentMethod              string                             `xml:"PmtMtd"`
+	PaymentTypeInfo            *ISOPaymentTypeInfo                `xml:"PmtTpInf,omitempty"`
+	RequestedExecutionDate     ISODateChoice                      `xml:"ReqdExctnDt"`
+	Debtor                     ISOParty                           `xml:"Dbtr"`
+	DebtorAccount              *ISOAccount                        `xml:"DbtrAcct,omitempty"`
+	DebtorAgent                ISOAgent                           `xml:"DbtrAgt"`
+	UltimateDebtor             *ISOParty                          `xml:"UltmtDbtr,omitempty"`
+	CreditTransferTransactions []Pain013CreditTransferTransaction `xml:"CdtTrfTx"`
+}
+
+// Pain013CreditTransferTransaction is the CdtTrfTx of a pain.013 document, which identifies the requested credit
+type Pain013CreditTransferTransaction struct {
+	PaymentID                    ISOPaymentID       `xml:"PmtId"`
+	Amount                       ISOAmountChoice    `xml:"Amt"`
+	IntermediaryAgent1           *ISOAgent          `xml:"IntrmyAgt1,omitempty"`
+	IntermediaryAgent2           *ISOAgent          `xml:"IntrmyAgt2,omitempty"`
+	IntermediaryAgent2Account    *ISOAccount        `xml:"IntrmyAgt2Acct,omitempty"`
+	CreditorAgent                *ISOAgent          `xml:"CdtrAgt,omitempty"`
+	CreditorAgentAccount         *ISOAccount        `xml:"CdtrAgtAcct,omitempty"`
+	Creditor                     ISOParty           `xml:"Cdtr"`
+	CreditorAccount              *ISOAccount        `xml:"CdtrAcct,omitempty"`
+	InstructionsForCreditorAgent []ISOInstruction   `xml:"InstrForCdtrAgt,omitempty"`
+	RemittanceInfo               *ISORemittanceInfo `xml:"RmtInf,omitempty"`
+}
+
+// Pain013FromXML reads a pain.013 document
+func Pain013FromXML(bs []byte) (*Pain013Document, error) {
+	doc := &Pain013Document{}
+	if err := isoFromXML(bs, doc, "pain.013"); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// XML returns the pain.013 document as XML
+func (doc *Pain013Document) XML() ([]byte, error) {
+	return isoXML(doc)
+}
+
+// ToPain013 converts a drawdown request (SubTypeCode 31) into a pain.013 CreditorPaymentActivationRequest.
+//
+// {3100} Sender is the initiating party and {3400} Receiver the debtor agent. {4400} AccountDebitedDrawdown is the
+// debtor and debtor account and {5000} Originator the ultimate debtor. The credit follows the payment chain, {5400}
+// AccountCreditedDrawdown is the first intermediary agent, {4000} BeneficiaryIntermediaryFI the second, {4100}
+// BeneficiaryFI the creditor agent and {4200} Beneficiary the creditor. The {6xxx} FI to FI tags, including {6110}
+// FIDrawdownDebitAccountAdvice, are instructions for the creditor agent prefixed with their code word. ToPain013 does
+// not validate the message, callers should make a Validate() call first.
+func (fwm *FEDWireMessage) ToPain013() (*Pain013Document, error) {
+	if fwm.TypeSubType == nil {
+		return nil, fieldError("TypeSubType", ErrFieldRequired)
+	}
+	if fwm.TypeSubType.SubTypeCode != RequestCredit {
+		return nil, fieldError("TypeSubType", ErrISOTypeSubType, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	}
+
+	tx := Pain013CreditTransferTransaction{
+		PaymentID:                    ISOPaymentID{EndToEndID: isoNotProvided},
+		Amount:                       ISOAmountChoice{InstructedAmount: &ISOAmount{Currency: isoCurrencyUSD}},
+		IntermediaryAgent1:           fwm.isoDrawdownCreditorAgent(),
+		InstructionsForCreditorAgent: fwm.isoFIToFIInstructions(),
+		RemittanceInfo:               fwm.isoOriginatorToBeneficiary(),
+	}
+	if fwm.SenderReference != nil {
+		tx.PaymentID.InstructionID = strings.TrimSpace(fwm.SenderReference.SenderReference)
+	}
+	if fwm.BeneficiaryReference != nil && strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference) != "" {
+		tx.PaymentID.EndToEndID = strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference)
+	}
+	if fwm.Amount != nil {
+		tx.Amount.InstructedAmount.Value = isoDecimalFromImplied(fwm.Amount.Amount)
+	}
+	if fwm.BeneficiaryIntermediaryFI != nil {
+		tx.IntermediaryAgent2, tx.IntermediaryAgent2Account = isoAgentFromFI(fwm.BeneficiaryIntermediaryFI.FinancialInstitution)
+	}
+	if fwm.BeneficiaryFI != nil {
+		tx.CreditorAgent, tx.CreditorAgentAccount = isoAgentFromFI(fwm.BeneficiaryFI.FinancialInstitution)
+	}
+	if b := fwm.Beneficiary; b != nil {
+		party, account := isoPartyFromIdentified(b.Personal.IdentificationCode, b.Personal.Identifier, b.Personal.Name, b.Personal.Address)
+		tx.Creditor, tx.CreditorAccount = *party, account
+	}
+
+	pmt := Pain013PaymentInstruction{
+		PaymentInfoID:              fwm.isoMessageID(),
+		PaymentMethod:              isoPaymentMethodTransfer,
+		PaymentTypeInfo:            fwm.isoPaymentTypeInfo(),
+		RequestedExecutionDate:     ISODateChoice{Date: fwm.isoCycleDate()},
+		CreditTransferTransactions: []Pain013CreditTransferTransaction{tx},
+	}
+	if debtor, account := fwm.isoDrawdownDebtor(); debtor != nil {
+		pmt.Debtor, pmt.DebtorAccount = *debtor, account
+	}
+	if o := fwm.Originator; o != nil {
+		pmt.UltimateDebtor = isoUltimateDebtor(o.Personal)
+	}
+
+	hdr := Pain013GroupHeader{
+		MessageID:            fwm.isoMessageID(),
+		CreationDateTime:     isoCreationDateTime(),
+		NumberOfTransactions: "1",
+	}
+	instructing, instructed := fwm.isoSenderReceiverAgents()
+	if instructing != nil {
+		aba, name := abaFromISOAgent(instructing)
+		hdr.InitiatingParty = *isoPartyFromABA(aba, name)
+	}
+	if instructed != nil {
+		pmt.DebtorAgent = *instructed
+	}
+
+	doc := &Pain013Document{
+		PaymentActivationRequest: Pain013CreditorPaymentActivationRequest{
+			GroupHeader:  hdr,
+			PaymentInfos: []Pain013PaymentInstruction{pmt},
+		},
+	}
+	return doc, nil
+}
+
+// FEDWireMessageFromPain013 converts a pain.013 CreditorPaymentActivationRequest into a drawdown request.
+//
+// The document must hold exactly one payment instruction with one transaction. Without PmtTpInf the message is a DRC
+// customer or corporate drawdown request. The initiating party must be identified by its Fed routing number. The
+// returned message is not validated, callers should make a Validate() call to confirm the message is a valid Fedwire
+// message.
+func FEDWireMessageFromPain013(doc *Pain013Document) (*FEDWireMessage, error) {
+	if doc == nil {
+		return nil, fieldError("Document", ErrISODocument)
+	}
+	req := doc.PaymentActivationRequest
+	if len(req.PaymentInfos) != 1 || len(req.PaymentInfos[0].CreditTransferTransactions) != 1 {
+		return nil, fieldError("CdtTrfTx", ErrISOTransactionCount)
+	}
+	pmt := req.PaymentInfos[0]
+	tx := pmt.CreditTransferTransactions[0]
+
+	fwm := &FEDWireMessage{}
+	fwm.setIMADFromISO(req.GroupHeader.MessageID, pmt.RequestedExecutionDate.Date)
+	fwm.setISOSenderSupplied("")
+	fwm.setFromISOPaymentTypeInfo(pmt.PaymentTypeInfo, CustomerCorporateDrawdownRequest, FundsTransfer+RequestCredit)
+
+	if tx.Amount.InstructedAmount == nil {
+		return nil, fieldError("InstdAmt", ErrISODocument)
+	}
+	amount, err := impliedFromISODecimal(tx.Amount.InstructedAmount.Value)
+	if err != nil {
+		return nil, err
+	}
+	fwm.Amount = NewAmount()
+	fwm.Amount.Amount = amount
+
+	aba, name := abaFromISOParty(&req.GroupHeader.InitiatingParty)
+	if aba == "" {
+		return nil, fieldError("InitgPty", ErrISODocument)
+	}
+	if err := fwm.setFromISOSenderReceiverAgents(isoAgentFromABA(aba, name), &pmt.DebtorAgent); err != nil {
+		return nil, err
+	}
+	if tx.PaymentID.InstructionID != "" {
+		fwm.SenderReference = NewSenderReference()
+		fwm.SenderReference.SenderReference = tx.PaymentID.InstructionID
+	}
+	if tx.PaymentID.EndToEndID != "" && tx.PaymentID.EndToEndID != isoNotProvided {
+		fwm.BeneficiaryReference = NewBeneficiaryReference()
+		fwm.BeneficiaryReference.BeneficiaryReference = tx.PaymentID.EndToEndID
+	}
+
+	fwm.setFromISODrawdownDebtor(&pmt.Debtor, pmt.DebtorAccount)
+	if pmt.UltimateDebtor != nil {
+		fwm.Originator = NewOriginator()
+		fwm.Originator.Personal = personalFromISOPartyChoice(&ISOPartyChoice{Party: pmt.UltimateDebtor}, nil)
+	}
+	fwm.setFromISODrawdownCreditorAgent(tx.IntermediaryAgent1)
+	if tx.IntermediaryAgent2 != nil || tx.IntermediaryAgent2Account != nil {
+		fwm.BeneficiaryIntermediaryFI = NewBeneficiaryIntermediaryFI()
+		fwm.BeneficiaryIntermediaryFI.FinancialInstitution = fiFromISOAgent(tx.IntermediaryAgent2, tx.IntermediaryAgent2Account)
+	}
+	if tx.CreditorAgent != nil || tx.CreditorAgentAccount != nil {
+		fwm.BeneficiaryFI = NewBeneficiaryFI()
+		fwm.BeneficiaryFI.FinancialInstitution = fiFromISOAgent(tx.CreditorAgent, tx.CreditorAgentAccount)
+	}
+	fwm.Beneficiary = NewBeneficiary()
+	fwm.Beneficiary.Personal = personalFromISOPartyChoice(&ISOPartyChoice{Party: &tx.Creditor}, tx.CreditorAccount)
+
+	fwm.setFromISOFIToFIInstructions(tx.InstructionsForCreditorAgent)
+	if tx.RemittanceInfo != nil {
+		fwm.setFromISOUnstructured(tx.RemittanceInfo.Unstructured)
+	}
+	return fwm, nil
+}
+
+// isoDrawdownDebtor maps {4400} AccountDebitedDrawdown into the debtor and debtor account of a drawdown
+func (fwm *FEDWireMessage) isoDrawdownDebtor() (*ISOParty, *ISOAccount) {
+	debitDD := fwm.AccountDebitedDrawdown
+	if debitDD == nil {
+		return nil, nil
+	}
+	return isoPartyFromIdentified(debitDD.IdentificationCode, debitDD.Identifier, debitDD.Name, debitDD.Address)
+}
+
+// setFromISODrawdownDebtor populates {4400} AccountDebitedDrawdown from the debtor and debtor account of a drawdown
+func (fwm *FEDWireMessage) setFromISODrawdownDebtor(party *ISOParty, account *ISOAccount) {
+	if isoAccountNumber(account) == "" && (party == nil || party.Name == "") {
+		return
+	}
+	debitDD := NewAccountDebitedDrawdown()
+	debitDD.IdentificationCode, debitDD.Identifier, debitDD.Name, debitDD.Address = identifiedFromISOParty(party, account)
+	fwm.AccountDebitedDrawdown = debitDD
+}
+
+// isoUltimateDebtor maps {5000} Originator into an ultimate debtor. An ultimate debtor has no account, a Demand
+// Deposit Account identifier is kept as a party identification.
+func isoUltimateDebtor(p Personal) *ISOParty {
+	party, account := isoPartyFromIdentified(p.IdentificationCode, p.Identifier, p.Name, p.Address)
+	if account != nil {
+		party.ID = &ISOPartyID{OrganisationID: &ISOOrganisationID{Other: []ISOGenericID{
+			{ID: isoAccountNumber(account), SchemeName: &ISOCode{Proprietary: DemandDepositAccountNumber}},
+		}}}
+	}
+	return party
+}
+
+// isoDrawdownCreditorAgent maps {5400} AccountCreditedDrawdown into the agent holding the account to credit
+func (fwm *FEDWireMessage) isoDrawdownCreditorAgent() *ISOAgent {
+	if fwm.AccountCreditedDrawdown == nil {
+		return nil
+	}
+	return isoAgentFromABA(fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber, "")
+}
+
+// setFromISODrawdownCreditorAgent populates {5400} AccountCreditedDrawdown from the agent holding the account to credit
+func (fwm *FEDWireMessage) setFromISODrawdownCreditorAgent(agent *ISOAgent) {
+	if agent == nil {
+		return
+	}
+	if aba, _ := abaFromISOAgent(agent); aba != "" {
+		fwm.AccountCreditedDrawdown = NewAccountCreditedDrawdown()
+		fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber = aba
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestPain013_CustomerCorporateDrawdownRequest converts a customer drawdown request into pain.013 and back
+func TestPain013_CustomerCorporateDrawdownRequest(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerCorporateDrawDownRequest.txt")
+
+	doc, err := fwm.ToPain013()
+	require.NoError(t, err)
+	req := doc.PaymentActivationRequest
+	pmt := req.PaymentInfos[0]
+	tx := pmt.CreditTransferTransactions[0]
+	require.Equal(t, "20190410Source08000001", req.GroupHeader.MessageID)
+	aba, _ := abaFromISOParty(&req.GroupHeader.InitiatingParty)
+	require.Equal(t, "121042882", aba)
+	require.Equal(t, "231380104", pmt.DebtorAgent.FinancialInstitutionID.ClearingSystemMemberID.MemberID)
+	require.Equal(t, "2019-04-10", pmt.RequestedExecutionDate.Date)
+	require.Equal(t, "debitDD Name", pmt.Debtor.Name)
+	require.Equal(t, "123456789", pmt.DebtorAccount.ID.Other.ID)
+	require.Equal(t, "123456789", tx.IntermediaryAgent1.FinancialInstitutionID.ClearingSystemMemberID.MemberID)
+	require.Equal(t, "12345.67", tx.Amount.InstructedAmount.Value)
+	require.Contains(t, tx.InstructionsForCreditorAgent, ISOInstruction{InstructionInformation: "/DDAD/LTR"})
+
+	bs, err := doc.XML()
+	require.NoError(t, err)
+	read, err := Pain013FromXML(bs)
+	require.NoError(t, err)
+
+	out, err := FEDWireMessageFromPain013(read)
+	require.NoError(t, err)
+	require.NoError(t, out.Validate())
+	require.Equal(t, fwm.InputMessageAccountabilityData.String(), out.InputMessageAccountabilityData.String())
+	require.Equal(t, fwm.TypeSubType.String(), out.TypeSubType.String())
+	require.Equal(t, fwm.BusinessFunctionCode.String(), out.BusinessFunctionCode.String())
+	require.Equal(t, fwm.Amount.String(), out.Amount.String())
+	require.Equal(t, fwm.SenderDepositoryInstitution.String(), out.SenderDepositoryInstitution.String())
+	require.Equal(t, fwm.ReceiverDepositoryInstitution.String(), out.ReceiverDepositoryInstitution.String())
+	require.Equal(t, fwm.SenderReference.String(), out.SenderReference.String())
+	require.Equal(t, fwm.BeneficiaryReference.String(), out.BeneficiaryReference.String())
+	require.Equal(t, fwm.BeneficiaryIntermediaryFI.String(), out.BeneficiaryIntermediaryFI.String())
+	require.Equal(t, fwm.BeneficiaryFI.String(), out.BeneficiaryFI.String())
+	require.Equal(t, fwm.Beneficiary.String(), out.Beneficiary.String())
+	require.Equal(t, fwm.AccountDebitedDrawdown.String(), out.AccountDebitedDrawdown.String())
+	require.Equal(t, fwm.Originator.String(), out.Originator.String())
+	require.Equal(t, fwm.AccountCreditedDrawdown.String(), out.AccountCreditedDrawdown.String())
+	require.Equal(t, fwm.OriginatorToBeneficiary.String(), out.OriginatorToBeneficiary.String())
+	require.Equal(t, fwm.FIDrawdownDebitAccountAdvice.String(), out.FIDrawdownDebitAccountAdvice.String())
+	require.Equal(t, fwm.FIAdditionalFIToFI.String(), out.FIAdditionalFIToFI.String())
+}
+
+// TestPain013_BankDrawdownRequest ensures the business function code of a bank drawdown request is kept
+func TestPain013_BankDrawdownRequest(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-BankDrawDownRequest.txt")
+
+	doc, err := fwm.ToPain013()
+	require.NoError(t, err)
+	out, err := FEDWireMessageFromPain013(doc)
+	require.NoError(t, err)
+	require.NoError(t, out.Validate())
+	require.Equal(t, BankDrawDownRequest, out.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, "1631", out.TypeSubType.TypeCode+out.TypeSubType.SubTypeCode)
+}
+
+// TestPain013_TypeSubType ensures only drawdown requests are converted
+func TestPain013_TypeSubType(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-DrawdownResponse.txt")
+	_, err := fwm.ToPain013()
+	require.True(t, errors.Is(err, ErrISOTypeSubType))
+
+	_, err = FEDWireMessageFromPain013(&Pain013Document{})
+	require.True(t, errors.Is(err, ErrISOTransactionCount))
+}
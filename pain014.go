@@ -0,0 +1,267 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// Pain014Namespace is the XML namespace of the supported pain.014 CreditorPaymentActivationRequestStatusReport version
+const Pain014Namespace = "urn:iso:std:iso:20022:tech:xsd:pain.014.001.07"
+
+const (
+	// isoStatusAccepted is the pain.014 status of an accepted drawdown request
+	isoStatusAccepted = "ACCP"
+	// isoStatusRejected is the pain.014 status of a refused drawdown request
+	isoStatusRejected = "RJCT"
+)
+
+// Pain014Document is an ISO 20022 pain.014 CreditorPaymentActivationRequestStatusReport document
+type Pain014Document struct {
+	XMLName      xml.Name                                            `xml:"urn:iso:std:iso:20022:tech:xsd:pain.014.001.07 Document"`
+	StatusReport Pain014CreditorPaymentActivationRequestStatusReport `xml:"CdtrPmtActvtnReqStsRpt"`
+}
+
+// Pain014CreditorPaymentActivationRequestStatusReport is the CdtrPmtActvtnReqStsRpt of a pain.014 document
+type Pain014CreditorPaymentActivationRequestStatusReport struct {
+	GroupHeader         Pain014GroupHeader           `xml:"GrpHdr"`
+	OriginalGroupInfo   ISOOriginalGroupInfo         `xml:"OrgnlGrpInfAndSts"`
+	OriginalPaymentInfo []Pain014OriginalPaymentInfo `xml:"OrgnlPmtInfAndSts"`
+}
+
+// Pain014GroupHeader is the GrpHdr of a pain.014 document
+type Pain014GroupHeader struct {
+	MessageID        string    `xml:"MsgId"`
+	CreationDateTime string    `xml:"CreDtTm"`
+	InitiatingParty  ISOParty  `xml:"InitgPty"`
+	CreditorAgent    *ISOAgent `xml:"CdtrAgt,omitempty"`
+}
+
+// Pain014OriginalPaymentInfo is the OrgnlPmtInfAndSts of a pain.014 document
+type Pain014OriginalPaymentInfo struct {
+	OriginalPaymentInfoID string                   `xml:"OrgnlPmtInfId"`
+	Transactions          []Pain014TransactionInfo `xml:"TxInfAndSts"`
+}
+
+// Pain014TransactionInfo is the TxInfAndSts of a pain.014 document, which holds the outcome of one drawdown request
+type Pain014TransactionInfo struct {
+	StatusID                     string                           `xml:"StsId,omitempty"`
+	OriginalInstructionID        string                           `xml:"OrgnlInstrId,omitempty"`
+	OriginalEndToEndID           string                           `xml:"OrgnlEndToEndId,omitempty"`
+	TransactionStatus            string                           `xml:"TxSts"`
+	StatusReason                 []ISOReasonInfo                  `xml:"StsRsnInf,omitempty"`
+	OriginalTransactionReference *ISOOriginalTransactionReference `xml:"OrgnlTxRef,omitempty"`
+}
+
+// Pain014FromXML reads a pain.014 document
+func Pain014FromXML(bs []byte) (*Pain014Document, error) {
+	doc := &Pain014Document{}
+	if err := isoFromXML(bs, doc, "pain.014"); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// XML returns the pain.014 document as XML
+func (doc *Pain014Document) XML() ([]byte, error) {
+	return isoXML(doc)
+}
+
+// ToPain014 converts the answer to a drawdown request into a pain.014 CreditorPaymentActivationRequestStatusReport.
+//
+// A drawdown payment (SubTypeCode 32) accepts the request identified by {3500} PreviousMessageIdentifier and is
+// converted with status ACCP, a refusal (SubTypeCode 33) with status RJCT. {3100} Sender is the initiating party and
+// {3400} Receiver the creditor agent. {6500} FIAdditionalFIToFI lines are the status reason. The amount, cycle date
+// and parties are carried in OrgnlTxRef, see isoDrawdownTransactionReference. ToPain014 does not validate the
+// message, callers should make a Validate() call first.
+func (fwm *FEDWireMessage) ToPain014() (*Pain014Document, error) {
+	if fwm.TypeSubType == nil {
+		return nil, fieldError("TypeSubType", ErrFieldRequired)
+	}
+	status := ""
+	switch fwm.TypeSubType.SubTypeCode {
+	case FundsTransferRequestCredit:
+		status = isoStatusAccepted
+	case RefusalRequestCredit:
+		status = isoStatusRejected
+	default:
+		return nil, fieldError("TypeSubType", ErrISOTypeSubType, fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	}
+	previous := fwm.previousMessageID()
+	if previous == "" {
+		return nil, fieldError("PreviousMessageIdentifier", ErrFieldRequired)
+	}
+
+	tx := Pain014TransactionInfo{
+		StatusID:                     fwm.isoMessageID(),
+		TransactionStatus:            status,
+		OriginalTransactionReference: fwm.isoDrawdownTransactionReference(),
+	}
+	if fwm.SenderReference != nil && strings.TrimSpace(fwm.SenderReference.SenderReference) != "" {
+		tx.StatusID = strings.TrimSpace(fwm.SenderReference.SenderReference)
+	}
+	if fwm.BeneficiaryReference != nil {
+		tx.OriginalEndToEndID = strings.TrimSpace(fwm.BeneficiaryReference.BeneficiaryReference)
+	}
+	if lines := nonEmpty(fwm.fiToFILines(TagFIAdditionalFIToFI)...); len(lines) > 0 {
+		tx.StatusReason = []ISOReasonInfo{{AdditionalInfo: lines}}
+	}
+
+	hdr := Pain014GroupHeader{
+		MessageID:        fwm.isoMessageID(),
+		CreationDateTime: isoCreationDateTime(),
+	}
+	instructing, instructed := fwm.isoSenderReceiverAgents()
+	if instructing != nil {
+		aba, name := abaFromISOAgent(instructing)
+		hdr.InitiatingParty = *isoPartyFromABA(aba, name)
+	}
+	hdr.CreditorAgent = instructed
+
+	doc := &Pain014Document{
+		StatusReport: Pain014CreditorPaymentActivationRequestStatusReport{
+			GroupHeader: hdr,
+			OriginalGroupInfo: ISOOriginalGroupInfo{
+				OriginalMessageID:     previous,
+				OriginalMessageNameID: isoPain013MessageName,
+			},
+			OriginalPaymentInfo: []Pain014OriginalPaymentInfo{{
+				OriginalPaymentInfoID: previous,
+				Transactions:          []Pain014TransactionInfo{tx},
+			}},
+		},
+	}
+	return doc, nil
+}
+
+// FEDWireMessageFromPain014 converts a pain.014 CreditorPaymentActivationRequestStatusReport into the answer to a
+// drawdown request.
+//
+// The document must hold exactly one transaction. Without OrgnlTxRef/PmtTpInf an accepted request (ACCP) becomes a DRW
+// drawdown payment and a rejected request (RJCT) a DRC refusal, any other status returns ErrISOStatus.
+// OrgnlGrpInfAndSts/OrgnlMsgId becomes {3500} PreviousMessageIdentifier. The returned message is not validated,
+// callers should make a Validate() call to confirm the message is a valid Fedwire message.
+func FEDWireMessageFromPain014(doc *Pain014Document) (*FEDWireMessage, error) {
+	if doc == nil {
+		return nil, fieldError("Document", ErrISODocument)
+	}
+	rpt := doc.StatusReport
+	if len(rpt.OriginalPaymentInfo) != 1 || len(rpt.OriginalPaymentInfo[0].Transactions) != 1 {
+		return nil, fieldError("TxInfAndSts", ErrISOTransactionCount)
+	}
+	tx := rpt.OriginalPaymentInfo[0].Transactions[0]
+	if rpt.OriginalGroupInfo.OriginalMessageID == "" {
+		return nil, fieldError("OrgnlGrpInfAndSts", ErrISODocument)
+	}
+
+	bfc, typeSubType := "", ""
+	switch tx.TransactionStatus {
+	case isoStatusAccepted:
+		bfc, typeSubType = DrawdownResponse, FundsTransfer+FundsTransferRequestCredit
+	case isoStatusRejected:
+		bfc, typeSubType = CustomerCorporateDrawdownRequest, FundsTransfer+RefusalRequestCredit
+	default:
+		return nil, fieldError("TxSts", ErrISOStatus, tx.TransactionStatus)
+	}
+
+	ref := tx.OriginalTransactionReference
+	if ref == nil {
+		ref = &ISOOriginalTransactionReference{}
+	}
+	date := ""
+	if ref.RequestedExecutionDate != nil {
+		date = ref.RequestedExecutionDate.Date
+	}
+	fwm := &FEDWireMessage{}
+	fwm.setIMADFromISO(rpt.GroupHeader.MessageID, date)
+	fwm.setISOSenderSupplied("")
+	fwm.setFromISOPaymentTypeInfo(ref.PaymentTypeInfo, bfc, typeSubType)
+
+	fwm.Amount = NewAmount()
+	fwm.Amount.Amount = strings.Repeat("0", 12)
+	if ref.Amount != nil && ref.Amount.InstructedAmount != nil {
+		amount, err := impliedFromISODecimal(ref.Amount.InstructedAmount.Value)
+		if err != nil {
+			return nil, err
+		}
+		fwm.Amount.Amount = amount
+	}
+
+	aba, name := abaFromISOParty(&rpt.GroupHeader.InitiatingParty)
+	if aba == "" {
+		return nil, fieldError("InitgPty", ErrISODocument)
+	}
+	if err := fwm.setFromISOSenderReceiverAgents(isoAgentFromABA(aba, name), rpt.GroupHeader.CreditorAgent); err != nil {
+		return nil, err
+	}
+	if tx.StatusID != "" && tx.StatusID != rpt.GroupHeader.MessageID {
+		fwm.SenderReference = NewSenderReference()
+		fwm.SenderReference.SenderReference = tx.StatusID
+	}
+	fwm.setPreviousMessageID(rpt.OriginalGroupInfo.OriginalMessageID)
+	if tx.OriginalEndToEndID != "" && tx.OriginalEndToEndID != isoNotProvided {
+		fwm.BeneficiaryReference = NewBeneficiaryReference()
+		fwm.BeneficiaryReference.BeneficiaryReference = tx.OriginalEndToEndID
+	}
+	fwm.setFromISODrawdownTransactionReference(ref)
+
+	var lines []string
+	for _, reason := range tx.StatusReason {
+		lines = append(lines, reason.AdditionalInfo...)
+	}
+	if lines = nonEmpty(lines...); len(lines) > 0 {
+		fwm.FIAdditionalFIToFI = NewFIAdditionalFIToFI()
+		a := &fwm.FIAdditionalFIToFI.AdditionalFIToFI
+		setLines([]*string{&a.LineOne, &a.LineTwo, &a.LineThree, &a.LineFour, &a.LineFive, &a.LineSix}, lines)
+	}
+	return fwm, nil
+}
+
+// isoDrawdownTransactionReference maps the answer to a drawdown request into an original transaction reference. A
+// refusal keeps the parties of the request, {4400} as the debtor, {5000} as the ultimate debtor and {5400} as the
+// creditor agent in place of {4100} BeneficiaryFI, a drawdown payment those of a payment.
+func (fwm *FEDWireMessage) isoDrawdownTransactionReference() *ISOOriginalTransactionReference {
+	ref := fwm.isoOriginalTransactionReference()
+	ref.PaymentTypeInfo = fwm.isoPaymentTypeInfo()
+	if fwm.Amount != nil {
+		ref.Amount = &ISOAmountChoice{InstructedAmount: &ISOAmount{Currency: isoCurrencyUSD, Value: isoDecimalFromImplied(fwm.Amount.Amount)}}
+	}
+	if date := fwm.isoCycleDate(); date != "" {
+		ref.RequestedExecutionDate = &ISODateChoice{Date: date}
+	}
+	if fwm.TypeSubType.SubTypeCode != RefusalRequestCredit {
+		return ref
+	}
+	if debtor, account := fwm.isoDrawdownDebtor(); debtor != nil {
+		if fwm.Originator != nil {
+			ref.UltimateDebtor = &ISOPartyChoice{Party: isoUltimateDebtor(fwm.Originator.Personal)}
+		}
+		ref.Debtor, ref.DebtorAccount = &ISOPartyChoice{Party: debtor}, account
+	}
+	if agent := fwm.isoDrawdownCreditorAgent(); agent != nil {
+		ref.CreditorAgent, ref.CreditorAgentAccount = agent, nil
+	}
+	return ref
+}
+
+// setFromISODrawdownTransactionReference is the inverse of isoDrawdownTransactionReference
+func (fwm *FEDWireMessage) setFromISODrawdownTransactionReference(ref *ISOOriginalTransactionReference) {
+	if fwm.TypeSubType.SubTypeCode != RefusalRequestCredit {
+		fwm.setFromISOOriginalTransactionReference(ref)
+		return
+	}
+	var debtor *ISOParty
+	if ref.Debtor != nil {
+		debtor = ref.Debtor.Party
+	}
+	fwm.setFromISODrawdownDebtor(debtor, ref.DebtorAccount)
+	fwm.setFromISODrawdownCreditorAgent(ref.CreditorAgent)
+
+	rest := *ref
+	rest.Debtor, rest.DebtorAccount = ref.UltimateDebtor, nil
+	rest.CreditorAgent, rest.CreditorAgentAccount = nil, nil
+	fwm.setFromISOOriginalTransactionReference(&rest)
+}
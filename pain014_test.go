@@ -0,0 +1,109 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestPain014_DrawdownRefusal converts a drawdown refusal into pain.014 and back
+func TestPain014_DrawdownRefusal(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-DrawdownRefusal.txt")
+
+	doc, err := fwm.ToPain014()
+	require.NoError(t, err)
+	rpt := doc.StatusReport
+	tx := rpt.OriginalPaymentInfo[0].Transactions[0]
+	require.Equal(t, "20190410Source08000001", rpt.OriginalGroupInfo.OriginalMessageID)
+	require.Equal(t, "pain.013.001.07", rpt.OriginalGroupInfo.OriginalMessageNameID)
+	require.Equal(t, "RJCT", tx.TransactionStatus)
+	require.Equal(t, "Refusal 1", tx.StatusID)
+	require.Equal(t, []string{"INSUFFICIENT FUNDS"}, tx.StatusReason[0].AdditionalInfo)
+	require.Equal(t, "Debtor Corp", tx.OriginalTransactionReference.Debtor.Party.Name)
+	require.Equal(t, "121042882", tx.OriginalTransactionReference.CreditorAgent.FinancialInstitutionID.ClearingSystemMemberID.MemberID)
+
+	bs, err := doc.XML()
+	require.NoError(t, err)
+	read, err := Pain014FromXML(bs)
+	require.NoError(t, err)
+
+	out, err := FEDWireMessageFromPain014(read)
+	require.NoError(t, err)
+	require.NoError(t, out.Validate())
+	require.Equal(t, fwm.InputMessageAccountabilityData.String(), out.InputMessageAccountabilityData.String())
+	require.Equal(t, fwm.TypeSubType.String(), out.TypeSubType.String())
+	require.Equal(t, fwm.BusinessFunctionCode.String(), out.BusinessFunctionCode.String())
+	require.Equal(t, fwm.Amount.String(), out.Amount.String())
+	require.Equal(t, fwm.SenderDepositoryInstitution.String(), out.SenderDepositoryInstitution.String())
+	require.Equal(t, fwm.ReceiverDepositoryInstitution.String(), out.ReceiverDepositoryInstitution.String())
+	require.Equal(t, fwm.SenderReference.String(), out.SenderReference.String())
+	require.Equal(t, fwm.PreviousMessageIdentifier.String(), out.PreviousMessageIdentifier.String())
+	require.Equal(t, fwm.BeneficiaryReference.String(), out.BeneficiaryReference.String())
+	require.Equal(t, fwm.Beneficiary.String(), out.Beneficiary.String())
+	require.Equal(t, fwm.AccountDebitedDrawdown.String(), out.AccountDebitedDrawdown.String())
+	require.Equal(t, fwm.Originator.String(), out.Originator.String())
+	require.Equal(t, fwm.AccountCreditedDrawdown.String(), out.AccountCreditedDrawdown.String())
+	require.Equal(t, fwm.FIAdditionalFIToFI.String(), out.FIAdditionalFIToFI.String())
+}
+
+// TestPain014_DrawdownResponse converts a drawdown payment into an accepted pain.014 and back
+func TestPain014_DrawdownResponse(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-DrawdownResponse.txt")
+
+	doc, err := fwm.ToPain014()
+	require.NoError(t, err)
+	require.Equal(t, "ACCP", doc.StatusReport.OriginalPaymentInfo[0].Transactions[0].TransactionStatus)
+
+	out, err := FEDWireMessageFromPain014(doc)
+	require.NoError(t, err)
+	require.NoError(t, out.Validate())
+	require.Equal(t, DrawdownResponse, out.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, fwm.Amount.String(), out.Amount.String())
+	require.Equal(t, fwm.Originator.String(), out.Originator.String())
+	require.Equal(t, fwm.OriginatorFI.String(), out.OriginatorFI.String())
+	require.Equal(t, fwm.BeneficiaryFI.String(), out.BeneficiaryFI.String())
+	require.Equal(t, fwm.Beneficiary.String(), out.Beneficiary.String())
+	require.Equal(t, fwm.FIAdditionalFIToFI.String(), out.FIAdditionalFIToFI.String())
+}
+
+// TestPain014_FromXML reads a pain.014 refusal without PmtTpInf into a DRC refusal
+func TestPain014_FromXML(t *testing.T) {
+	bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", "pain014-DrawdownRefusal.xml"))
+	require.NoError(t, err)
+	doc, err := Pain014FromXML(bs)
+	require.NoError(t, err)
+
+	fwm, err := FEDWireMessageFromPain014(doc)
+	require.NoError(t, err)
+	require.NoError(t, fwm.Validate())
+	require.Equal(t, CustomerCorporateDrawdownRequest, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, "1033", fwm.TypeSubType.TypeCode+fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, "{4400}D123456789*Debtor Corp*1 Market Street*San Francisco CA 94105*US*", fwm.AccountDebitedDrawdown.String())
+	require.Equal(t, "{5400}121042882", fwm.AccountCreditedDrawdown.String())
+	require.Equal(t, "{5000}D123456789*Debtor Corp*", fwm.Originator.String())
+	require.Equal(t, "{4200}D987654321*Creditor Corp*", fwm.Beneficiary.String())
+	require.Equal(t, "20190410Source08000001", fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+}
+
+// TestPain014_Status ensures only accepted and rejected statuses are converted
+func TestPain014_Status(t *testing.T) {
+	bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", "pain014-DrawdownRefusal.xml"))
+	require.NoError(t, err)
+	doc, err := Pain014FromXML(bs)
+	require.NoError(t, err)
+
+	doc.StatusReport.OriginalPaymentInfo[0].Transactions[0].TransactionStatus = "PDNG"
+	_, err = FEDWireMessageFromPain014(doc)
+	require.True(t, errors.Is(err, ErrISOStatus))
+
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerCorporateDrawDownRequest.txt")
+	_, err = fwm.ToPain014()
+	require.True(t, errors.Is(err, ErrISOTypeSubType))
+}
@@ -0,0 +1,152 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+)
+
+// PartyRole is the role of a Party in the payment chain of a FEDWireMessage
+type PartyRole string
+
+const (
+	// RoleDebtor is the originator {5000} or {5010}, or the ordering customer {7050} of a cover payment
+	RoleDebtor PartyRole = "debtor"
+	// RoleDebtorAgent is the originator's financial institution {5100}
+	RoleDebtorAgent PartyRole = "debtorAgent"
+	// RoleInstructingAgent is the instructing financial institution {5200} or the sender {3100}
+	RoleInstructingAgent PartyRole = "instructingAgent"
+	// RoleInstructedAgent is the receiver {3400}
+	RoleInstructedAgent PartyRole = "instructedAgent"
+	// RoleIntermediary is the beneficiary's intermediary financial institution {4000}
+	RoleIntermediary PartyRole = "intermediary"
+	// RoleCreditorAgent is the beneficiary's financial institution {4100}
+	RoleCreditorAgent PartyRole = "creditorAgent"
+	// RoleCreditor is the beneficiary {4200}, or the beneficiary customer {7059} of a cover payment
+	RoleCreditor PartyRole = "creditor"
+)
+
+// Party is a party or financial institution of a FEDWireMessage in one model, whichever tag it is read from
+type Party struct {
+	// Role of the party in the payment chain
+	Role PartyRole `json:"role"`
+	// Tag the party is read from, e.g. {5000}
+	Tag string `json:"tag"`
+	// IdentificationCode of Identifier, e.g. D for an account, see IdentificationCode
+	IdentificationCode string `json:"identificationCode,omitempty"`
+	// IdentificationType describes IdentificationCode
+	IdentificationType string `json:"identificationType,omitempty"`
+	// Identifier such as an account, routing number or BIC
+	Identifier string `json:"identifier,omitempty"`
+	// Name of the party
+	Name string `json:"name,omitempty"`
+	// AddressLines are the non-blank address lines
+	AddressLines []string `json:"addressLines,omitempty"`
+	// Country is the ISO 3166 country code of a SWIFT option F "3/" line
+	Country string `json:"country,omitempty"`
+	// Town is the town of a SWIFT option F "3/" line
+	Town string `json:"town,omitempty"`
+}
+
+// PaymentChain returns the parties and financial institutions of the message in the order the funds move: the
+// debtors, debtor agent, instructing agents, instructed agent, intermediary, creditor agent and creditors. A role
+// is absent when its tag is not in the message, and debtors and creditors appear once for each tag which holds one.
+//
+// The {5010} OriginatorOptionF and the {7050} OrderingCustomer and {7059} BeneficiaryCustomer SWIFT fields are
+// decoded into an identification, a name and address lines: an account as D, a BIC as B or T, a //FW clearing code
+// as F and a party identifier as its personal identification code, or as other identification (9) with its code.
+// Option F lines with a line code other than name, address and country and town are kept as address lines.
+func (fwm *FEDWireMessage) PaymentChain() []Party {
+	var chain []Party
+	if fwm.Originator != nil {
+		chain = append(chain, newPartyFromPersonal(RoleDebtor, TagOriginator, fwm.Originator.Personal))
+	}
+	if oof := fwm.OriginatorOptionF; oof != nil {
+		lines := []string{oof.PartyIdentifier, oof.Name, oof.LineOne, oof.LineTwo, oof.LineThree}
+		chain = appendSwiftParty(chain, RoleDebtor, TagOriginatorOptionF, "50F", lines)
+	}
+	if oc := fwm.OrderingCustomer; oc != nil {
+		chain = appendSwiftParty(chain, RoleDebtor, TagOrderingCustomer, oc.CoverPayment.SwiftFieldTag, coverPaymentLines(oc.CoverPayment))
+	}
+	if fwm.OriginatorFI != nil {
+		chain = append(chain, newPartyFromFI(RoleDebtorAgent, TagOriginatorFI, fwm.OriginatorFI.FinancialInstitution))
+	}
+	if fwm.InstructingFI != nil {
+		chain = append(chain, newPartyFromFI(RoleInstructingAgent, TagInstructingFI, fwm.InstructingFI.FinancialInstitution))
+	}
+	if sdi := fwm.SenderDepositoryInstitution; sdi != nil {
+		chain = append(chain, newPartyFromABA(RoleInstructingAgent, TagSenderDepositoryInstitution, sdi.SenderABANumber, sdi.SenderShortName))
+	}
+	if rdi := fwm.ReceiverDepositoryInstitution; rdi != nil {
+		chain = append(chain, newPartyFromABA(RoleInstructedAgent, TagReceiverDepositoryInstitution, rdi.ReceiverABANumber, rdi.ReceiverShortName))
+	}
+	if fwm.BeneficiaryIntermediaryFI != nil {
+		chain = append(chain, newPartyFromFI(RoleIntermediary, TagBeneficiaryIntermediaryFI, fwm.BeneficiaryIntermediaryFI.FinancialInstitution))
+	}
+	if fwm.BeneficiaryFI != nil {
+		chain = append(chain, newPartyFromFI(RoleCreditorAgent, TagBeneficiaryFI, fwm.BeneficiaryFI.FinancialInstitution))
+	}
+	if fwm.Beneficiary != nil {
+		chain = append(chain, newPartyFromPersonal(RoleCreditor, TagBeneficiary, fwm.Beneficiary.Personal))
+	}
+	if bc := fwm.BeneficiaryCustomer; bc != nil {
+		chain = appendSwiftParty(chain, RoleCreditor, TagBeneficiaryCustomer, bc.CoverPayment.SwiftFieldTag, coverPaymentLines(bc.CoverPayment))
+	}
+	return chain
+}
+
+// Parties returns the parties of the PaymentChain with role
+func (fwm *FEDWireMessage) Parties(role PartyRole) []Party {
+	var parties []Party
+	for _, p := range fwm.PaymentChain() {
+		if p.Role == role {
+			parties = append(parties, p)
+		}
+	}
+	return parties
+}
+
+// newParty returns a Party with its identification and address lines trimmed
+func newParty(role PartyRole, tag, code, identifier, name string, lines ...string) Party {
+	code = strings.TrimSpace(code)
+	return Party{
+		Role:               role,
+		Tag:                tag,
+		IdentificationCode: code,
+		IdentificationType: IdentificationCode(code).Description(),
+		Identifier:         strings.TrimSpace(identifier),
+		Name:               strings.TrimSpace(name),
+		AddressLines:       nonEmpty(lines...),
+	}
+}
+
+// newPartyFromPersonal returns the Party of an originator or beneficiary
+func newPartyFromPersonal(role PartyRole, tag string, p Personal) Party {
+	return newParty(role, tag, p.IdentificationCode, p.Identifier, p.Name,
+		p.Address.AddressLineOne, p.Address.AddressLineTwo, p.Address.AddressLineThree)
+}
+
+// newPartyFromFI returns the Party of a financial institution
+func newPartyFromFI(role PartyRole, tag string, fi FinancialInstitution) Party {
+	return newParty(role, tag, fi.IdentificationCode, fi.Identifier, fi.Name,
+		fi.Address.AddressLineOne, fi.Address.AddressLineTwo, fi.Address.AddressLineThree)
+}
+
+// newPartyFromABA returns the Party of a sender or receiver depository institution
+func newPartyFromABA(role PartyRole, tag, aba, shortName string) Party {
+	return newParty(role, tag, FEDRoutingNumber, aba, shortName)
+}
+
+// appendSwiftParty appends the Party decoded from the lines of a SWIFT field, unless the field is blank
+func appendSwiftParty(chain []Party, role PartyRole, tag, swiftFieldTag string, lines []string) []Party {
+	sp := decodeSwiftParty(swiftFieldTag, lines)
+	code, identifier := sp.identification()
+	p := newParty(role, tag, code, identifier, sp.Name, sp.AddressLines...)
+	p.Country, p.Town = strings.TrimSpace(sp.Country), strings.TrimSpace(sp.Town)
+	if p.Identifier == "" && p.Name == "" && len(p.AddressLines) == 0 && p.Country == "" {
+		return chain
+	}
+	return append(chain, p)
+}
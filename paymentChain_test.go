@@ -0,0 +1,110 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFEDWireMessage_PaymentChain(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.txt")
+	chain := fwm.PaymentChain()
+
+	var tags []string
+	var roles []PartyRole
+	for _, p := range chain {
+		tags = append(tags, p.Tag)
+		roles = append(roles, p.Role)
+	}
+	require.Equal(t, []string{"{5000}", "{5010}", "{7050}", "{5100}", "{5200}", "{3100}", "{3400}", "{4000}", "{4100}", "{4200}", "{7059}"}, tags)
+	require.Equal(t, []PartyRole{RoleDebtor, RoleDebtor, RoleDebtor, RoleDebtorAgent, RoleInstructingAgent, RoleInstructingAgent,
+		RoleInstructedAgent, RoleIntermediary, RoleCreditorAgent, RoleCreditor, RoleCreditor}, roles)
+
+	require.Equal(t, Party{
+		Role:               RoleDebtor,
+		Tag:                TagOriginator,
+		IdentificationCode: PassportNumber,
+		IdentificationType: "Passport Number",
+		Identifier:         "1234",
+		Name:               "Name",
+		AddressLines:       []string{"Address One", "Address Two", "Address Three"},
+	}, chain[0])
+	require.Equal(t, Party{
+		Role:               RoleInstructingAgent,
+		Tag:                TagSenderDepositoryInstitution,
+		IdentificationCode: FEDRoutingNumber,
+		IdentificationType: "Fed Routing Number",
+		Identifier:         "121042882",
+		Name:               "Wells Fargo NA",
+	}, chain[5])
+}
+
+func TestFEDWireMessage_PaymentChainSwift(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.txt")
+
+	// {5010} TXID/123-45-6789*1/Name*1/1234*2/1000 Colonial Farm Rd*5/Pottstown*
+	optionF := fwm.PaymentChain()[1]
+	require.Equal(t, TaxIdentificationNumber, optionF.IdentificationCode)
+	require.Equal(t, "123-45-6789", optionF.Identifier)
+	require.Equal(t, "Name 1234", optionF.Name)
+	require.Equal(t, []string{"1000 Colonial Farm Rd", "5/Pottstown"}, optionF.AddressLines)
+
+	// {7050} 50F*TXID/123-45-6789*1/Jane Doe*2/1000 Colonial Farm Rd*3/US/Pottstown*4/19800101*
+	orderingCustomer := fwm.PaymentChain()[2]
+	require.Equal(t, TagOrderingCustomer, orderingCustomer.Tag)
+	require.Equal(t, TaxIdentificationNumber, orderingCustomer.IdentificationCode)
+	require.Equal(t, "Jane Doe", orderingCustomer.Name)
+	require.Equal(t, "US", orderingCustomer.Country)
+	require.Equal(t, "Pottstown", orderingCustomer.Town)
+
+	// {7059} 59*/DE89370400440532013000*John Doe*Taunusanlage 12*Frankfurt am Main*
+	creditors := fwm.Parties(RoleCreditor)
+	require.Len(t, creditors, 2)
+	require.Equal(t, Party{
+		Role:               RoleCreditor,
+		Tag:                TagBeneficiaryCustomer,
+		IdentificationCode: DemandDepositAccountNumber,
+		IdentificationType: "Demand Deposit Account (DDA) Number",
+		Identifier:         "DE89370400440532013000",
+		Name:               "John Doe",
+		AddressLines:       []string{"Taunusanlage 12", "Frankfurt am Main"},
+	}, creditors[1])
+
+	// a party identifier without a Fedwire identification code is other identification
+	fwm.OrderingCustomer.CoverPayment = newCoverPayment("50F", []string{"SOSE/123-45-6789", "1/Jane Doe"}, 5)
+	debtors := fwm.Parties(RoleDebtor)
+	require.Equal(t, OtherIdentification, debtors[2].IdentificationCode)
+	require.Equal(t, "SOSE/123-45-6789", debtors[2].Identifier)
+
+	// a BIC
+	fwm.OrderingCustomer.CoverPayment = newCoverPayment("50A", []string{"/123456789", "CHASUS33"}, 5)
+	debtors = fwm.Parties(RoleDebtor)
+	require.Equal(t, SWIFTBICORBEIANDAccountNumber, debtors[2].IdentificationCode)
+	require.Equal(t, "CHASUS33/123456789", debtors[2].Identifier)
+
+	// a blank SWIFT field is not a party
+	fwm.BeneficiaryCustomer.CoverPayment = CoverPayment{SwiftFieldTag: "59"}
+	require.Len(t, fwm.Parties(RoleCreditor), 1)
+}
+
+func TestFEDWireMessage_PaymentChainMinimal(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-FEDFundsSold.txt")
+	fwm.InstructingFI = nil
+	fwm.OriginatorFI = nil
+	fwm.BeneficiaryIntermediaryFI = nil
+	fwm.BeneficiaryFI = nil
+	fwm.Beneficiary = nil
+	fwm.Originator = nil
+	fwm.OriginatorOptionF = nil
+
+	chain := fwm.PaymentChain()
+	require.Len(t, chain, 2)
+	require.Equal(t, RoleInstructingAgent, chain[0].Role)
+	require.Equal(t, RoleInstructedAgent, chain[1].Role)
+	require.Empty(t, fwm.Parties(RoleDebtor))
+	require.Empty(t, (&FEDWireMessage{}).PaymentChain())
+}
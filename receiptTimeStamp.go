@@ -7,6 +7,7 @@ package wire
 import (
 	"encoding/json"
 	"strings"
+	"time"
 )
 
 // ReceiptTimeStamp is the receipt time stamp of the wire
@@ -95,3 +96,23 @@ func (rts *ReceiptTimeStamp) ReceiptTimeField() string {
 func (rts *ReceiptTimeStamp) ReceiptApplicationIdentificationField() string {
 	return rts.alphaField(rts.ReceiptApplicationIdentification, 4)
 }
+
+// ReceiptDateTime returns ReceiptDate and ReceiptTime in FedwireLocation. The year is inferred from cycleDate, which
+// is usually the InputCycleDate of the message; see FEDWireMessage.ReceiptDateTime.
+func (rts *ReceiptTimeStamp) ReceiptDateTime(cycleDate time.Time) (time.Time, error) {
+	t, err := parseMMDDHHMM(cycleDate, rts.ReceiptDate, rts.ReceiptTime)
+	switch err {
+	case nil:
+		return t, nil
+	case ErrValidTime:
+		return t, fieldError("ReceiptTime", err, rts.ReceiptTime)
+	}
+	return t, fieldError("ReceiptDate", err, rts.ReceiptDate)
+}
+
+// SetReceiptDateTime sets ReceiptDate and ReceiptTime to t in FedwireLocation
+func (rts *ReceiptTimeStamp) SetReceiptDateTime(t time.Time) {
+	t = t.In(FedwireLocation)
+	rts.ReceiptDate = t.Format(mmddLayout)
+	rts.ReceiptTime = t.Format(hhmmLayout)
+}
@@ -1,8 +1,10 @@
 package wire
 
 import (
+	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -56,3 +58,35 @@ func TestReceiptTimeStampTagError(t *testing.T) {
 
 	require.EqualError(t, rts.Validate(), fieldError("tag", ErrValidTagForType, rts.tag).Error())
 }
+
+// TestReceiptTimeStampReceiptDateTime converts ReceiptDate and ReceiptTime to and from a time.Time
+func TestReceiptTimeStampReceiptDateTime(t *testing.T) {
+	rts := mockReceiptTimeStamp()
+	cycleDate := time.Date(2019, time.May, 2, 0, 0, 0, 0, FedwireLocation)
+	receipt, err := rts.ReceiptDateTime(cycleDate)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.May, 2, 12, 30, 0, 0, FedwireLocation), receipt)
+	require.Equal(t, "2019-05-02T16:30:00Z", receipt.UTC().Format(time.RFC3339))
+
+	rts.SetReceiptDateTime(time.Date(2019, time.December, 1, 2, 5, 0, 0, time.UTC))
+	require.Equal(t, "1130", rts.ReceiptDate)
+	require.Equal(t, "2105", rts.ReceiptTime)
+
+	rts.ReceiptDate = "1332"
+	_, err = rts.ReceiptDateTime(cycleDate)
+	require.True(t, errors.Is(err, ErrValidDate))
+}
+
+// TestFEDWireMessage_ReceiptDateTime infers the year of the receipt time stamp from the input cycle date
+func TestFEDWireMessage_ReceiptDateTime(t *testing.T) {
+	fwm := FEDWireMessage{}
+	_, err := fwm.ReceiptDateTime()
+	require.True(t, errors.Is(err, ErrFieldRequired))
+
+	fwm.ReceiptTimeStamp = mockReceiptTimeStamp()
+	fwm.InputMessageAccountabilityData = mockInputMessageAccountabilityData()
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20190503"
+	receipt, err := fwm.ReceiptDateTime()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2019, time.May, 2, 12, 30, 0, 0, FedwireLocation), receipt)
+}
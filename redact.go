@@ -0,0 +1,381 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// redactClass is a class of personal information
+type redactClass int
+
+const (
+	redactIdentifier redactClass = iota + 1
+	redactName
+	redactAddress
+	redactContact
+	redactFreeText
+)
+
+// redactedText replaces free text
+const redactedText = "REDACTED"
+
+// RedactPolicy configures which classes of personal information Redact masks. Only the fields of people and
+// businesses are redacted, the identifiers, names and addresses of financial institutions are kept.
+type RedactPolicy struct {
+	// Identifiers masks account numbers and identification numbers, such as passport and tax identification numbers,
+	// keeping their last 4 characters and any code before a slash, e.g. TXID/123-45-6789 becomes TXID/XXX-XX-6789
+	Identifiers bool
+	// Names replaces names with a # and the first 12 hex characters of their HMAC-SHA256 with NameKey, or of their
+	// SHA-256 digest when NameKey is not set, so the same name can be matched across redacted messages.
+	//
+	// Without NameKey names are pseudonymized, not anonymized: anyone can hash a list of candidate names and match
+	// the digests, which reverses the redaction of known names. Set NameKey, and keep it secret, whenever redacted
+	// messages leave a trusted system.
+	Names bool
+	// NameKey is the secret HMAC key of Names
+	NameKey []byte
+	// Addresses drops addresses, dates of birth and places of birth
+	Addresses bool
+	// Contacts drops phone numbers, fax numbers and electronic addresses
+	Contacts bool
+	// FreeText replaces free text which may hold personal information, such as originator to beneficiary
+	// information, FI to FI information and remittance free text, with REDACTED
+	FreeText bool
+}
+
+// DefaultRedactPolicy redacts every class of personal information. It has no NameKey, so its hashed names can be
+// reversed by hashing candidate names; copy it and set NameKey to prevent this.
+var DefaultRedactPolicy = RedactPolicy{
+	Identifiers: true,
+	Names:       true,
+	Addresses:   true,
+	Contacts:    true,
+	FreeText:    true,
+}
+
+// redactFields are the classes of the fields of people and businesses, by JSON path
+var redactFields = map[string]redactClass{
+	"paymentNotification.contactNotificationElectronicAddress": redactContact,
+	"paymentNotification.contactName":                          redactName,
+	"paymentNotification.contactPhoneNumber":                   redactContact,
+	"paymentNotification.contactMobileNumber":                  redactContact,
+	"paymentNotification.faxNumber":                            redactContact,
+	"beneficiary.personal.identifier":                          redactIdentifier,
+	"beneficiary.personal.name":                                redactName,
+	"beneficiary.personal.address.addressLineOne":              redactAddress,
+	"beneficiary.personal.address.addressLineTwo":              redactAddress,
+	"beneficiary.personal.address.addressLineThree":            redactAddress,
+	"accountDebitedDrawdown.identifier":                        redactIdentifier,
+	"accountDebitedDrawdown.name":                              redactName,
+	"accountDebitedDrawdown.address.addressLineOne":            redactAddress,
+	"accountDebitedDrawdown.address.addressLineTwo":            redactAddress,
+	"accountDebitedDrawdown.address.addressLineThree":          redactAddress,
+	"originator.personal.identifier":                           redactIdentifier,
+	"originator.personal.name":                                 redactName,
+	"originator.personal.address.addressLineOne":               redactAddress,
+	"originator.personal.address.addressLineTwo":               redactAddress,
+	"originator.personal.address.addressLineThree":             redactAddress,
+	"originatorOptionF.partyIdentifier":                        redactIdentifier,
+	"accountCreditedDrawdown.drawdownCreditAccountNumber":      redactIdentifier,
+	"originatorToBeneficiary.lineOne":                          redactFreeText,
+	"originatorToBeneficiary.lineTwo":                          redactFreeText,
+	"originatorToBeneficiary.lineThree":                        redactFreeText,
+	"originatorToBeneficiary.lineFour":                         redactFreeText,
+	"fiPaymentMethodToBeneficiary.Additional":                  redactFreeText,
+	"unstructuredAddenda.addenda":                              redactFreeText,
+	"remittanceOriginator.identificationNumber":                redactIdentifier,
+	"remittanceOriginator.contactName":                         redactName,
+	"remittanceOriginator.contactPhoneNumber":                  redactContact,
+	"remittanceOriginator.contactMobileNumber":                 redactContact,
+	"remittanceOriginator.contactFaxNumber":                    redactContact,
+	"remittanceOriginator.contactElectronicAddress":            redactContact,
+	"remittanceOriginator.contactOther":                        redactContact,
+	"remittanceBeneficiary.identificationNumber":               redactIdentifier,
+	"relatedRemittance.remittanceLocationElctronicAddress":     redactContact,
+	"adjustment.additionalInfo":                                redactFreeText,
+	"remittanceFreeText.lineOne":                               redactFreeText,
+	"remittanceFreeText.lineTwo":                               redactFreeText,
+	"remittanceFreeText.lineThree":                             redactFreeText,
+}
+
+// redactRemittanceDataFields are the classes of the RemittanceData fields of {8250}, {8300} and {8350}
+var redactRemittanceDataFields = map[string]redactClass{
+	"name":                    redactName,
+	"dateBirthPlace":          redactAddress,
+	"department":              redactAddress,
+	"subDepartment":           redactAddress,
+	"streetName":              redactAddress,
+	"buildingNumber":          redactAddress,
+	"postCode":                redactAddress,
+	"townName":                redactAddress,
+	"countrySubDivisionState": redactAddress,
+	"addressLineOne":          redactAddress,
+	"addressLineTwo":          redactAddress,
+	"addressLineThree":        redactAddress,
+	"addressLineFour":         redactAddress,
+	"addressLineFive":         redactAddress,
+	"addressLineSix":          redactAddress,
+	"addressLineSeven":        redactAddress,
+}
+
+// redactFIToFITags are the FI to FI information tags whose lines are free text
+var redactFIToFITags = []string{"fiReceiverFI.", "fiDrawdownDebitAccountAdvice.", "fiIntermediaryFI.", "fiIntermediaryFIAdvice.", "fiBeneficiaryFI.",
+	"fiBeneficiaryFIAdvice.", "fiBeneficiary.", "fiBeneficiaryAdvice.", "fiAdditionalFiToFi.", "serviceMessage."}
+
+// redactClassOf returns the class of personal information of the field with the JSON path
+func redactClassOf(path string) redactClass {
+	if class, ok := redactFields[path]; ok {
+		return class
+	}
+	if idx := strings.Index(path, ".remittanceData."); idx >= 0 {
+		return redactRemittanceDataFields[path[idx+len(".remittanceData."):]]
+	}
+	for _, prefix := range redactFIToFITags {
+		if strings.HasPrefix(path, prefix) && strings.HasPrefix(path[strings.LastIndex(path, ".")+1:], "line") {
+			return redactFreeText
+		}
+	}
+	return 0
+}
+
+// Redact returns a copy of the FEDWireMessage with the personal information of the classes in policy masked. The
+// copy keeps the structure of each tag, so its tags written with String() can be parsed again.
+func (fwm *FEDWireMessage) Redact(policy RedactPolicy) *FEDWireMessage {
+	redacted := fwm.Clone()
+	v := reflect.ValueOf(redacted).Elem()
+	for _, column := range csvColumns {
+		class := redactClassOf(column.name)
+		if class == 0 {
+			continue
+		}
+		field, ok := redactField(v, column.index)
+		if !ok || strings.TrimSpace(field.String()) == "" {
+			continue
+		}
+		field.SetString(policy.redact(class, field.String()))
+	}
+
+	if oof := redacted.OriginatorOptionF; oof != nil {
+		oof.Name = policy.redactOptionFLine(oof.Name)
+		lines := policy.redactOptionFLines([]string{oof.LineOne, oof.LineTwo, oof.LineThree})
+		lines = append(lines, "", "", "")
+		oof.LineOne, oof.LineTwo, oof.LineThree = lines[0], lines[1], lines[2]
+	}
+	if oc := redacted.OrderingCustomer; oc != nil {
+		oc.CoverPayment = policy.redactSwiftParty(oc.CoverPayment)
+	}
+	if bc := redacted.BeneficiaryCustomer; bc != nil {
+		bc.CoverPayment = policy.redactSwiftParty(bc.CoverPayment)
+	}
+	if ri := redacted.Remittance; ri != nil && policy.FreeText {
+		ri.CoverPayment = newCoverPayment(ri.CoverPayment.SwiftFieldTag, []string{redactedText}, 1)
+	}
+	if sr := redacted.SenderToReceiver; sr != nil && policy.FreeText {
+		lines := coverPaymentLines(sr.CoverPayment)
+		for i := range lines {
+			lines[i] = redactCodeWordLine(lines[i])
+		}
+		sr.CoverPayment = newCoverPayment(sr.CoverPayment.SwiftFieldTag, lines, len(lines))
+	}
+	return redacted
+}
+
+// Redact returns a copy of the File with the personal information of its FEDWireMessage redacted by policy
+func (f *File) Redact(policy RedactPolicy) *File {
+	return &File{
+		ID:             f.ID,
+		FEDWireMessage: *f.FEDWireMessage.Redact(policy),
+	}
+}
+
+// RedactTag returns a tag in the Fedwire format, e.g. the String() of a tag, with its personal information redacted
+// by policy. An error is returned when the tag does not parse.
+func RedactTag(record string, policy RedactPolicy) (string, error) {
+	r := &Reader{line: record}
+	if err := r.parseLine(); err != nil {
+		return "", err
+	}
+	v := reflect.ValueOf(r.currentFEDWireMessage.Redact(policy)).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if field := v.Field(i); field.Kind() == reflect.Ptr && !field.IsNil() {
+			if s, ok := field.Interface().(fmt.Stringer); ok {
+				return s.String(), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("tag %q is not redacted", record)
+}
+
+// redactField returns the settable field at index in v, or false when a tag along the path is nil
+func redactField(v reflect.Value, index []int) (reflect.Value, bool) {
+	for _, i := range index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v, true
+}
+
+// redact returns the value of a field of the class redacted by the policy
+func (policy RedactPolicy) redact(class redactClass, s string) string {
+	switch class {
+	case redactIdentifier:
+		if policy.Identifiers {
+			return maskIdentifier(s)
+		}
+	case redactName:
+		if policy.Names {
+			return policy.hashName(s)
+		}
+	case redactAddress:
+		if policy.Addresses {
+			return ""
+		}
+	case redactContact:
+		if policy.Contacts {
+			return ""
+		}
+	case redactFreeText:
+		if policy.FreeText {
+			return redactedText
+		}
+	}
+	return s
+}
+
+// hashName returns a # followed by the first 12 hex characters of the digest of the name
+func (policy RedactPolicy) hashName(s string) string {
+	s = strings.TrimSpace(s)
+	var sum []byte
+	if len(policy.NameKey) > 0 {
+		mac := hmac.New(sha256.New, policy.NameKey)
+		mac.Write([]byte(s))
+		sum = mac.Sum(nil)
+	} else {
+		digest := sha256.Sum256([]byte(s))
+		sum = digest[:]
+	}
+	return "#" + strings.ToUpper(hex.EncodeToString(sum)[:12])
+}
+
+// maskIdentifier replaces the characters of an identifier after its last slash with X, keeping the last 4
+// characters, hyphens and spaces. Identifiers of 4 characters or less are masked entirely.
+func maskIdentifier(s string) string {
+	s = strings.TrimSpace(s)
+	prefix, id := "", s
+	if idx := strings.LastIndex(s, "/"); idx >= 0 {
+		prefix, id = s[:idx+1], s[idx+1:]
+	}
+	keep := 4
+	if len(id) <= keep {
+		keep = 0
+	}
+	masked := []byte(id)
+	for i := 0; i < len(masked)-keep; i++ {
+		if masked[i] != '-' && masked[i] != ' ' {
+			masked[i] = 'X'
+		}
+	}
+	return prefix + string(masked)
+}
+
+// redactOptionFLine redacts a numbered OriginatorOptionF or SWIFT option F line, e.g. 1/JOHN DOE, by its line code,
+// returning "" when the line is dropped
+func (policy RedactPolicy) redactOptionFLine(s string) string {
+	if len(s) < 2 || s[1] != '/' {
+		return s
+	}
+	code, value := s[:2], s[2:]
+	switch s[:1] {
+	case OptionFName:
+		if policy.Names {
+			return code + policy.hashName(value)
+		}
+	case OptionFAddress, OptionFCountryTown, OptionFDOB, OptionFBirthPlace:
+		if policy.Addresses {
+			return ""
+		}
+	case OptionFCustomerIdentificationNumber, OptionFNationalIdentityNumber:
+		if policy.Identifiers {
+			return code + maskIdentifier(value)
+		}
+	case OptionFAdditionalInformation:
+		if policy.FreeText {
+			return code + redactedText
+		}
+	}
+	return s
+}
+
+// redactOptionFLines redacts numbered option F lines, removing dropped lines
+func (policy RedactPolicy) redactOptionFLines(lines []string) []string {
+	var redacted []string
+	for _, line := range lines {
+		if line = policy.redactOptionFLine(strings.TrimSpace(line)); line != "" {
+			redacted = append(redacted, line)
+		}
+	}
+	return redacted
+}
+
+// redactSwiftParty redacts the SWIFT lines of the {7050} ordering customer or {7059} beneficiary customer by the
+// field option: option F lines by their line codes, and option K and 59 lines as an optional /account followed by
+// a name and address lines. Option A lines hold a BIC which is kept.
+func (policy RedactPolicy) redactSwiftParty(cp CoverPayment) CoverPayment {
+	lines := coverPaymentLines(cp)
+	if len(lines) == 0 {
+		return cp
+	}
+	_, option := swiftFieldOption(cp.SwiftFieldTag)
+	var redacted []string
+	first := strings.TrimSpace(lines[0])
+	if isSwiftAccountLine(first) || option == "F" && !strings.HasPrefix(first, OptionFName+"/") {
+		if policy.Identifiers {
+			first = maskIdentifier(first)
+		}
+		redacted, lines = append(redacted, first), lines[1:]
+	}
+
+	switch option {
+	case "A":
+		redacted = append(redacted, lines...)
+	case "F":
+		redacted = append(redacted, policy.redactOptionFLines(lines)...)
+	default:
+		for i, line := range lines {
+			switch {
+			case i == 0:
+				redacted = append(redacted, policy.redact(redactName, line))
+			case !policy.Addresses:
+				redacted = append(redacted, line)
+			}
+		}
+	}
+	return newCoverPayment(cp.SwiftFieldTag, redacted, len(redacted))
+}
+
+// redactCodeWordLine replaces the text of a field 72 line, keeping its /code word/ or // continuation
+func redactCodeWordLine(s string) string {
+	s = strings.TrimSpace(s)
+	switch {
+	case strings.HasPrefix(s, "//"):
+		return "//" + redactedText
+	case strings.HasPrefix(s, "/"):
+		if idx := strings.Index(s[1:], "/"); idx >= 0 {
+			return s[:idx+2] + redactedText
+		}
+	}
+	return redactedText
+}
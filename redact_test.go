@@ -0,0 +1,174 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestFEDWireMessage_Redact masks each class of personal information and leaves the original unchanged
+func TestFEDWireMessage_Redact(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.txt")
+	fwm.Beneficiary.Personal.Identifier = "123456789"
+	redacted := fwm.Redact(DefaultRedactPolicy)
+
+	// identifiers keep their last 4 characters
+	require.Equal(t, "XXXXX6789", redacted.Beneficiary.Personal.Identifier)
+	require.Equal(t, "TXID/XXX-XX-6789", redacted.OriginatorOptionF.PartyIdentifier)
+	require.Equal(t, "TXID/XXX-XX-6789", redacted.OrderingCustomer.CoverPayment.SwiftLineOne)
+	require.Equal(t, "/XXXXXXXXXXXXXXXXXX3000", redacted.BeneficiaryCustomer.CoverPayment.SwiftLineOne)
+
+	// names are hashed
+	name := DefaultRedactPolicy.hashName(fwm.Beneficiary.Personal.Name)
+	require.Regexp(t, "^#[0-9A-F]{12}$", name)
+	require.Equal(t, name, redacted.Beneficiary.Personal.Name)
+	require.Equal(t, "1/"+DefaultRedactPolicy.hashName("Jane Doe"), redacted.OrderingCustomer.CoverPayment.SwiftLineTwo)
+	require.Equal(t, DefaultRedactPolicy.hashName("John Doe"), redacted.BeneficiaryCustomer.CoverPayment.SwiftLineTwo)
+
+	// addresses are dropped
+	require.Empty(t, redacted.Beneficiary.Personal.Address.AddressLineOne)
+	require.Empty(t, redacted.OrderingCustomer.CoverPayment.SwiftLineThree)
+	require.Empty(t, redacted.BeneficiaryCustomer.CoverPayment.SwiftLineThree)
+
+	// financial institutions are kept
+	require.Equal(t, fwm.BeneficiaryFI, redacted.BeneficiaryFI)
+	require.Equal(t, fwm.OrderingInstitution, redacted.OrderingInstitution)
+
+	// free text keeps the structure of its lines
+	require.Equal(t, redactedText, redacted.OriginatorToBeneficiary.LineOne)
+	require.Equal(t, redactedText, redacted.Remittance.CoverPayment.SwiftLineOne)
+	require.Empty(t, redacted.Remittance.CoverPayment.SwiftLineTwo)
+	require.Equal(t, "/ACC/"+redactedText, redacted.SenderToReceiver.CoverPayment.SwiftLineOne)
+	require.Equal(t, "//"+redactedText, redacted.SenderToReceiver.CoverPayment.SwiftLineTwo)
+	require.Equal(t, redactedText, redacted.FIAdditionalFIToFI.AdditionalFIToFI.LineOne)
+	require.Equal(t, fwm.FIReceiverFI == nil, redacted.FIReceiverFI == nil)
+
+	require.NoError(t, redacted.Validate())
+	require.Equal(t, "Name", fwm.Beneficiary.Personal.Name)
+	require.Equal(t, "123456789", fwm.Beneficiary.Personal.Identifier)
+}
+
+// TestFEDWireMessage_RedactParses writes redacted messages which read back
+func TestFEDWireMessage_RedactParses(t *testing.T) {
+	for _, name := range []string{
+		"fedWireMessage-CustomerTransfer.txt",
+		"fedWireMessage-CustomerTransferPlusCOVS.txt",
+		"fedWireMessage-CustomerTransferPlusStructuredRemittance.txt",
+		"fedWireMessage-CustomerTransferPlusUnstructuredAddenda.txt",
+		"fedWireMessage-BankTransfer.txt",
+	} {
+		fwm := readFEDWireMessage(t, name)
+		f := NewFile()
+		f.AddFEDWireMessage(fwm)
+		redacted := f.Redact(DefaultRedactPolicy)
+
+		var buf strings.Builder
+		require.NoError(t, NewWriter(&buf).Write(redacted), name)
+		read, err := NewReader(strings.NewReader(buf.String())).Read()
+		require.NoError(t, err, name)
+		require.Equal(t, redacted.FEDWireMessage.Fingerprint(), read.FEDWireMessage.Fingerprint(), name)
+		if fwm.Beneficiary != nil && fwm.Beneficiary.Personal.Address.AddressLineOne != "" {
+			require.NotContains(t, buf.String(), "{4200}"+fwm.Beneficiary.Personal.IdentificationCode+fwm.Beneficiary.Personal.Identifier, name)
+		}
+	}
+}
+
+// TestRedactPolicy redacts only the configured classes and hashes names with the key
+func TestRedactPolicy(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransferPlusStructuredRemittance.txt")
+
+	redacted := fwm.Redact(RedactPolicy{Addresses: true})
+	require.Equal(t, fwm.Originator.Personal.Name, redacted.Originator.Personal.Name)
+	require.Equal(t, fwm.Originator.Personal.Identifier, redacted.Originator.Personal.Identifier)
+	require.Empty(t, redacted.Originator.Personal.Address.AddressLineOne)
+	require.Empty(t, redacted.RemittanceOriginator.RemittanceData.TownName)
+	require.Equal(t, fwm.RemittanceOriginator.RemittanceData.Country, redacted.RemittanceOriginator.RemittanceData.Country)
+	require.Equal(t, fwm.OriginatorToBeneficiary, redacted.OriginatorToBeneficiary)
+
+	keyed := fwm.Redact(RedactPolicy{Names: true, NameKey: []byte("secret")})
+	unkeyed := fwm.Redact(RedactPolicy{Names: true})
+	require.NotEqual(t, unkeyed.Originator.Personal.Name, keyed.Originator.Personal.Name)
+	require.Equal(t, keyed.Originator.Personal.Name, keyed.Beneficiary.Personal.Name)
+	require.Equal(t, keyed.Originator.Personal.Name, keyed.RemittanceOriginator.RemittanceData.Name)
+
+	redacted = fwm.Redact(RedactPolicy{Contacts: true, Identifiers: true})
+	require.Empty(t, redacted.RemittanceOriginator.ContactPhoneNumber)
+	require.Equal(t, "XX1111", redacted.RemittanceOriginator.IdentificationNumber)
+	require.Equal(t, fwm.RemittanceOriginator.ContactName, redacted.RemittanceOriginator.ContactName)
+}
+
+// TestRedactFields covers every account and identifier of people and businesses, while the identifiers of
+// financial institutions are kept
+func TestRedactFields(t *testing.T) {
+	for _, column := range csvColumns {
+		field := strings.ToLower(column.name[strings.LastIndex(column.name, ".")+1:])
+		isIdentifier := field == "identifier" || field == "partyidentifier" || field == "identificationnumber" ||
+			strings.HasSuffix(field, "accountnumber")
+		if !isIdentifier || strings.Contains(column.name, ".financialInstitution.") {
+			continue
+		}
+		require.Equal(t, redactIdentifier, redactClassOf(column.name), column.name)
+	}
+
+	fwm := readFEDWireMessage(t, "fedWireMessage-BankDrawDownRequest.txt")
+	redacted := fwm.Redact(DefaultRedactPolicy)
+	require.Equal(t, maskIdentifier(fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber), redacted.AccountCreditedDrawdown.DrawdownCreditAccountNumber)
+	require.NotEqual(t, fwm.AccountCreditedDrawdown.DrawdownCreditAccountNumber, redacted.AccountCreditedDrawdown.DrawdownCreditAccountNumber)
+}
+
+// TestRedactFreeTextAndContacts covers the lines of every {6xxx} FI to FI and advice tag, and every contact field
+func TestRedactFreeTextAndContacts(t *testing.T) {
+	for _, info := range Fields() {
+		field := strings.ToLower(info.Path[strings.LastIndex(info.Path, ".")+1:])
+		if strings.HasPrefix(info.Tag, "{6") && (strings.HasPrefix(field, "line") || field == "additional") {
+			require.Equal(t, redactFreeText, redactClassOf(info.Path), info.Path)
+		}
+		isContact := strings.Contains(field, "phone") || strings.Contains(field, "fax") ||
+			strings.Contains(field, "electronicaddress") || strings.Contains(field, "elctronicaddress") ||
+			field == "contactother"
+		if isContact {
+			require.Equal(t, redactContact, redactClassOf(info.Path), info.Path)
+		}
+	}
+
+	fwm := readFEDWireMessage(t, "fedWireMessage-BankDrawDownRequest.txt")
+	fwm.FIDrawdownDebitAccountAdvice = NewFIDrawdownDebitAccountAdvice()
+	fwm.FIDrawdownDebitAccountAdvice.Advice.AdviceCode = AdviceCodeLetter
+	fwm.FIDrawdownDebitAccountAdvice.Advice.LineOne = "JOHN SMITH SECRET"
+	fwm.RelatedRemittance = NewRelatedRemittance()
+	fwm.RelatedRemittance.RemittanceLocationElectronicAddress = "john@example.com"
+	redacted := fwm.Redact(DefaultRedactPolicy)
+	require.Equal(t, redactedText, redacted.FIDrawdownDebitAccountAdvice.Advice.LineOne)
+	require.Equal(t, AdviceCodeLetter, redacted.FIDrawdownDebitAccountAdvice.Advice.AdviceCode)
+	require.Empty(t, redacted.RelatedRemittance.RemittanceLocationElectronicAddress)
+}
+
+// TestRedactTag redacts a tag in the Fedwire format
+func TestRedactTag(t *testing.T) {
+	ben := mockBeneficiary()
+	ben.Personal.Identifier = "123456789"
+	s, err := RedactTag(ben.String(), DefaultRedactPolicy)
+	require.NoError(t, err)
+	require.Equal(t, "{4200}"+ben.Personal.IdentificationCode+"XXXXX6789*"+DefaultRedactPolicy.hashName(ben.Personal.Name)+"*", s)
+
+	s, err = RedactTag(mockAmount().String(), DefaultRedactPolicy)
+	require.NoError(t, err)
+	require.Equal(t, mockAmount().String(), s)
+
+	_, err = RedactTag("{9999}Unknown", DefaultRedactPolicy)
+	require.Error(t, err)
+}
+
+// TestMaskIdentifier keeps the last 4 characters, separators and any code before a slash
+func TestMaskIdentifier(t *testing.T) {
+	require.Equal(t, "XXXXX6789", maskIdentifier("123456789"))
+	require.Equal(t, "TXID/XXX-XX-6789", maskIdentifier("TXID/123-45-6789"))
+	require.Equal(t, "/XXXX5678", maskIdentifier("/12345678"))
+	require.Equal(t, "XXXX", maskIdentifier("1234"))
+	require.Equal(t, "US/XXX", maskIdentifier("US/123"))
+}
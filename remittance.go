@@ -107,6 +107,9 @@ func (ri *Remittance) Validate() error {
 	if err := ri.isAlphanumeric(ri.CoverPayment.SwiftLineFour); err != nil {
 		return fieldError("SwiftLineFour", err, ri.CoverPayment.SwiftLineFour)
 	}
+	if err := ri.validateCoverPayment(TagRemittance, ri.CoverPayment); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4,6 +4,11 @@
 
 package wire
 
+import "golang.org/x/text/currency"
+
+// remittanceAmountScale is the most decimal places of a RemittanceAmount
+const remittanceAmountScale = 5
+
 // RemittanceAmount is remittance amount
 type RemittanceAmount struct {
 	// CurrencyCode
@@ -11,3 +16,25 @@ type RemittanceAmount struct {
 	// Amount Must contain at least one numeric character and only one decimal period marker (e.g., $1,234.56 should be entered as 1234.56). Can have up to 5 numeric characters following the decimal period marker (e.g., 1234.56789). Amount must be greater than zero (i.e., at least .01).
 	Amount string `json:"amount,omitempty"`
 }
+
+// Money returns the remittance amount, e.g. USD and 1234.56 is USD 1234.56
+func (ra *RemittanceAmount) Money() (Money, error) {
+	return ParseMoney(ra.CurrencyCode, ra.Amount)
+}
+
+// SetMoney sets CurrencyCode and Amount, formatting Amount with a period decimal marker and at most five decimal
+// places, e.g. 1234.56
+func (ra *RemittanceAmount) SetMoney(m Money) error {
+	if _, err := currency.ParseISO(m.Currency); err != nil {
+		return fieldError("CurrencyCode", ErrNonCurrencyCode, m.Currency)
+	}
+	d, err := m.fieldDecimal(remittanceAmountScale)
+	if err != nil {
+		return err
+	}
+	if s := d.String(); len(s) > 19 {
+		return fieldError("Amount", ErrMoneyRange, s)
+	}
+	ra.CurrencyCode, ra.Amount = m.Currency, d.String()
+	return nil
+}
@@ -10,7 +10,7 @@ import (
 // Remittance creates a Remittance
 func mockRemittance() *Remittance {
 	ri := NewRemittance()
-	ri.CoverPayment.SwiftFieldTag = "Swift Field Tag"
+	ri.CoverPayment.SwiftFieldTag = "70"
 	ri.CoverPayment.SwiftLineOne = "Swift Line One"
 	ri.CoverPayment.SwiftLineTwo = "Swift Line Two"
 	ri.CoverPayment.SwiftLineThree = "Swift Line Three"
@@ -117,3 +117,13 @@ func TestRemittanceTagError(t *testing.T) {
 
 	require.EqualError(t, ri.Validate(), fieldError("tag", ErrValidTagForType, ri.tag).Error())
 }
+
+// TestRemittanceSwiftFieldOption validates Remittance SwiftFieldTag is a SWIFT field option permitted for the tag
+func TestRemittanceSwiftFieldOption(t *testing.T) {
+	ri := mockRemittance()
+	ri.CoverPayment.SwiftFieldTag = "72"
+
+	err := ri.Validate()
+
+	require.EqualError(t, err, fieldError("SwiftFieldTag", ErrSwiftFieldOption, ri.CoverPayment.SwiftFieldTag).Error())
+}
@@ -0,0 +1,462 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// Remt001Namespace is the XML namespace of the supported remt.001 RemittanceAdvice version
+const Remt001Namespace = "urn:iso:std:iso:20022:tech:xsd:remt.001.001.04"
+
+// Remt001Document is an ISO 20022 remt.001 RemittanceAdvice document
+type Remt001Document struct {
+	XMLName          xml.Name                `xml:"urn:iso:std:iso:20022:tech:xsd:remt.001.001.04 Document"`
+	RemittanceAdvice Remt001RemittanceAdvice `xml:"RmtAdvc"`
+}
+
+// Remt001RemittanceAdvice is the RmtAdvc of a remt.001 document
+type Remt001RemittanceAdvice struct {
+	GroupHeader     ISOGroupHeader          `xml:"GrpHdr"`
+	RemittanceInfos []Remt001RemittanceInfo `xml:"RmtInf"`
+}
+
+// Remt001RemittanceInfo is the RmtInf of a remt.001 document, which holds the remittance of one payment
+type Remt001RemittanceInfo struct {
+	RemittanceID        string                    `xml:"RmtId,omitempty"`
+	Structured          []ISOStructuredRemittance `xml:"Strd,omitempty"`
+	OriginalPaymentInfo *Remt001OriginalPayment   `xml:"OrgnlPmtInf,omitempty"`
+}
+
+// Remt001OriginalPayment is the OrgnlPmtInf of a remt.001 document, which identifies the payment being remitted
+type Remt001OriginalPayment struct {
+	Reference string `xml:"Ref"`
+}
+
+// ISOStructuredRemittance is an ISO 20022 StructuredRemittanceInformation
+type ISOStructuredRemittance struct {
+	ReferredDocumentInfo     []ISOReferredDocumentInfo  `xml:"RfrdDocInf,omitempty"`
+	ReferredDocumentAmount   *ISOReferredDocumentAmount `xml:"RfrdDocAmt,omitempty"`
+	CreditorReferenceInfo    *ISOCreditorReferenceInfo  `xml:"CdtrRefInf,omitempty"`
+	Invoicer                 *ISOParty                  `xml:"Invcr,omitempty"`
+	Invoicee                 *ISOParty                  `xml:"Invcee,omitempty"`
+	AdditionalRemittanceInfo []string                   `xml:"AddtlRmtInf,omitempty"`
+}
+
+// ISOReferredDocumentInfo is the RfrdDocInf of an ISO 20022 structured remittance
+type ISOReferredDocumentInfo struct {
+	Type        *ISODocumentType `xml:"Tp,omitempty"`
+	Number      string           `xml:"Nb,omitempty"`
+	RelatedDate string           `xml:"RltdDt,omitempty"`
+}
+
+// ISODocumentType is the type and issuer of a referred document or creditor reference
+type ISODocumentType struct {
+	CodeOrProprietary ISOCode `xml:"CdOrPrtry"`
+	Issuer            string  `xml:"Issr,omitempty"`
+}
+
+// ISOReferredDocumentAmount is the RfrdDocAmt of an ISO 20022 structured remittance
+type ISOReferredDocumentAmount struct {
+	DuePayableAmount          *ISOAmount          `xml:"DuePyblAmt,omitempty"`
+	DiscountAppliedAmount     []ISODiscountAmount `xml:"DscntApldAmt,omitempty"`
+	AdjustmentAmountAndReason []ISOAdjustment     `xml:"AdjstmntAmtAndRsn,omitempty"`
+	RemittedAmount            *ISOAmount          `xml:"RmtdAmt,omitempty"`
+}
+
+// ISODiscountAmount is an ISO 20022 DiscountAmountAndType
+type ISODiscountAmount struct {
+	Type   *ISOCode  `xml:"Tp,omitempty"`
+	Amount ISOAmount `xml:"Amt"`
+}
+
+// ISOAdjustment is an ISO 20022 DocumentAdjustment
+type ISOAdjustment struct {
+	Amount               ISOAmount `xml:"Amt"`
+	CreditDebitIndicator string    `xml:"CdtDbtInd,omitempty"`
+	Reason               string    `xml:"Rsn,omitempty"`
+	AdditionalInfo       string    `xml:"AddtlInf,omitempty"`
+}
+
+// ISOCreditorReferenceInfo is the CdtrRefInf of an ISO 20022 structured remittance
+type ISOCreditorReferenceInfo struct {
+	Type      *ISODocumentType `xml:"Tp,omitempty"`
+	Reference string           `xml:"Ref,omitempty"`
+}
+
+// Remt001FromXML reads a remt.001 document
+func Remt001FromXML(bs []byte) (*Remt001Document, error) {
+	doc := &Remt001Document{}
+	if err := isoFromXML(bs, doc, "remt.001"); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// XML returns the remt.001 document as XML
+func (doc *Remt001Document) XML() ([]byte, error) {
+	return isoXML(doc)
+}
+
+// ToRemt001 converts the {8xxx} remittance tags of a message into a remt.001 RemittanceAdvice.
+//
+// The structured remittance tags are mapped as in ToISOStructuredRemittance. {8250} RelatedRemittance
+// RemittanceIdentification is the remittance identifier and {1520} IMAD the original payment reference.
+func (fwm *FEDWireMessage) ToRemt001() (*Remt001Document, error) {
+	strd := fwm.ToISOStructuredRemittance()
+	if strd == nil && fwm.RelatedRemittance == nil {
+		return nil, fieldError("RemittanceOriginator", ErrFieldRequired)
+	}
+	rmt := Remt001RemittanceInfo{
+		OriginalPaymentInfo: &Remt001OriginalPayment{Reference: fwm.isoMessageID()},
+	}
+	if strd != nil {
+		rmt.Structured = []ISOStructuredRemittance{*strd}
+	}
+	if fwm.RelatedRemittance != nil {
+		rmt.RemittanceID = strings.TrimSpace(fwm.RelatedRemittance.RemittanceIdentification)
+	}
+	doc := &Remt001Document{
+		RemittanceAdvice: Remt001RemittanceAdvice{
+			GroupHeader: ISOGroupHeader{
+				MessageID:            fwm.isoMessageID(),
+				CreationDateTime:     isoCreationDateTime(),
+				NumberOfTransactions: "1",
+			},
+			RemittanceInfos: []Remt001RemittanceInfo{rmt},
+		},
+	}
+	return doc, nil
+}
+
+// FEDWireMessageFromRemt001 converts a remt.001 RemittanceAdvice into the {8xxx} remittance tags.
+//
+// The document must hold exactly one remittance with at most one structured remittance. The returned message holds
+// {1520} IMAD of the original payment, {8250} RelatedRemittance with the remittance identifier and the structured
+// remittance tags; callers copy these tags onto a CTP message.
+func FEDWireMessageFromRemt001(doc *Remt001Document) (*FEDWireMessage, error) {
+	if doc == nil {
+		return nil, fieldError("Document", ErrISODocument)
+	}
+	advc := doc.RemittanceAdvice
+	if n := len(advc.RemittanceInfos); n != 1 {
+		return nil, fieldError("RmtInf", ErrISOTransactionCount, n)
+	}
+	rmt := advc.RemittanceInfos[0]
+	if n := len(rmt.Structured); n > 1 {
+		return nil, fieldError("Strd", ErrISOTransactionCount, n)
+	}
+
+	fwm := &FEDWireMessage{}
+	if rmt.OriginalPaymentInfo != nil {
+		fwm.setIMADFromISO(rmt.OriginalPaymentInfo.Reference, "")
+	}
+	if rmt.RemittanceID != "" {
+		fwm.RelatedRemittance = NewRelatedRemittance()
+		fwm.RelatedRemittance.RemittanceIdentification = rmt.RemittanceID
+	}
+	if len(rmt.Structured) == 1 {
+		fwm.SetFromISOStructuredRemittance(&rmt.Structured[0])
+	}
+	return fwm, nil
+}
+
+// ToISOStructuredRemittance maps the structured remittance tags of a message into an ISO 20022 RmtInf/Strd.
+//
+// {8300} RemittanceOriginator is the invoicee and {8350} RemittanceBeneficiary the invoicer. {8400}
+// PrimaryRemittanceDocument with {8650} DateRemittanceDocument is the referred document. {8450} ActualAmountPaid is
+// the remitted amount, {8500} GrossAmountRemittanceDocument the due payable amount, {8550} AmountNegotiatedDiscount
+// the discount applied and {8600} Adjustment the adjustment amount and reason. {8700} SecondaryRemittanceDocument is
+// the creditor reference and {8750} RemittanceFreeText the additional remittance information. It returns nil when
+// the message has none of these tags.
+func (fwm *FEDWireMessage) ToISOStructuredRemittance() *ISOStructuredRemittance {
+	strd := &ISOStructuredRemittance{}
+	found := false
+	if ro := fwm.RemittanceOriginator; ro != nil {
+		strd.Invoicee = isoRemittanceParty(ro.IdentificationType, ro.IdentificationCode, ro.IdentificationNumber, ro.IdentificationNumberIssuer, ro.RemittanceData)
+		if contact := isoRemittanceContact(ro); contact != nil {
+			strd.Invoicee.ContactDetails = contact
+		}
+		found = true
+	}
+	if rb := fwm.RemittanceBeneficiary; rb != nil {
+		strd.Invoicer = isoRemittanceParty(rb.IdentificationType, rb.IdentificationCode, rb.IdentificationNumber, rb.IdentificationNumberIssuer, rb.RemittanceData)
+		found = true
+	}
+	if prd := fwm.PrimaryRemittanceDocument; prd != nil {
+		strd.ReferredDocumentInfo = []ISOReferredDocumentInfo{{
+			Type:   isoDocumentType(prd.DocumentTypeCode, prd.ProprietaryDocumentTypeCode, prd.Issuer),
+			Number: strings.TrimSpace(prd.DocumentIdentificationNumber),
+		}}
+		found = true
+	}
+	if fwm.DateRemittanceDocument != nil {
+		if len(strd.ReferredDocumentInfo) == 0 {
+			strd.ReferredDocumentInfo = []ISOReferredDocumentInfo{{}}
+		}
+		strd.ReferredDocumentInfo[0].RelatedDate = isoDateFromCCYYMMDD(fwm.DateRemittanceDocument.DateRemittanceDocument)
+		found = true
+	}
+
+	amt := &ISOReferredDocumentAmount{}
+	if fwm.ActualAmountPaid != nil {
+		amt.RemittedAmount = isoRemittanceAmount(fwm.ActualAmountPaid.RemittanceAmount)
+	}
+	if fwm.GrossAmountRemittanceDocument != nil {
+		amt.DuePayableAmount = isoRemittanceAmount(fwm.GrossAmountRemittanceDocument.RemittanceAmount)
+	}
+	if fwm.AmountNegotiatedDiscount != nil {
+		amt.DiscountAppliedAmount = []ISODiscountAmount{{Amount: *isoRemittanceAmount(fwm.AmountNegotiatedDiscount.RemittanceAmount)}}
+	}
+	if adj := fwm.Adjustment; adj != nil {
+		amt.AdjustmentAmountAndReason = []ISOAdjustment{{
+			Amount:               *isoRemittanceAmount(adj.RemittanceAmount),
+			CreditDebitIndicator: adj.CreditDebitIndicator,
+			Reason:               adj.AdjustmentReasonCode,
+			AdditionalInfo:       strings.TrimSpace(adj.AdditionalInfo),
+		}}
+	}
+	if amt.RemittedAmount != nil || amt.DuePayableAmount != nil || len(amt.DiscountAppliedAmount) > 0 || len(amt.AdjustmentAmountAndReason) > 0 {
+		strd.ReferredDocumentAmount = amt
+		found = true
+	}
+
+	if srd := fwm.SecondaryRemittanceDocument; srd != nil {
+		strd.CreditorReferenceInfo = &ISOCreditorReferenceInfo{
+			Type:      isoDocumentType(srd.DocumentTypeCode, srd.ProprietaryDocumentTypeCode, srd.Issuer),
+			Reference: strings.TrimSpace(srd.DocumentIdentificationNumber),
+		}
+		found = true
+	}
+	if rft := fwm.RemittanceFreeText; rft != nil {
+		strd.AdditionalRemittanceInfo = nonEmpty(rft.LineOne, rft.LineTwo, rft.LineThree)
+		found = true
+	}
+	if !found {
+		return nil
+	}
+	return strd
+}
+
+// SetFromISOStructuredRemittance populates the structured remittance tags of a message from an ISO 20022
+// RmtInf/Strd, it is the inverse of ToISOStructuredRemittance. Only the first referred document, discount and
+// adjustment are mapped, Fedwire has a single tag for each.
+func (fwm *FEDWireMessage) SetFromISOStructuredRemittance(strd *ISOStructuredRemittance) {
+	if strd == nil {
+		return
+	}
+	if strd.Invoicee != nil {
+		ro := NewRemittanceOriginator()
+		ro.IdentificationType, ro.IdentificationCode, ro.IdentificationNumber, ro.IdentificationNumberIssuer, ro.RemittanceData = remittancePartyFromISO(strd.Invoicee)
+		if c := strd.Invoicee.ContactDetails; c != nil {
+			ro.ContactName, ro.ContactPhoneNumber, ro.ContactMobileNumber = c.Name, c.PhoneNumber, c.MobileNumber
+			ro.ContactFaxNumber, ro.ContactElectronicAddress, ro.ContactOther = c.FaxNumber, c.EmailAddress, c.Other
+		}
+		fwm.RemittanceOriginator = ro
+	}
+	if strd.Invoicer != nil {
+		rb := NewRemittanceBeneficiary()
+		rb.IdentificationType, rb.IdentificationCode, rb.IdentificationNumber, rb.IdentificationNumberIssuer, rb.RemittanceData = remittancePartyFromISO(strd.Invoicer)
+		fwm.RemittanceBeneficiary = rb
+	}
+	if len(strd.ReferredDocumentInfo) > 0 {
+		doc := strd.ReferredDocumentInfo[0]
+		if doc.Type != nil || doc.Number != "" {
+			prd := NewPrimaryRemittanceDocument()
+			prd.DocumentTypeCode, prd.ProprietaryDocumentTypeCode, prd.Issuer = documentTypeFromISO(doc.Type)
+			prd.DocumentIdentificationNumber = doc.Number
+			fwm.PrimaryRemittanceDocument = prd
+		}
+		if doc.RelatedDate != "" {
+			fwm.DateRemittanceDocument = NewDateRemittanceDocument()
+			fwm.DateRemittanceDocument.DateRemittanceDocument = ccyymmddFromISODate(doc.RelatedDate)
+		}
+	}
+	if amt := strd.ReferredDocumentAmount; amt != nil {
+		if amt.RemittedAmount != nil {
+			fwm.ActualAmountPaid = NewActualAmountPaid()
+			fwm.ActualAmountPaid.RemittanceAmount = remittanceAmountFromISO(*amt.RemittedAmount)
+		}
+		if amt.DuePayableAmount != nil {
+			fwm.GrossAmountRemittanceDocument = NewGrossAmountRemittanceDocument()
+			fwm.GrossAmountRemittanceDocument.RemittanceAmount = remittanceAmountFromISO(*amt.DuePayableAmount)
+		}
+		if len(amt.DiscountAppliedAmount) > 0 {
+			fwm.AmountNegotiatedDiscount = NewAmountNegotiatedDiscount()
+			fwm.AmountNegotiatedDiscount.RemittanceAmount = remittanceAmountFromISO(amt.DiscountAppliedAmount[0].Amount)
+		}
+		if len(amt.AdjustmentAmountAndReason) > 0 {
+			a := amt.AdjustmentAmountAndReason[0]
+			fwm.Adjustment = NewAdjustment()
+			fwm.Adjustment.RemittanceAmount = remittanceAmountFromISO(a.Amount)
+			fwm.Adjustment.CreditDebitIndicator = a.CreditDebitIndicator
+			fwm.Adjustment.AdjustmentReasonCode = a.Reason
+			fwm.Adjustment.AdditionalInfo = a.AdditionalInfo
+		}
+	}
+	if ref := strd.CreditorReferenceInfo; ref != nil {
+		srd := NewSecondaryRemittanceDocument()
+		srd.DocumentTypeCode, srd.ProprietaryDocumentTypeCode, srd.Issuer = documentTypeFromISO(ref.Type)
+		srd.DocumentIdentificationNumber = ref.Reference
+		fwm.SecondaryRemittanceDocument = srd
+	}
+	if lines := nonEmpty(strd.AdditionalRemittanceInfo...); len(lines) > 0 {
+		rft := NewRemittanceFreeText()
+		setLines([]*string{&rft.LineOne, &rft.LineTwo, &rft.LineThree}, lines)
+		fwm.RemittanceFreeText = rft
+	}
+}
+
+// isoRemittanceParty maps the identification and RemittanceData of {8300} or {8350} into an ISO party. The Fedwire
+// identification codes are used as the ISO scheme name codes, SWIFT BIC or BEI (SWBB) is the organisation's BIC.
+func isoRemittanceParty(idType, code, number, issuer string, data RemittanceData) *ISOParty {
+	party := &ISOParty{
+		Name:               strings.TrimSpace(data.Name),
+		PostalAddress:      isoRemittancePostalAddress(data),
+		CountryOfResidence: strings.TrimSpace(data.CountryOfResidence),
+	}
+	id := ISOGenericID{ID: strings.TrimSpace(number), SchemeName: &ISOCode{Code: code}, Issuer: strings.TrimSpace(issuer)}
+	switch {
+	case idType == OrganizationID && code == OICSWIFTBICORBEI:
+		party.ID = &ISOPartyID{OrganisationID: &ISOOrganisationID{AnyBIC: id.ID}}
+	case idType == OrganizationID:
+		party.ID = &ISOPartyID{OrganisationID: &ISOOrganisationID{Other: []ISOGenericID{id}}}
+	case idType == PrivateID && code == PICDateBirthPlace:
+		id.ID = strings.TrimSpace(data.DateBirthPlace)
+		party.ID = &ISOPartyID{PrivateID: &ISOPersonID{Other: []ISOGenericID{id}}}
+	case idType == PrivateID:
+		party.ID = &ISOPartyID{PrivateID: &ISOPersonID{Other: []ISOGenericID{id}}}
+	}
+	return party
+}
+
+// remittancePartyFromISO is the inverse of isoRemittanceParty
+func remittancePartyFromISO(party *ISOParty) (idType, code, number, issuer string, data RemittanceData) {
+	data = remittanceDataFromISOPostalAddress(party.PostalAddress)
+	data.Name = party.Name
+	data.CountryOfResidence = party.CountryOfResidence
+	if party.ID == nil {
+		return "", "", "", "", data
+	}
+	var other []ISOGenericID
+	if org := party.ID.OrganisationID; org != nil {
+		idType = OrganizationID
+		if org.AnyBIC != "" {
+			return idType, OICSWIFTBICORBEI, org.AnyBIC, "", data
+		}
+		other = org.Other
+	}
+	if prvt := party.ID.PrivateID; prvt != nil {
+		idType = PrivateID
+		other = prvt.Other
+	}
+	if len(other) == 0 {
+		return idType, "", "", "", data
+	}
+	id := other[0]
+	if id.SchemeName != nil {
+		code = id.SchemeName.Code
+		if code == "" {
+			code = id.SchemeName.Proprietary
+		}
+	}
+	if code == PICDateBirthPlace {
+		data.DateBirthPlace = id.ID
+		return idType, code, "", "", data
+	}
+	return idType, code, id.ID, id.Issuer, data
+}
+
+// isoRemittancePostalAddress maps the structured address of a RemittanceData into an ISO postal address
+func isoRemittancePostalAddress(data RemittanceData) *ISOPostalAddress {
+	pa := &ISOPostalAddress{
+		Department:         strings.TrimSpace(data.Department),
+		SubDepartment:      strings.TrimSpace(data.SubDepartment),
+		StreetName:         strings.TrimSpace(data.StreetName),
+		BuildingNumber:     strings.TrimSpace(data.BuildingNumber),
+		PostCode:           strings.TrimSpace(data.PostCode),
+		TownName:           strings.TrimSpace(data.TownName),
+		CountrySubDivision: strings.TrimSpace(data.CountrySubDivisionState),
+		Country:            strings.TrimSpace(data.Country),
+		AddressLines: nonEmpty(data.AddressLineOne, data.AddressLineTwo, data.AddressLineThree, data.AddressLineFour,
+			data.AddressLineFive, data.AddressLineSix, data.AddressLineSeven),
+	}
+	if data.AddressType != "" {
+		pa.AddressType = &ISOCode{Code: strings.TrimSpace(data.AddressType)}
+	}
+	if pa.AddressType == nil && len(pa.AddressLines) == 0 && pa.Department == "" && pa.SubDepartment == "" &&
+		pa.StreetName == "" && pa.BuildingNumber == "" && pa.PostCode == "" && pa.TownName == "" &&
+		pa.CountrySubDivision == "" && pa.Country == "" {
+		return nil
+	}
+	return pa
+}
+
+// remittanceDataFromISOPostalAddress is the inverse of isoRemittancePostalAddress
+func remittanceDataFromISOPostalAddress(pa *ISOPostalAddress) RemittanceData {
+	var data RemittanceData
+	if pa == nil {
+		return data
+	}
+	if pa.AddressType != nil {
+		data.AddressType = pa.AddressType.Code
+	}
+	data.Department, data.SubDepartment, data.StreetName = pa.Department, pa.SubDepartment, pa.StreetName
+	data.BuildingNumber, data.PostCode, data.TownName = pa.BuildingNumber, pa.PostCode, pa.TownName
+	data.CountrySubDivisionState, data.Country = pa.CountrySubDivision, pa.Country
+	setLines([]*string{&data.AddressLineOne, &data.AddressLineTwo, &data.AddressLineThree, &data.AddressLineFour,
+		&data.AddressLineFive, &data.AddressLineSix, &data.AddressLineSeven}, pa.AddressLines)
+	return data
+}
+
+// isoRemittanceContact maps the contact details of {8300} RemittanceOriginator, it returns nil without contact details
+func isoRemittanceContact(ro *RemittanceOriginator) *ISOContact {
+	c := &ISOContact{
+		Name:         strings.TrimSpace(ro.ContactName),
+		PhoneNumber:  strings.TrimSpace(ro.ContactPhoneNumber),
+		MobileNumber: strings.TrimSpace(ro.ContactMobileNumber),
+		FaxNumber:    strings.TrimSpace(ro.ContactFaxNumber),
+		EmailAddress: strings.TrimSpace(ro.ContactElectronicAddress),
+		Other:        strings.TrimSpace(ro.ContactOther),
+	}
+	if *c == (ISOContact{}) {
+		return nil
+	}
+	return c
+}
+
+// isoDocumentType maps a Fedwire document type code into an ISO document type, a proprietary document type (PROP)
+// is the proprietary code.
+func isoDocumentType(code, proprietary, issuer string) *ISODocumentType {
+	t := &ISODocumentType{Issuer: strings.TrimSpace(issuer)}
+	if code == ProprietaryDocumentType {
+		t.CodeOrProprietary.Proprietary = strings.TrimSpace(proprietary)
+	} else {
+		t.CodeOrProprietary.Code = code
+	}
+	return t
+}
+
+// documentTypeFromISO is the inverse of isoDocumentType
+func documentTypeFromISO(t *ISODocumentType) (code, proprietary, issuer string) {
+	if t == nil {
+		return "", "", ""
+	}
+	if t.CodeOrProprietary.Proprietary != "" {
+		return ProprietaryDocumentType, t.CodeOrProprietary.Proprietary, t.Issuer
+	}
+	return t.CodeOrProprietary.Code, "", t.Issuer
+}
+
+// isoRemittanceAmount maps a Fedwire remittance amount into an ISO amount
+func isoRemittanceAmount(ra RemittanceAmount) *ISOAmount {
+	return &ISOAmount{Currency: ra.CurrencyCode, Value: strings.TrimSpace(ra.Amount)}
+}
+
+// remittanceAmountFromISO is the inverse of isoRemittanceAmount
+func remittanceAmountFromISO(amt ISOAmount) RemittanceAmount {
+	return RemittanceAmount{CurrencyCode: amt.Currency, Amount: amt.Value}
+}
@@ -0,0 +1,111 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestRemt001_StructuredRemittance converts the structured remittance tags into remt.001 and back
+func TestRemt001_StructuredRemittance(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransferPlusStructuredRemittance.txt")
+
+	doc, err := fwm.ToRemt001()
+	require.NoError(t, err)
+	rmt := doc.RemittanceAdvice.RemittanceInfos[0]
+	require.Equal(t, "20190509Source08000001", rmt.OriginalPaymentInfo.Reference)
+	strd := rmt.Structured[0]
+	require.Equal(t, "Name", strd.Invoicee.Name)
+	require.Equal(t, "CUST", strd.Invoicee.ID.OrganisationID.Other[0].SchemeName.Code)
+	require.Equal(t, "Contact Other", strd.Invoicee.ContactDetails.Other)
+	require.Equal(t, "AROI", strd.ReferredDocumentInfo[0].Type.CodeOrProprietary.Code)
+	require.Equal(t, "2019-05-09", strd.ReferredDocumentInfo[0].RelatedDate)
+	require.Equal(t, "1234.56", strd.ReferredDocumentAmount.RemittedAmount.Value)
+	require.Equal(t, "CRDT", strd.ReferredDocumentAmount.AdjustmentAmountAndReason[0].CreditDebitIndicator)
+	require.Equal(t, "222222", strd.CreditorReferenceInfo.Reference)
+
+	bs, err := doc.XML()
+	require.NoError(t, err)
+	read, err := Remt001FromXML(bs)
+	require.NoError(t, err)
+
+	out, err := FEDWireMessageFromRemt001(read)
+	require.NoError(t, err)
+	require.Equal(t, fwm.InputMessageAccountabilityData.String(), out.InputMessageAccountabilityData.String())
+	require.Equal(t, fwm.RemittanceOriginator.String(), out.RemittanceOriginator.String())
+	require.Equal(t, fwm.RemittanceBeneficiary.String(), out.RemittanceBeneficiary.String())
+	require.Equal(t, fwm.PrimaryRemittanceDocument.String(), out.PrimaryRemittanceDocument.String())
+	require.Equal(t, fwm.ActualAmountPaid.String(), out.ActualAmountPaid.String())
+	require.Equal(t, fwm.GrossAmountRemittanceDocument.String(), out.GrossAmountRemittanceDocument.String())
+	require.Equal(t, fwm.AmountNegotiatedDiscount.String(), out.AmountNegotiatedDiscount.String())
+	require.Equal(t, fwm.Adjustment.String(), out.Adjustment.String())
+	require.Equal(t, fwm.DateRemittanceDocument.String(), out.DateRemittanceDocument.String())
+	require.Equal(t, fwm.SecondaryRemittanceDocument.String(), out.SecondaryRemittanceDocument.String())
+	require.Equal(t, fwm.RemittanceFreeText.String(), out.RemittanceFreeText.String())
+	require.Nil(t, out.RelatedRemittance)
+}
+
+// TestRemt001_RelatedRemittance uses {8250} RelatedRemittance as the remt.001 remittance identifier
+func TestRemt001_RelatedRemittance(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	fwm.RelatedRemittance = NewRelatedRemittance()
+	fwm.RelatedRemittance.RemittanceIdentification = "Remittance Identification"
+
+	doc, err := fwm.ToRemt001()
+	require.NoError(t, err)
+	rmt := doc.RemittanceAdvice.RemittanceInfos[0]
+	require.Equal(t, fwm.RelatedRemittance.RemittanceIdentification, rmt.RemittanceID)
+	require.Empty(t, rmt.Structured)
+
+	out, err := FEDWireMessageFromRemt001(doc)
+	require.NoError(t, err)
+	require.Equal(t, fwm.RelatedRemittance.RemittanceIdentification, out.RelatedRemittance.RemittanceIdentification)
+	require.Nil(t, fwm.ToISOStructuredRemittance())
+}
+
+// TestRemt001_FromXML reads a remt.001 with a proprietary document type and a date of birth
+func TestRemt001_FromXML(t *testing.T) {
+	bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", "remt001-StructuredRemittance.xml"))
+	require.NoError(t, err)
+	doc, err := Remt001FromXML(bs)
+	require.NoError(t, err)
+
+	fwm, err := FEDWireMessageFromRemt001(doc)
+	require.NoError(t, err)
+	require.Equal(t, "REMIT-0001", fwm.RelatedRemittance.RemittanceIdentification)
+	require.Equal(t, PrivateID, fwm.RemittanceOriginator.IdentificationType)
+	require.Equal(t, PICDateBirthPlace, fwm.RemittanceOriginator.IdentificationCode)
+	require.Equal(t, "19800101 ANYTOWN US", fwm.RemittanceOriginator.RemittanceData.DateBirthPlace)
+	require.Equal(t, OrganizationID, fwm.RemittanceBeneficiary.IdentificationType)
+	require.Equal(t, OICSWIFTBICORBEI, fwm.RemittanceBeneficiary.IdentificationCode)
+	require.Equal(t, "MOOVUS33", fwm.RemittanceBeneficiary.IdentificationNumber)
+	require.Equal(t, ProprietaryDocumentType, fwm.PrimaryRemittanceDocument.DocumentTypeCode)
+	require.Equal(t, "PO", fwm.PrimaryRemittanceDocument.ProprietaryDocumentTypeCode)
+	require.Equal(t, "99.50", fwm.ActualAmountPaid.RemittanceAmount.Amount)
+	require.Equal(t, "20200115", fwm.DateRemittanceDocument.DateRemittanceDocument)
+	require.NoError(t, fwm.RemittanceOriginator.Validate())
+	require.NoError(t, fwm.RemittanceBeneficiary.Validate())
+	require.NoError(t, fwm.PrimaryRemittanceDocument.Validate())
+}
+
+// TestRemt001_Errors ensures messages without remittance tags and documents with several remittances are rejected
+func TestRemt001_Errors(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-BankTransfer.txt")
+	_, err := fwm.ToRemt001()
+	require.True(t, errors.Is(err, ErrFieldRequired))
+
+	doc := &Remt001Document{}
+	_, err = FEDWireMessageFromRemt001(doc)
+	require.True(t, errors.Is(err, ErrISOTransactionCount))
+
+	doc.RemittanceAdvice.RemittanceInfos = []Remt001RemittanceInfo{{Structured: make([]ISOStructuredRemittance, 2)}}
+	_, err = FEDWireMessageFromRemt001(doc)
+	require.True(t, errors.Is(err, ErrISOTransactionCount))
+}
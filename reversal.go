@@ -0,0 +1,232 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// NewReversalTransfer returns a reversal (SubTypeCode 02) of original, a basic funds transfer (SubTypeCode 00) which
+// settled in the cycle of imad, the IMAD of the reversal.
+//
+// The reversal is sent by the receiver of original back to its sender, so the sender and receiver depository
+// institutions, the originator and beneficiary, the originator and beneficiary FIs and the instructing and
+// beneficiary intermediary FIs are swapped. An originator in {5010} OriginatorOptionF is the beneficiary of the
+// reversal, with its party identifier, name and address lines mapped as for an MT103 field 50F. The amount, the
+// business function code, the type code and {4320} BeneficiaryReference are kept and the IMAD of original is
+// {3500} PreviousMessageIdentifier. Other tags, such as {3320} SenderReference and a reason in {6500}
+// FIAdditionalFIToFI, can be set on the returned message, which is validated.
+func NewReversalTransfer(original *FEDWireMessage, imad *InputMessageAccountabilityData) (*FEDWireMessage, error) {
+	return newReversal(original, imad, ReversalTransfer)
+}
+
+// NewReversalPriorDayTransfer returns a reversal of a prior day transfer (SubTypeCode 08) of original, a basic funds
+// transfer (SubTypeCode 00) which settled in a cycle before the cycle of imad, the IMAD of the reversal. The message
+// is populated as by NewReversalTransfer.
+func NewReversalPriorDayTransfer(original *FEDWireMessage, imad *InputMessageAccountabilityData) (*FEDWireMessage, error) {
+	return newReversal(original, imad, ReversalPriorDayTransfer)
+}
+
+// NewRequestReversal returns a request for reversal (SubTypeCode 01) of original, a basic funds transfer
+// (SubTypeCode 00) which settled in the cycle of imad, the IMAD of the request.
+//
+// The request is sent by the sender of original to its receiver, so the depository institutions, parties and FIs,
+// including the instructing and beneficiary intermediary FIs, are kept in place. The amount, the business function code, the type code and {4320} BeneficiaryReference are kept and
+// the IMAD of original is {3500} PreviousMessageIdentifier. Only CustomerTransferPlus permits a request for reversal,
+// other business function codes request a reversal with a {3600} SVC service message. The returned message is
+// validated.
+func NewRequestReversal(original *FEDWireMessage, imad *InputMessageAccountabilityData) (*FEDWireMessage, error) {
+	return newReversal(original, imad, RequestReversal)
+}
+
+// NewRequestReversalPriorDayTransfer returns a request for reversal of a prior day transfer (SubTypeCode 07) of
+// original, a basic funds transfer (SubTypeCode 00) which settled in a cycle before the cycle of imad, the IMAD of
+// the request. The message is populated as by NewRequestReversal.
+func NewRequestReversalPriorDayTransfer(original *FEDWireMessage, imad *InputMessageAccountabilityData) (*FEDWireMessage, error) {
+	return newReversal(original, imad, RequestReversalPriorDayTransfer)
+}
+
+// newReversal builds a reversal or request for reversal with subTypeCode of original
+func newReversal(original *FEDWireMessage, imad *InputMessageAccountabilityData, subTypeCode string) (*FEDWireMessage, error) {
+	if err := checkReversalOriginal(original); err != nil {
+		return nil, err
+	}
+	if err := checkReversalCycleDate(original.InputMessageAccountabilityData, imad, subTypeCode); err != nil {
+		return nil, err
+	}
+
+	fwm := &FEDWireMessage{}
+	fwm.SenderSupplied = NewSenderSupplied()
+	if original.SenderSupplied != nil {
+		fwm.SenderSupplied.UserRequestCorrelation = original.SenderSupplied.UserRequestCorrelation
+		fwm.SenderSupplied.TestProductionCode = original.SenderSupplied.TestProductionCode
+	}
+	fwm.TypeSubType = NewTypeSubType()
+	fwm.TypeSubType.TypeCode = original.TypeSubType.TypeCode
+	fwm.TypeSubType.SubTypeCode = subTypeCode
+	CloneTag(&fwm.InputMessageAccountabilityData, imad)
+	CloneTag(&fwm.Amount, original.Amount)
+	fwm.BusinessFunctionCode = NewBusinessFunctionCode()
+	fwm.BusinessFunctionCode.BusinessFunctionCode = original.BusinessFunctionCode.BusinessFunctionCode
+	fwm.PreviousMessageIdentifier = NewPreviousMessageIdentifier()
+	fwm.PreviousMessageIdentifier.PreviousMessageIdentifier = original.InputMessageAccountabilityData.IMAD()
+	if original.BeneficiaryReference != nil {
+		CloneTag(&fwm.BeneficiaryReference, original.BeneficiaryReference)
+	}
+
+	typeSubType := fwm.TypeSubType.TypeCode + fwm.TypeSubType.SubTypeCode
+	bfc := fwm.BusinessFunctionCode.BusinessFunctionCode
+	if !businessFunctionTypeSubTypes[bfc].Contains(typeSubType) {
+		return nil, fieldError("TypeSubType", NewErrBusinessFunctionCodeProperty("TypeSubType", typeSubType, bfc))
+	}
+
+	switch subTypeCode {
+	case ReversalTransfer, ReversalPriorDayTransfer:
+		if err := fwm.setReversedParties(original); err != nil {
+			return nil, err
+		}
+	default:
+		fwm.setRequestReversalParties(original)
+	}
+
+	if err := fwm.checkPreviousMessageIdentifier(); err != nil {
+		return nil, err
+	}
+	if err := fwm.Validate(); err != nil {
+		return nil, err
+	}
+	return fwm, nil
+}
+
+// checkReversalOriginal ensures original is a basic funds transfer with the tags a reversal is built from
+func checkReversalOriginal(original *FEDWireMessage) error {
+	if original == nil {
+		return fieldError("FEDWireMessage", ErrFieldRequired)
+	}
+	if original.TypeSubType == nil {
+		return fieldError("TypeSubType", ErrFieldRequired)
+	}
+	if original.TypeSubType.SubTypeCode != BasicFundsTransfer {
+		return fieldError("SubTypeCode", ErrReversalSubType, original.TypeSubType.SubTypeCode)
+	}
+	if original.InputMessageAccountabilityData == nil {
+		return fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+	}
+	if original.Amount == nil {
+		return fieldError("Amount", ErrFieldRequired)
+	}
+	if original.BusinessFunctionCode == nil {
+		return fieldError("BusinessFunctionCode", ErrFieldRequired)
+	}
+	if original.SenderDepositoryInstitution == nil {
+		return fieldError("SenderDepositoryInstitution", ErrFieldRequired)
+	}
+	if original.ReceiverDepositoryInstitution == nil {
+		return fieldError("ReceiverDepositoryInstitution", ErrFieldRequired)
+	}
+	return nil
+}
+
+// checkReversalCycleDate ensures the cycle date of imad is the cycle date of original for a same day reversal or
+// request, and after it for a prior day reversal or request
+func checkReversalCycleDate(original, imad *InputMessageAccountabilityData, subTypeCode string) error {
+	if imad == nil {
+		return fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+	}
+	originalDate, err := original.CycleDate()
+	if err != nil {
+		return err
+	}
+	date, err := imad.CycleDate()
+	if err != nil {
+		return err
+	}
+
+	switch subTypeCode {
+	case ReversalTransfer, RequestReversal:
+		if !date.Equal(originalDate) {
+			return fieldError("InputCycleDate", ErrReversalCycleDate, imad.InputCycleDate)
+		}
+	default:
+		if !date.After(originalDate) {
+			return fieldError("InputCycleDate", ErrReversalCycleDate, imad.InputCycleDate)
+		}
+	}
+	return nil
+}
+
+// setReversedParties populates a reversal with the depository institutions, parties and FIs of original swapped.
+// The {5200} InstructingFI of original is on the path of the reversal between its receiver and beneficiary FI, so
+// it is the {4000} BeneficiaryIntermediaryFI of the reversal, and the other way around.
+func (fwm *FEDWireMessage) setReversedParties(original *FEDWireMessage) error {
+	fwm.SenderDepositoryInstitution = NewSenderDepositoryInstitution()
+	fwm.SenderDepositoryInstitution.SenderABANumber = original.ReceiverDepositoryInstitution.ReceiverABANumber
+	fwm.SenderDepositoryInstitution.SenderShortName = original.ReceiverDepositoryInstitution.ReceiverShortName
+	fwm.ReceiverDepositoryInstitution = NewReceiverDepositoryInstitution()
+	fwm.ReceiverDepositoryInstitution.ReceiverABANumber = original.SenderDepositoryInstitution.SenderABANumber
+	fwm.ReceiverDepositoryInstitution.ReceiverShortName = original.SenderDepositoryInstitution.SenderShortName
+
+	if original.Beneficiary != nil {
+		fwm.Originator = NewOriginator()
+		fwm.Originator.Personal = original.Beneficiary.Personal
+	}
+	switch {
+	case original.OriginatorOptionF != nil:
+		oof := original.OriginatorOptionF
+		personal, unmapped := personalFromSwiftField(&SwiftField{
+			Tag:   "50F",
+			Lines: nonEmpty(oof.PartyIdentifier, oof.Name, oof.LineOne, oof.LineTwo, oof.LineThree),
+		})
+		if personal.IdentificationCode == "" || len(unmapped) > 0 {
+			return fieldError("OriginatorOptionF", ErrNotPermitted, oof.PartyIdentifier)
+		}
+		fwm.Beneficiary = NewBeneficiary()
+		fwm.Beneficiary.Personal = personal
+	case original.Originator != nil:
+		fwm.Beneficiary = NewBeneficiary()
+		fwm.Beneficiary.Personal = original.Originator.Personal
+	}
+	if original.BeneficiaryFI != nil {
+		fwm.OriginatorFI = NewOriginatorFI()
+		fwm.OriginatorFI.FinancialInstitution = original.BeneficiaryFI.FinancialInstitution
+	}
+	if original.OriginatorFI != nil {
+		fwm.BeneficiaryFI = NewBeneficiaryFI()
+		fwm.BeneficiaryFI.FinancialInstitution = original.OriginatorFI.FinancialInstitution
+	}
+	if original.BeneficiaryIntermediaryFI != nil {
+		fwm.InstructingFI = NewInstructingFI()
+		fwm.InstructingFI.FinancialInstitution = original.BeneficiaryIntermediaryFI.FinancialInstitution
+	}
+	if original.InstructingFI != nil {
+		fwm.BeneficiaryIntermediaryFI = NewBeneficiaryIntermediaryFI()
+		fwm.BeneficiaryIntermediaryFI.FinancialInstitution = original.InstructingFI.FinancialInstitution
+	}
+	return nil
+}
+
+// setRequestReversalParties populates a request for reversal with the depository institutions, parties and FIs of
+// original
+func (fwm *FEDWireMessage) setRequestReversalParties(original *FEDWireMessage) {
+	CloneTag(&fwm.SenderDepositoryInstitution, original.SenderDepositoryInstitution)
+	CloneTag(&fwm.ReceiverDepositoryInstitution, original.ReceiverDepositoryInstitution)
+	if original.Originator != nil {
+		CloneTag(&fwm.Originator, original.Originator)
+	}
+	if original.OriginatorOptionF != nil {
+		CloneTag(&fwm.OriginatorOptionF, original.OriginatorOptionF)
+	}
+	if original.Beneficiary != nil {
+		CloneTag(&fwm.Beneficiary, original.Beneficiary)
+	}
+	if original.OriginatorFI != nil {
+		CloneTag(&fwm.OriginatorFI, original.OriginatorFI)
+	}
+	if original.BeneficiaryFI != nil {
+		CloneTag(&fwm.BeneficiaryFI, original.BeneficiaryFI)
+	}
+	if original.BeneficiaryIntermediaryFI != nil {
+		CloneTag(&fwm.BeneficiaryIntermediaryFI, original.BeneficiaryIntermediaryFI)
+	}
+	if original.InstructingFI != nil {
+		CloneTag(&fwm.InstructingFI, original.InstructingFI)
+	}
+}
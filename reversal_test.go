@@ -0,0 +1,140 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockReversalIMAD creates an IMAD for a reversal in the cycle of cycleDate
+func mockReversalIMAD(cycleDate string) *InputMessageAccountabilityData {
+	imad := NewInputMessageAccountabilityData()
+	imad.InputCycleDate = cycleDate
+	imad.InputSource = "Source08"
+	imad.InputSequenceNumber = "000009"
+	return imad
+}
+
+// TestNewReversalTransfer swaps the parties of a bank transfer
+func TestNewReversalTransfer(t *testing.T) {
+	original := readFEDWireMessage(t, "fedWireMessage-BankTransfer.txt")
+
+	fwm, err := NewReversalTransfer(&original, mockReversalIMAD("20190410"))
+	require.NoError(t, err)
+	require.Equal(t, FundsTransfer, fwm.TypeSubType.TypeCode)
+	require.Equal(t, ReversalTransfer, fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, "20190410Source08000001", fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, "20190410Source08000009", fwm.InputMessageAccountabilityData.IMAD())
+	require.Equal(t, original.Amount.Amount, fwm.Amount.Amount)
+	require.Equal(t, BankTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+
+	require.Equal(t, original.ReceiverDepositoryInstitution.ReceiverABANumber, fwm.SenderDepositoryInstitution.SenderABANumber)
+	require.Equal(t, original.SenderDepositoryInstitution.SenderABANumber, fwm.ReceiverDepositoryInstitution.ReceiverABANumber)
+	require.Equal(t, original.Beneficiary.Personal, fwm.Originator.Personal)
+	require.Equal(t, original.Originator.Personal, fwm.Beneficiary.Personal)
+	require.Equal(t, original.BeneficiaryFI.FinancialInstitution, fwm.OriginatorFI.FinancialInstitution)
+	require.Equal(t, original.OriginatorFI.FinancialInstitution, fwm.BeneficiaryFI.FinancialInstitution)
+	require.Equal(t, original.BeneficiaryReference.BeneficiaryReference, fwm.BeneficiaryReference.BeneficiaryReference)
+	require.Equal(t, original.InstructingFI.FinancialInstitution, fwm.BeneficiaryIntermediaryFI.FinancialInstitution)
+	require.Equal(t, original.BeneficiaryIntermediaryFI.FinancialInstitution, fwm.InstructingFI.FinancialInstitution)
+	require.Nil(t, fwm.SenderReference)
+	require.NoError(t, fwm.Validate())
+
+	// the original is unchanged
+	require.Equal(t, BasicFundsTransfer, original.TypeSubType.SubTypeCode)
+	require.Equal(t, "121042882", original.SenderDepositoryInstitution.SenderABANumber)
+}
+
+// TestNewReversalPriorDayTransfer requires a cycle date after the original
+func TestNewReversalPriorDayTransfer(t *testing.T) {
+	original := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+
+	fwm, err := NewReversalPriorDayTransfer(&original, mockReversalIMAD("20190411"))
+	require.NoError(t, err)
+	require.Equal(t, ReversalPriorDayTransfer, fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, CustomerTransfer, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, original.Beneficiary.Personal, fwm.Originator.Personal)
+
+	_, err = NewReversalPriorDayTransfer(&original, mockReversalIMAD("20190410"))
+	require.True(t, errors.Is(err, ErrReversalCycleDate))
+
+	_, err = NewReversalTransfer(&original, mockReversalIMAD("20190411"))
+	require.True(t, errors.Is(err, ErrReversalCycleDate))
+}
+
+// TestNewRequestReversal keeps the direction of a customer transfer plus
+func TestNewRequestReversal(t *testing.T) {
+	original := readFEDWireMessage(t, "fedWireMessage-CustomerTransferPlus.txt")
+
+	fwm, err := NewRequestReversal(&original, mockReversalIMAD("20190410"))
+	require.NoError(t, err)
+	require.Equal(t, RequestReversal, fwm.TypeSubType.SubTypeCode)
+	require.Equal(t, CustomerTransferPlus, fwm.BusinessFunctionCode.BusinessFunctionCode)
+	require.Equal(t, original.SenderDepositoryInstitution.SenderABANumber, fwm.SenderDepositoryInstitution.SenderABANumber)
+	require.Equal(t, original.Beneficiary.Personal, fwm.Beneficiary.Personal)
+	require.Equal(t, original.OriginatorOptionF.PartyIdentifier, fwm.OriginatorOptionF.PartyIdentifier)
+	require.Equal(t, "20190410Source08000001", fwm.PreviousMessageIdentifier.PreviousMessageIdentifier)
+	require.Equal(t, original.InstructingFI, fwm.InstructingFI)
+	require.Equal(t, original.BeneficiaryIntermediaryFI, fwm.BeneficiaryIntermediaryFI)
+	require.Nil(t, fwm.LocalInstrument)
+
+	fwm, err = NewRequestReversalPriorDayTransfer(&original, mockReversalIMAD("20190412"))
+	require.NoError(t, err)
+	require.Equal(t, RequestReversalPriorDayTransfer, fwm.TypeSubType.SubTypeCode)
+}
+
+// TestNewReversalTransfer_OriginatorOptionF maps an originator in option F to the beneficiary of the reversal
+func TestNewReversalTransfer_OriginatorOptionF(t *testing.T) {
+	original := readFEDWireMessage(t, "fedWireMessage-CustomerTransferPlus.txt")
+	original.Originator = nil
+
+	// {5010} TXID/123-45-6789*1/Name*1/1234*2/1000 Colonial Farm Rd*5/Pottstown*
+	fwm, err := NewReversalTransfer(&original, mockReversalIMAD("20190410"))
+	require.NoError(t, err)
+	require.Nil(t, fwm.OriginatorOptionF)
+	require.Equal(t, TaxIdentificationNumber, fwm.Beneficiary.Personal.IdentificationCode)
+	require.Equal(t, "123-45-6789", fwm.Beneficiary.Personal.Identifier)
+	require.Equal(t, "Name 1234", fwm.Beneficiary.Personal.Name)
+	require.Equal(t, "1000 Colonial Farm Rd", fwm.Beneficiary.Personal.Address.AddressLineOne)
+	require.Equal(t, original.Beneficiary.Personal, fwm.Originator.Personal)
+
+	original.OriginatorOptionF.PartyIdentifier = "/123456789"
+	fwm, err = NewReversalTransfer(&original, mockReversalIMAD("20190410"))
+	require.NoError(t, err)
+	require.Equal(t, DemandDepositAccountNumber, fwm.Beneficiary.Personal.IdentificationCode)
+	require.Equal(t, "123456789", fwm.Beneficiary.Personal.Identifier)
+}
+
+// TestNewRequestReversal_BusinessFunctionCode only permits a request for reversal of a customer transfer plus
+func TestNewRequestReversal_BusinessFunctionCode(t *testing.T) {
+	original := readFEDWireMessage(t, "fedWireMessage-BankTransfer.txt")
+
+	_, err := NewRequestReversal(&original, mockReversalIMAD("20190410"))
+	var bfcErr ErrBusinessFunctionCodeProperty
+	require.True(t, errors.As(err, &bfcErr))
+	require.Equal(t, "1001", bfcErr.PropertyValue)
+	require.Equal(t, BankTransfer, bfcErr.BusinessFunctionCode)
+}
+
+// TestNewReversal_Original only reverses a basic funds transfer
+func TestNewReversal_Original(t *testing.T) {
+	reversal := readFEDWireMessage(t, "fedWireMessage-ReversalTransfer.txt")
+	_, err := NewReversalTransfer(&reversal, mockReversalIMAD("20190410"))
+	require.True(t, errors.Is(err, ErrReversalSubType))
+
+	_, err = NewReversalTransfer(nil, mockReversalIMAD("20190410"))
+	require.True(t, errors.Is(err, ErrFieldRequired))
+
+	original := readFEDWireMessage(t, "fedWireMessage-BankTransfer.txt")
+	_, err = NewReversalTransfer(&original, nil)
+	require.True(t, errors.Is(err, ErrFieldRequired))
+
+	original.InputMessageAccountabilityData = nil
+	_, err = NewReversalTransfer(&original, mockReversalIMAD("20190410"))
+	require.True(t, errors.Is(err, ErrFieldRequired))
+}
@@ -0,0 +1,244 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"reflect"
+	"strings"
+)
+
+// ScreeningKind is the kind of a value extracted from a FEDWireMessage for sanctions screening
+type ScreeningKind string
+
+const (
+	// ScreeningName is the name of a party or financial institution
+	ScreeningName ScreeningKind = "name"
+	// ScreeningAddress is an address line, or a part of a structured address such as a street or town
+	ScreeningAddress ScreeningKind = "address"
+	// ScreeningCountry is an ISO 3166 country code
+	ScreeningCountry ScreeningKind = "country"
+	// ScreeningBIC is a SWIFT Bank Identifier Code
+	ScreeningBIC ScreeningKind = "bic"
+	// ScreeningText is free text which may hold names, such as originator to beneficiary information
+	ScreeningText ScreeningKind = "text"
+)
+
+// ScreeningValue is a value of a FEDWireMessage to screen, and where it is in the message
+type ScreeningValue struct {
+	// Kind of the value
+	Kind ScreeningKind `json:"kind"`
+	// Tag holding the value, e.g. {4200}
+	Tag string `json:"tag"`
+	// Path is the JSON path of the field holding the value, e.g. beneficiary.personal.name
+	Path string `json:"path"`
+	// Value as found in the field, without a SWIFT account line or option F line code
+	Value string `json:"value"`
+}
+
+// screeningSwiftParties are the {7xxx} cover payment tags holding a SWIFT party or institution field
+var screeningSwiftParties = map[string]bool{
+	"orderingCustomer":        true,
+	"orderingInstitution":     true,
+	"intermediaryInstitution": true,
+	"institutionAccount":      true,
+	"beneficiaryCustomer":     true,
+}
+
+// screeningSwiftText are the {7xxx} cover payment tags holding SWIFT free text
+var screeningSwiftText = map[string]bool{
+	"remittance":       true,
+	"senderToReceiver": true,
+}
+
+// screeningTextTags are the tags holding free text: the originator to beneficiary, FI to FI and advice, service
+// message, addenda and remittance free text tags
+var screeningTextTags = map[string]bool{
+	"originatorToBeneficiary":      true,
+	"fiReceiverFI":                 true,
+	"fiDrawdownDebitAccountAdvice": true,
+	"fiIntermediaryFI":             true,
+	"fiIntermediaryFIAdvice":       true,
+	"fiBeneficiaryFI":              true,
+	"fiBeneficiaryFIAdvice":        true,
+	"fiBeneficiary":                true,
+	"fiBeneficiaryAdvice":          true,
+	"fiPaymentMethodToBeneficiary": true,
+	"fiAdditionalFiToFi":           true,
+	"unstructuredAddenda":          true,
+	"adjustment":                   true,
+	"remittanceFreeText":           true,
+	"serviceMessage":               true,
+}
+
+// screeningTextFields are the free text fields of the screeningTextTags other than their numbered lines, by the last
+// element of their JSON path
+var screeningTextFields = map[string]bool{
+	"Additional":     true,
+	"addenda":        true,
+	"additionalInfo": true,
+}
+
+// screeningFieldKinds are the kinds of the fields of parties and financial institutions, by the last element of
+// their JSON path
+var screeningFieldKinds = map[string]ScreeningKind{
+	"name":                    ScreeningName,
+	"senderShortName":         ScreeningName,
+	"receiverShortName":       ScreeningName,
+	"contactName":             ScreeningName,
+	"addressLineOne":          ScreeningAddress,
+	"addressLineTwo":          ScreeningAddress,
+	"addressLineThree":        ScreeningAddress,
+	"addressLineFour":         ScreeningAddress,
+	"addressLineFive":         ScreeningAddress,
+	"addressLineSix":          ScreeningAddress,
+	"addressLineSeven":        ScreeningAddress,
+	"department":              ScreeningAddress,
+	"subDepartment":           ScreeningAddress,
+	"streetName":              ScreeningAddress,
+	"buildingNumber":          ScreeningAddress,
+	"postCode":                ScreeningAddress,
+	"townName":                ScreeningAddress,
+	"countrySubDivisionState": ScreeningAddress,
+	"country":                 ScreeningCountry,
+	"countryOfResidence":      ScreeningCountry,
+}
+
+// ScreeningValues returns every value of the message to screen in tag order: the names, addresses, countries and
+// BICs of the parties and financial institutions, including the {5010} OriginatorOptionF lines and the SWIFT fields
+// of the {7xxx} cover payment tags, and the free text of the originator to beneficiary, FI to FI, cover payment,
+// addenda and remittance tags.
+func (fwm *FEDWireMessage) ScreeningValues() []ScreeningValue {
+	var values []ScreeningValue
+	v := reflect.ValueOf(fwm).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Type.Kind() != reflect.Ptr || v.Field(i).IsNil() {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		tag := v.Field(i).Elem().FieldByName("tag").String()
+		switch {
+		case name == "originatorOptionF":
+			values = append(values, screeningOptionF(tag, fwm.OriginatorOptionF)...)
+		case screeningSwiftParties[name]:
+			cp := v.Field(i).Elem().FieldByName("CoverPayment").Interface().(CoverPayment)
+			values = append(values, screeningSwiftParty(tag, name+".coverPayment.", cp)...)
+		default:
+			for _, column := range csvColumnsOf(field.Type.Elem(), name+".", nil) {
+				value := strings.TrimSpace(csvValue(v.Field(i), column.index))
+				if value == "" {
+					continue
+				}
+				if kind := fwm.screeningKindOf(name, column.name); kind != "" {
+					if kind == ScreeningBIC {
+						value = strings.Split(value, "/")[0]
+					}
+					values = append(values, ScreeningValue{Kind: kind, Tag: tag, Path: column.name, Value: value})
+				}
+			}
+		}
+	}
+	return values
+}
+
+// screeningKindOf returns the kind of the field at path, or "" when it is not screened
+func (fwm *FEDWireMessage) screeningKindOf(name, path string) ScreeningKind {
+	if screeningSwiftText[name] && strings.Contains(path, ".swiftLine") {
+		return ScreeningText
+	}
+	last := path[strings.LastIndex(path, ".")+1:]
+	if screeningTextTags[name] {
+		if strings.HasPrefix(last, "line") || screeningTextFields[last] {
+			return ScreeningText
+		}
+		return ""
+	}
+	if last == "identifier" {
+		// the identifier of a party or financial institution is a BIC for identification codes B and T
+		code, _ := fwm.Get(strings.TrimSuffix(path, "identifier") + "identificationCode")
+		if code == SWIFTBankIdentifierCode || code == SWIFTBICORBEIANDAccountNumber {
+			return ScreeningBIC
+		}
+		return ""
+	}
+	return screeningFieldKinds[last]
+}
+
+// screeningOptionF returns the values of the numbered lines of {5010} OriginatorOptionF: the name, address lines and
+// the country and town of a "3/" line
+func screeningOptionF(tag string, oof *OriginatorOptionF) []ScreeningValue {
+	var values []ScreeningValue
+	lines := map[string]string{
+		"originatorOptionF.name":      oof.Name,
+		"originatorOptionF.lineOne":   oof.LineOne,
+		"originatorOptionF.lineTwo":   oof.LineTwo,
+		"originatorOptionF.lineThree": oof.LineThree,
+	}
+	for _, path := range []string{"originatorOptionF.name", "originatorOptionF.lineOne", "originatorOptionF.lineTwo", "originatorOptionF.lineThree"} {
+		values = append(values, screeningOptionFLine(tag, path, lines[path])...)
+	}
+	return values
+}
+
+// screeningOptionFLine returns the values of a numbered option F line, e.g. 1/JOHN DOE or 3/US/NEW YORK
+func screeningOptionFLine(tag, path, line string) []ScreeningValue {
+	line = strings.TrimSpace(line)
+	if len(line) < 3 || line[1] != '/' {
+		return nil
+	}
+	value := strings.TrimSpace(line[2:])
+	switch line[:1] {
+	case OptionFName:
+		return []ScreeningValue{{Kind: ScreeningName, Tag: tag, Path: path, Value: value}}
+	case OptionFAddress, OptionFBirthPlace:
+		return []ScreeningValue{{Kind: ScreeningAddress, Tag: tag, Path: path, Value: value}}
+	case OptionFCountryTown:
+		parts := strings.SplitN(value, "/", 2)
+		values := []ScreeningValue{{Kind: ScreeningCountry, Tag: tag, Path: path, Value: strings.TrimSpace(parts[0])}}
+		if len(parts) > 1 && strings.TrimSpace(parts[1]) != "" {
+			values = append(values, ScreeningValue{Kind: ScreeningAddress, Tag: tag, Path: path, Value: strings.TrimSpace(parts[1])})
+		}
+		return values
+	}
+	return nil
+}
+
+// screeningSwiftParty returns the values of the SWIFT party or institution field of a cover payment tag, each
+// located at the line it is read from
+func screeningSwiftParty(tag, prefix string, cp CoverPayment) []ScreeningValue {
+	lines := []string{cp.SwiftLineOne, cp.SwiftLineTwo, cp.SwiftLineThree, cp.SwiftLineFour, cp.SwiftLineFive, cp.SwiftLineSix}
+	names := []string{"swiftLineOne", "swiftLineTwo", "swiftLineThree", "swiftLineFour", "swiftLineFive", "swiftLineSix"}
+
+	var values []ScreeningValue
+	add := func(kind ScreeningKind, value string) {
+		if value = strings.TrimSpace(value); value == "" {
+			return
+		}
+		path := prefix + names[0]
+		for i, line := range lines {
+			if strings.Contains(line, value) {
+				path = prefix + names[i]
+				break
+			}
+		}
+		values = append(values, ScreeningValue{Kind: kind, Tag: tag, Path: path, Value: value})
+	}
+
+	p := decodeSwiftParty(cp.SwiftFieldTag, lines)
+	add(ScreeningBIC, p.BIC)
+	if _, option := swiftFieldOption(cp.SwiftFieldTag); option == "F" {
+		// the name of option F may span several 1/ lines, so its numbered lines are screened one by one
+		for i, line := range lines {
+			values = append(values, screeningOptionFLine(tag, prefix+names[i], line)...)
+		}
+		return values
+	}
+	add(ScreeningName, p.Name)
+	for _, line := range p.AddressLines {
+		add(ScreeningAddress, line)
+	}
+	return values
+}
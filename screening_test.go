@@ -0,0 +1,109 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// screeningValuesAt returns the values of the message at path
+func screeningValuesAt(fwm *FEDWireMessage, path string) []ScreeningValue {
+	var values []ScreeningValue
+	for _, value := range fwm.ScreeningValues() {
+		if value.Path == path {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func TestFEDWireMessage_ScreeningValues(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.txt")
+
+	require.Equal(t, []ScreeningValue{{Kind: ScreeningName, Tag: TagBeneficiary, Path: "beneficiary.personal.name", Value: "Name"}},
+		screeningValuesAt(&fwm, "beneficiary.personal.name"))
+	require.Equal(t, []ScreeningValue{{Kind: ScreeningAddress, Tag: TagOriginatorFI, Path: "originatorFI.financialInstitution.address.addressLineOne", Value: "Address One"}},
+		screeningValuesAt(&fwm, "originatorFI.financialInstitution.address.addressLineOne"))
+	require.Equal(t, []ScreeningValue{{Kind: ScreeningText, Tag: TagOriginatorToBeneficiary, Path: "originatorToBeneficiary.lineOne", Value: "LineOne"}},
+		screeningValuesAt(&fwm, "originatorToBeneficiary.lineOne"))
+	require.Equal(t, ScreeningText, screeningValuesAt(&fwm, "fiBeneficiary.fiToFI.lineOne")[0].Kind)
+
+	// identifiers are only screened when they are BICs
+	require.Empty(t, screeningValuesAt(&fwm, "beneficiaryFI.financialInstitution.identifier"))
+	fwm.BeneficiaryFI.FinancialInstitution.IdentificationCode = SWIFTBICORBEIANDAccountNumber
+	fwm.BeneficiaryFI.FinancialInstitution.Identifier = "DEUTDEFF/123456789"
+	require.Equal(t, []ScreeningValue{{Kind: ScreeningBIC, Tag: TagBeneficiaryFI, Path: "beneficiaryFI.financialInstitution.identifier", Value: "DEUTDEFF"}},
+		screeningValuesAt(&fwm, "beneficiaryFI.financialInstitution.identifier"))
+
+	// the ABA numbers and amounts are not screened
+	require.Empty(t, screeningValuesAt(&fwm, "senderDepositoryInstitution.senderABANumber"))
+	require.Empty(t, screeningValuesAt(&fwm, "amount.amount"))
+}
+
+func TestFEDWireMessage_ScreeningValuesSwift(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.txt")
+
+	// {5010} TXID/123-45-6789*1/Name*1/1234*2/1000 Colonial Farm Rd*5/Pottstown*
+	require.Empty(t, screeningValuesAt(&fwm, "originatorOptionF.partyIdentifier"))
+	require.Equal(t, []ScreeningValue{{Kind: ScreeningAddress, Tag: TagOriginatorOptionF, Path: "originatorOptionF.lineTwo", Value: "1000 Colonial Farm Rd"}},
+		screeningValuesAt(&fwm, "originatorOptionF.lineTwo"))
+
+	// {7050} 50F*TXID/123-45-6789*1/Jane Doe*2/1000 Colonial Farm Rd*3/US/Pottstown*4/19800101*
+	require.Equal(t, []ScreeningValue{{Kind: ScreeningName, Tag: TagOrderingCustomer, Path: "orderingCustomer.coverPayment.swiftLineTwo", Value: "Jane Doe"}},
+		screeningValuesAt(&fwm, "orderingCustomer.coverPayment.swiftLineTwo"))
+	require.Equal(t, []ScreeningValue{
+		{Kind: ScreeningCountry, Tag: TagOrderingCustomer, Path: "orderingCustomer.coverPayment.swiftLineFour", Value: "US"},
+		{Kind: ScreeningAddress, Tag: TagOrderingCustomer, Path: "orderingCustomer.coverPayment.swiftLineFour", Value: "Pottstown"},
+	}, screeningValuesAt(&fwm, "orderingCustomer.coverPayment.swiftLineFour"))
+	require.Empty(t, screeningValuesAt(&fwm, "orderingCustomer.coverPayment.swiftLineFive"))
+
+	// {7057} 57A*/123456789*DEUTDEFF*
+	require.Equal(t, []ScreeningValue{{Kind: ScreeningBIC, Tag: TagInstitutionAccount, Path: "institutionAccount.coverPayment.swiftLineTwo", Value: "DEUTDEFF"}},
+		screeningValuesAt(&fwm, "institutionAccount.coverPayment.swiftLineTwo"))
+
+	// {7059} 59*/DE89370400440532013000*John Doe*Taunusanlage 12*Frankfurt am Main*
+	require.Empty(t, screeningValuesAt(&fwm, "beneficiaryCustomer.coverPayment.swiftLineOne"))
+	require.Equal(t, ScreeningName, screeningValuesAt(&fwm, "beneficiaryCustomer.coverPayment.swiftLineTwo")[0].Kind)
+
+	// {7072} 72*/ACC/Line One*...
+	require.Equal(t, ScreeningText, screeningValuesAt(&fwm, "senderToReceiver.coverPayment.swiftLineOne")[0].Kind)
+	require.Empty(t, screeningValuesAt(&fwm, "senderToReceiver.coverPayment.swiftFieldTag"))
+
+	require.Empty(t, (&FEDWireMessage{}).ScreeningValues())
+}
+
+func TestFEDWireMessage_ScreeningValuesText(t *testing.T) {
+	textTags := map[string]bool{
+		TagOriginatorToBeneficiary: true, TagFIReceiverFI: true, TagFIDrawdownDebitAccountAdvice: true,
+		TagFIIntermediaryFI: true, TagFIIntermediaryFIAdvice: true, TagFIBeneficiaryFI: true, TagFIBeneficiaryFIAdvice: true,
+		TagFIBeneficiary: true, TagFIBeneficiaryAdvice: true, TagFIAdditionalFIToFI: true, TagRemittanceFreeText: true,
+		TagServiceMessage: true,
+	}
+	var paths []string
+	for _, field := range Fields() {
+		last := field.Path[strings.LastIndex(field.Path, ".")+1:]
+		if textTags[field.Tag] && strings.HasPrefix(last, "line") {
+			paths = append(paths, field.Path)
+		}
+	}
+	paths = append(paths, "fiPaymentMethodToBeneficiary.Additional", "unstructuredAddenda.addenda", "adjustment.additionalInfo")
+
+	for _, path := range paths {
+		fwm := &FEDWireMessage{}
+		require.NoError(t, fwm.Set(path, "JOHN DOE"))
+		values := screeningValuesAt(fwm, path)
+		require.Len(t, values, 1, path)
+		require.Equal(t, ScreeningText, values[0].Kind, path)
+		require.Equal(t, "JOHN DOE", values[0].Value, path)
+	}
+
+	// the advice codes of the advice tags are not screened
+	fwm := &FEDWireMessage{}
+	require.NoError(t, fwm.Set("fiDrawdownDebitAccountAdvice.advice.adviceCode", AdviceCodeLetter))
+	require.Empty(t, fwm.ScreeningValues())
+}
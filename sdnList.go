@@ -0,0 +1,412 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"encoding/csv"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/moov-io/base"
+)
+
+// SDNEntry is an entry of a sanctions list in the format of the OFAC Specially Designated Nationals (SDN) list
+type SDNEntry struct {
+	// ID is the unique entry number of the entry
+	ID string `json:"id"`
+	// Name of the individual, entity, vessel or aircraft
+	Name string `json:"name"`
+	// Type of the entry, e.g. individual or vessel, which is blank for an entity
+	Type string `json:"type,omitempty"`
+	// Programs are the sanctions programs of the entry, e.g. SDGT
+	Programs string `json:"programs,omitempty"`
+	// Remarks of the entry
+	Remarks string `json:"remarks,omitempty"`
+	// Aliases are the alternate names of the entry
+	Aliases []string `json:"aliases,omitempty"`
+	// BICs are the SWIFT BICs given in the remarks of the entry
+	BICs []string `json:"bics,omitempty"`
+}
+
+// ScreeningOptions are the thresholds of the fuzzy matching of SDNList.Screen. Scores range from 0 (nothing in common)
+// to 1 (the same name), and a zero threshold is the threshold of DefaultScreeningOptions.
+type ScreeningOptions struct {
+	// NameThreshold is the lowest score of a hit on a name
+	NameThreshold float64 `json:"nameThreshold,omitempty"`
+	// TextThreshold is the lowest score of a hit in an address or in free text
+	TextThreshold float64 `json:"textThreshold,omitempty"`
+}
+
+// DefaultScreeningOptions are the thresholds used when ScreeningOptions are not given
+var DefaultScreeningOptions = ScreeningOptions{
+	NameThreshold: 0.92,
+	TextThreshold: 0.95,
+}
+
+// ScreeningHit is a value of a FEDWireMessage which matches an entry of an SDNList
+type ScreeningHit struct {
+	ScreeningValue
+	// Entry matched
+	Entry SDNEntry `json:"entry"`
+	// MatchedName is the name, alias or BIC of the entry which matched the value
+	MatchedName string `json:"matchedName"`
+	// Score of the match, which is 1 for a BIC
+	Score float64 `json:"score"`
+}
+
+// SDNList is a sanctions list loaded from local files in the CSV format of the OFAC SDN list, which is screened
+// without any network access.
+type SDNList struct {
+	entries []*SDNEntry
+	byID    map[string]*SDNEntry
+	// names holds the normalized name and aliases of every entry
+	names []sdnName
+	// bics holds the entries by the first 8 characters of their BICs
+	bics map[string][]*SDNEntry
+}
+
+// sdnName is a normalized name or alias of an entry
+type sdnName struct {
+	entry  *SDNEntry
+	name   string
+	tokens []string
+}
+
+// sdnBIC matches the BICs the OFAC SDN list gives in the remarks of an entry, e.g. SWIFT/BIC EXTBIRTH
+var sdnBIC = regexp.MustCompile(`SWIFT/BIC ([A-Z0-9]{8,11})`)
+
+// NewSDNList reads an SDNList from r in the format of the OFAC sdn.csv file, which has no header and the columns
+// ent_num, SDN_Name, SDN_Type, Program, Title, Call_Sign, Vess_type, Tonnage, GRT, Vess_flag, Vess_owner and
+// Remarks. Only the ent_num and SDN_Name columns are required, and "-0-" is a blank value.
+//
+// The errors of every row which does not parse are returned in a base.ErrorList of base.ParseError, where Line is
+// the line number of the row, and no SDNList is returned so a partially loaded list is never screened.
+func NewSDNList(r io.Reader) (*SDNList, error) {
+	l := &SDNList{
+		byID: make(map[string]*SDNEntry),
+		bics: make(map[string][]*SDNEntry),
+	}
+	err := readSDNRows(r, func(line int, row []string) error {
+		if len(row) < 2 || sdnField(row, 0) == "" || sdnField(row, 1) == "" {
+			return &base.ParseError{Line: line, Record: "SDNEntry", Err: fieldError("SDN_Name", ErrSDNEntry, strings.Join(row, ","))}
+		}
+		entry := &SDNEntry{
+			ID:       sdnField(row, 0),
+			Name:     sdnField(row, 1),
+			Type:     sdnField(row, 2),
+			Programs: sdnField(row, 3),
+			Remarks:  sdnField(row, 11),
+		}
+		if _, ok := l.byID[entry.ID]; ok {
+			return &base.ParseError{Line: line, Record: "SDNEntry", Err: fieldError("ent_num", ErrSDNDuplicateEntry, entry.ID)}
+		}
+		banks := make(map[string]bool)
+		for _, match := range sdnBIC.FindAllStringSubmatch(entry.Remarks, -1) {
+			entry.BICs = append(entry.BICs, match[1])
+			if bank := match[1][:8]; !banks[bank] {
+				banks[bank] = true
+				l.bics[bank] = append(l.bics[bank], entry)
+			}
+		}
+		l.entries = append(l.entries, entry)
+		l.byID[entry.ID] = entry
+		l.addName(entry, entry.Name)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// ReadAliases reads the alternate names of the entries of the list from r in the format of the OFAC alt.csv file,
+// which has no header and the columns ent_num, alt_num, alt_type, alt_name and alt_remarks.
+//
+// The errors of every row which does not parse, or whose ent_num is not an entry of the list, are returned in a
+// base.ErrorList of base.ParseError. The aliases of the other rows are added.
+func (l *SDNList) ReadAliases(r io.Reader) error {
+	return readSDNRows(r, func(line int, row []string) error {
+		entry, ok := l.byID[sdnField(row, 0)]
+		if !ok {
+			return &base.ParseError{Line: line, Record: "SDNAlias", Err: fieldError("ent_num", ErrSDNAlias, sdnField(row, 0))}
+		}
+		alias := sdnField(row, 3)
+		if alias == "" {
+			return &base.ParseError{Line: line, Record: "SDNAlias", Err: fieldError("alt_name", ErrSDNAlias, alias)}
+		}
+		entry.Aliases = append(entry.Aliases, alias)
+		l.addName(entry, alias)
+		return nil
+	})
+}
+
+// Entries returns a copy of every entry of the list in the order read
+func (l *SDNList) Entries() []SDNEntry {
+	entries := make([]SDNEntry, len(l.entries))
+	for i, entry := range l.entries {
+		entries[i] = entry.copy()
+	}
+	return entries
+}
+
+// Screen matches the ScreeningValues of fwm against the list and returns the hits in the order of the values, and
+// for each value by descending score with one hit per entry. Names are fuzzy matched against the names and aliases of
+// the entries using opts.NameThreshold, and addresses and free text using opts.TextThreshold. BICs match the BICs of
+// the entries with the same bank, country and location code, i.e. their first 8 characters. Countries are not
+// screened against the list.
+func (l *SDNList) Screen(fwm *FEDWireMessage, opts ScreeningOptions) []ScreeningHit {
+	if opts.NameThreshold == 0 {
+		opts.NameThreshold = DefaultScreeningOptions.NameThreshold
+	}
+	if opts.TextThreshold == 0 {
+		opts.TextThreshold = DefaultScreeningOptions.TextThreshold
+	}
+
+	var hits []ScreeningHit
+	for _, value := range fwm.ScreeningValues() {
+		var found []ScreeningHit
+		switch value.Kind {
+		case ScreeningBIC:
+			if len(value.Value) >= 8 {
+				bank := strings.ToUpper(value.Value[:8])
+				for _, entry := range l.bics[bank] {
+					for _, bic := range entry.BICs {
+						if strings.HasPrefix(bic, bank) {
+							found = append(found, ScreeningHit{ScreeningValue: value, Entry: entry.copy(), MatchedName: bic, Score: 1})
+							break
+						}
+					}
+				}
+			}
+		case ScreeningName, ScreeningAddress, ScreeningText:
+			threshold := opts.TextThreshold
+			if value.Kind == ScreeningName {
+				threshold = opts.NameThreshold
+			}
+			found = l.match(value, threshold)
+		}
+		hits = append(hits, found...)
+	}
+	return hits
+}
+
+// match returns the best hit of each entry with a name scoring at least threshold against value
+func (l *SDNList) match(value ScreeningValue, threshold float64) []ScreeningHit {
+	tokens := screeningTokens(value.Value)
+	if len(tokens) == 0 {
+		return nil
+	}
+	best := make(map[*SDNEntry]ScreeningHit)
+	for _, name := range l.names {
+		score := screeningScore(tokens, name.tokens)
+		if score < threshold || score <= best[name.entry].Score {
+			continue
+		}
+		best[name.entry] = ScreeningHit{ScreeningValue: value, Entry: name.entry.copy(), MatchedName: name.name, Score: score}
+	}
+
+	hits := make([]ScreeningHit, 0, len(best))
+	for _, hit := range best {
+		hits = append(hits, hit)
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].Entry.ID < hits[j].Entry.ID
+	})
+	return hits
+}
+
+// addName adds a name or alias of entry to the names matched
+func (l *SDNList) addName(entry *SDNEntry, name string) {
+	tokens := screeningTokens(name)
+	if len(tokens) > 0 {
+		l.names = append(l.names, sdnName{entry: entry, name: name, tokens: tokens})
+	}
+}
+
+// copy returns a copy of the entry which does not share its slices
+func (e *SDNEntry) copy() SDNEntry {
+	c := *e
+	c.Aliases = append([]string(nil), e.Aliases...)
+	c.BICs = append([]string(nil), e.BICs...)
+	return c
+}
+
+// readSDNRows calls parse for each row of an OFAC CSV file, skipping the end of file marker the OFAC files end with,
+// and returns the errors of the rows in a base.ErrorList
+func readSDNRows(r io.Reader, parse func(line int, row []string) error) error {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	var errs base.ErrorList
+	for line := 1; ; line++ {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errs.Add(&base.ParseError{Line: line, Record: "SDN", Err: err})
+			continue
+		}
+		if len(row) == 1 && strings.Trim(row[0], "\x1a \t") == "" {
+			continue
+		}
+		if err := parse(line, row); err != nil {
+			errs.Add(err)
+		}
+	}
+	if errs.Empty() {
+		return nil
+	}
+	return errs
+}
+
+// sdnField returns the trimmed column i of row, which is blank for "-0-" or a missing column
+func sdnField(row []string, i int) string {
+	if i >= len(row) {
+		return ""
+	}
+	value := strings.TrimSpace(row[i])
+	if value == "-0-" {
+		return ""
+	}
+	return value
+}
+
+// screeningStopWords are the legal forms and articles which are left out of the tokens of a name, so a name matches
+// without them, e.g. "NORTHERN STAR SHIPPING" and "NORTHERN STAR SHIPPING LLC"
+var screeningStopWords = map[string]bool{
+	"THE": true, "LLC": true, "LTD": true, "LIMITED": true, "INC": true, "CO": true, "CORP": true,
+	"COMPANY": true, "PLC": true, "SA": true, "AG": true, "GMBH": true, "JSC": true, "OJSC": true,
+}
+
+// screeningTokens returns the words of the normalized name s without its screeningStopWords
+func screeningTokens(s string) []string {
+	var tokens []string
+	for _, token := range strings.Fields(normalizeScreeningName(s)) {
+		if !screeningStopWords[token] {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+// normalizeScreeningName returns s in upper case with every character which is not a letter or digit replaced by
+// a space, and runs of spaces collapsed
+func normalizeScreeningName(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToUpper(r)
+		}
+		return ' '
+	}, s)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// screeningScore returns the best Jaro-Winkler similarity of any run of tokens of value with a run of as many tokens
+// of name, comparing the tokens both in order and sorted, so "ZAKHAROV, Ivan Petrovich" matches "Ivan Zakharov" and
+// a name matches within free text. A single token is only compared with the whole name, so a first name alone does
+// not match every name it is part of.
+func screeningScore(value, name []string) float64 {
+	size := len(name)
+	if len(value) < size {
+		size = len(value)
+	}
+	nameSize := size
+	if size < 2 {
+		nameSize = len(name)
+	}
+	var best float64
+	for j := 0; j+nameSize <= len(name); j++ {
+		joinedName, sortedName := strings.Join(name[j:j+nameSize], " "), sortedTokens(name[j:j+nameSize])
+		for i := 0; i+size <= len(value); i++ {
+			window := value[i : i+size]
+			if score := jaroWinkler(strings.Join(window, " "), joinedName); score > best {
+				best = score
+			}
+			if score := jaroWinkler(sortedTokens(window), sortedName); score > best {
+				best = score
+			}
+		}
+	}
+	return best
+}
+
+// sortedTokens returns the tokens sorted and joined by a space
+func sortedTokens(tokens []string) string {
+	sorted := append([]string(nil), tokens...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, " ")
+}
+
+// jaroWinkler returns the Jaro-Winkler similarity of a and b, from 0 to 1
+func jaroWinkler(a, b string) float64 {
+	s, t := []rune(a), []rune(b)
+	if len(s) == 0 || len(t) == 0 {
+		return 0
+	}
+	if a == b {
+		return 1
+	}
+
+	window := len(s)
+	if len(t) > window {
+		window = len(t)
+	}
+	window = window/2 - 1
+	if window < 0 {
+		window = 0
+	}
+	sMatched := make([]bool, len(s))
+	tMatched := make([]bool, len(t))
+	matches := 0
+	for i := range s {
+		lo, hi := i-window, i+window+1
+		if lo < 0 {
+			lo = 0
+		}
+		if hi > len(t) {
+			hi = len(t)
+		}
+		for j := lo; j < hi; j++ {
+			if !tMatched[j] && s[i] == t[j] {
+				sMatched[i], tMatched[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions, j := 0, 0
+	for i := range s {
+		if !sMatched[i] {
+			continue
+		}
+		for !tMatched[j] {
+			j++
+		}
+		if s[i] != t[j] {
+			transpositions++
+		}
+		j++
+	}
+	m := float64(matches)
+	jaro := (m/float64(len(s)) + m/float64(len(t)) + (m-float64(transpositions)/2)/m) / 3
+
+	prefix := 0
+	for prefix < 4 && prefix < len(s) && prefix < len(t) && s[prefix] == t[prefix] {
+		prefix++
+	}
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
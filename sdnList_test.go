@@ -0,0 +1,114 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/stretchr/testify/require"
+)
+
+// readSDNList returns the SDN list of test/testdata with its aliases
+func readSDNList(t *testing.T) *SDNList {
+	t.Helper()
+	f, err := os.Open(filepath.Join("test", "testdata", "sdn.csv"))
+	require.NoError(t, err)
+	defer f.Close()
+	list, err := NewSDNList(f)
+	require.NoError(t, err)
+
+	alt, err := os.Open(filepath.Join("test", "testdata", "alt.csv"))
+	require.NoError(t, err)
+	defer alt.Close()
+	require.NoError(t, list.ReadAliases(alt))
+	return list
+}
+
+func TestNewSDNList(t *testing.T) {
+	entries := readSDNList(t).Entries()
+	require.Len(t, entries, 4)
+	require.Equal(t, SDNEntry{
+		ID:       "100",
+		Name:     "ZAKHAROV, Ivan Petrovich",
+		Type:     "individual",
+		Programs: "SDGT",
+		Remarks:  "DOB 01 Jan 1970; nationality Russia.",
+		Aliases:  []string{"ZAKHAROV, Vanya"},
+	}, entries[0])
+	require.Equal(t, "", entries[1].Type)
+	require.Equal(t, []string{"EXTBIRTH"}, entries[1].BICs)
+}
+
+func TestNewSDNListErrors(t *testing.T) {
+	_, err := NewSDNList(strings.NewReader("100,\"ZAKHAROV, Ivan\"\n200,-0-\n100,\"ZAKHAROV, Ivan\"\n"))
+	require.Error(t, err)
+	errs, ok := err.(base.ErrorList)
+	require.True(t, ok)
+	require.Len(t, errs, 2)
+
+	var parseErr *base.ParseError
+	require.True(t, errors.As(errs[0], &parseErr))
+	require.Equal(t, 2, parseErr.Line)
+	require.True(t, errors.Is(parseErr.Err, ErrSDNEntry))
+	require.True(t, errors.As(errs[1], &parseErr))
+	require.Equal(t, 3, parseErr.Line)
+	require.True(t, errors.Is(parseErr.Err, ErrSDNDuplicateEntry))
+
+	list := readSDNList(t)
+	err = list.ReadAliases(strings.NewReader("999,1,\"aka\",\"NOBODY\"\n100,2,\"aka\",\"ZAKHAROV, Ivan P.\"\n"))
+	require.Error(t, err)
+	require.Len(t, err.(base.ErrorList), 1)
+	require.Equal(t, []string{"ZAKHAROV, Vanya", "ZAKHAROV, Ivan P."}, list.Entries()[0].Aliases)
+}
+
+func TestSDNList_Screen(t *testing.T) {
+	list := readSDNList(t)
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.txt")
+	require.Empty(t, list.Screen(&fwm, ScreeningOptions{}))
+
+	fwm.Beneficiary.Personal.Name = "Ivan Zakharov"
+	fwm.OriginatorToBeneficiary.LineTwo = "PAYMENT FOR NORTHERN STAR SHIPPING"
+	fwm.InstitutionAccount.CoverPayment.SwiftLineTwo = "EXTBIRTHXXX"
+	hits := list.Screen(&fwm, ScreeningOptions{})
+	require.Len(t, hits, 3)
+
+	// names in another order match
+	require.Equal(t, "beneficiary.personal.name", hits[0].Path)
+	require.Equal(t, TagBeneficiary, hits[0].Tag)
+	require.Equal(t, "100", hits[0].Entry.ID)
+	require.Equal(t, "ZAKHAROV, Ivan Petrovich", hits[0].MatchedName)
+	require.True(t, hits[0].Score >= DefaultScreeningOptions.NameThreshold)
+
+	// names in free text match by their words
+	require.Equal(t, ScreeningText, hits[1].Kind)
+	require.Equal(t, "originatorToBeneficiary.lineTwo", hits[1].Path)
+	require.Equal(t, "300", hits[1].Entry.ID)
+
+	// BICs match on the bank, country and location code
+	require.Equal(t, ScreeningHit{
+		ScreeningValue: ScreeningValue{Kind: ScreeningBIC, Tag: TagInstitutionAccount, Path: "institutionAccount.coverPayment.swiftLineTwo", Value: "EXTBIRTHXXX"},
+		Entry:          list.Entries()[1],
+		MatchedName:    "EXTBIRTH",
+		Score:          1,
+	}, hits[2])
+
+	// aliases match, and the thresholds are configurable
+	fwm.Beneficiary.Personal.Name = "Vanya Zakharova"
+	require.Len(t, list.Screen(&fwm, ScreeningOptions{}), 3)
+	require.Len(t, list.Screen(&fwm, ScreeningOptions{NameThreshold: 0.99}), 2)
+}
+
+func TestJaroWinkler(t *testing.T) {
+	require.Equal(t, 1.0, jaroWinkler("ZAKHAROV", "ZAKHAROV"))
+	require.Equal(t, 0.0, jaroWinkler("ABC", ""))
+	require.InDelta(t, 0.961, jaroWinkler("MARTHA", "MARHTA"), 0.001)
+	require.InDelta(t, 0.840, jaroWinkler("DWAYNE", "DUANE"), 0.001)
+	require.Equal(t, "IVAN ZAKHAROV O NEIL", normalizeScreeningName(" ivan  zakharov, o'neil "))
+}
@@ -118,6 +118,9 @@ func (str *SenderToReceiver) Validate() error {
 	if err := str.isAlphanumeric(str.CoverPayment.SwiftLineSix); err != nil {
 		return fieldError("SwiftLineSix", err, str.CoverPayment.SwiftLineSix)
 	}
+	if err := str.validateCoverPayment(TagSenderToReceiver, str.CoverPayment); err != nil {
+		return err
+	}
 	return nil
 }
 
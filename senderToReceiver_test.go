@@ -10,13 +10,13 @@ import (
 // SenderToReceiver creates a SenderToReceiver
 func mockSenderToReceiver() *SenderToReceiver {
 	sr := NewSenderToReceiver()
-	sr.CoverPayment.SwiftFieldTag = "Swift Field Tag"
-	sr.CoverPayment.SwiftLineOne = "Swift Line One"
-	sr.CoverPayment.SwiftLineTwo = "Swift Line Two"
-	sr.CoverPayment.SwiftLineThree = "Swift Line Three"
-	sr.CoverPayment.SwiftLineFour = "Swift Line Four"
-	sr.CoverPayment.SwiftLineFive = "Swift Line Five"
-	sr.CoverPayment.SwiftLineSix = "Swift Line Six"
+	sr.CoverPayment.SwiftFieldTag = "72"
+	sr.CoverPayment.SwiftLineOne = "/ACC/Line One"
+	sr.CoverPayment.SwiftLineTwo = "//Line Two"
+	sr.CoverPayment.SwiftLineThree = "//Line Three"
+	sr.CoverPayment.SwiftLineFour = "/INS/Line Four"
+	sr.CoverPayment.SwiftLineFive = "//Line Five"
+	sr.CoverPayment.SwiftLineSix = "//Line Six"
 	return sr
 }
 
@@ -119,3 +119,23 @@ func TestSenderToReceiverTagError(t *testing.T) {
 
 	require.EqualError(t, str.Validate(), fieldError("tag", ErrValidTagForType, str.tag).Error())
 }
+
+// TestSenderToReceiverSwiftFieldOption validates SenderToReceiver SwiftFieldTag is a SWIFT field option permitted for the tag
+func TestSenderToReceiverSwiftFieldOption(t *testing.T) {
+	sr := mockSenderToReceiver()
+	sr.CoverPayment.SwiftFieldTag = "70"
+
+	err := sr.Validate()
+
+	require.EqualError(t, err, fieldError("SwiftFieldTag", ErrSwiftFieldOption, sr.CoverPayment.SwiftFieldTag).Error())
+}
+
+// TestSenderToReceiverSwiftFieldLine validates SenderToReceiver SWIFT lines follow the format of the SWIFT field option
+func TestSenderToReceiverSwiftFieldLine(t *testing.T) {
+	sr := mockSenderToReceiver()
+	sr.CoverPayment.SwiftLineTwo = "Line Two"
+
+	err := sr.Validate()
+
+	require.EqualError(t, err, fieldError("SwiftLineTwo", ErrSwiftFieldLine, sr.CoverPayment.SwiftLineTwo).Error())
+}
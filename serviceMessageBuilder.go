@@ -0,0 +1,186 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// ServiceMessageBuilder builds a SVC service message, exposing only the tags a service message permits
+type ServiceMessageBuilder struct {
+	messageBuilder
+}
+
+// NewServiceMessageBuilder returns a builder of a SVC service message with a default {1500} SenderSupplied,
+// {1510} TypeSubType 1090, a service message, and an {2000} Amount of zero
+func NewServiceMessageBuilder() *ServiceMessageBuilder {
+	b := &ServiceMessageBuilder{newMessageBuilder(BFCServiceMessage, FundsTransfer, SSIServiceMessage)}
+	b.setAmount(0)
+	return b
+}
+
+// SenderSupplied sets {1500} SenderSupplied
+func (b *ServiceMessageBuilder) SenderSupplied(tag *SenderSupplied) *ServiceMessageBuilder {
+	CloneTag(&b.fwm.SenderSupplied, tag)
+	return b
+}
+
+// UserRequestCorrelation sets the UserRequestCorrelation of {1500} SenderSupplied
+func (b *ServiceMessageBuilder) UserRequestCorrelation(id string) *ServiceMessageBuilder {
+	b.setUserRequestCorrelation(id)
+	return b
+}
+
+// TypeSubType sets {1510} TypeSubType to typeCode and subTypeCode
+func (b *ServiceMessageBuilder) TypeSubType(typeCode, subTypeCode string) *ServiceMessageBuilder {
+	b.setTypeSubType(typeCode, subTypeCode)
+	return b
+}
+
+// IMAD sets {1520} InputMessageAccountabilityData
+func (b *ServiceMessageBuilder) IMAD(tag *InputMessageAccountabilityData) *ServiceMessageBuilder {
+	CloneTag(&b.fwm.InputMessageAccountabilityData, tag)
+	return b
+}
+
+// Amount sets {2000} Amount in cents
+func (b *ServiceMessageBuilder) Amount(cents int64) *ServiceMessageBuilder {
+	b.setAmount(cents)
+	return b
+}
+
+// Sender sets {3100} SenderDepositoryInstitution to the ABA routing number and short name of the sender
+func (b *ServiceMessageBuilder) Sender(aba, shortName string) *ServiceMessageBuilder {
+	b.setSender(aba, shortName)
+	return b
+}
+
+// Receiver sets {3400} ReceiverDepositoryInstitution to the ABA routing number and short name of the receiver
+func (b *ServiceMessageBuilder) Receiver(aba, shortName string) *ServiceMessageBuilder {
+	b.setReceiver(aba, shortName)
+	return b
+}
+
+// SenderReference sets {3320} SenderReference
+func (b *ServiceMessageBuilder) SenderReference(ref string) *ServiceMessageBuilder {
+	b.setSenderReference(ref)
+	return b
+}
+
+// PreviousMessageIdentifier sets {3500} PreviousMessageIdentifier to the IMAD of the previous message
+func (b *ServiceMessageBuilder) PreviousMessageIdentifier(imad string) *ServiceMessageBuilder {
+	b.setPreviousMessageIdentifier(imad)
+	return b
+}
+
+// BeneficiaryIntermediaryFI sets {4000} BeneficiaryIntermediaryFI
+func (b *ServiceMessageBuilder) BeneficiaryIntermediaryFI(fi FinancialInstitution) *ServiceMessageBuilder {
+	b.setBeneficiaryIntermediaryFI(fi)
+	return b
+}
+
+// BeneficiaryFI sets {4100} BeneficiaryFI
+func (b *ServiceMessageBuilder) BeneficiaryFI(fi FinancialInstitution) *ServiceMessageBuilder {
+	b.setBeneficiaryFI(fi)
+	return b
+}
+
+// Beneficiary sets {4200} Beneficiary
+func (b *ServiceMessageBuilder) Beneficiary(p Personal) *ServiceMessageBuilder {
+	b.setBeneficiary(p)
+	return b
+}
+
+// BeneficiaryReference sets {4320} BeneficiaryReference
+func (b *ServiceMessageBuilder) BeneficiaryReference(ref string) *ServiceMessageBuilder {
+	b.setBeneficiaryReference(ref)
+	return b
+}
+
+// Originator sets {5000} Originator
+func (b *ServiceMessageBuilder) Originator(p Personal) *ServiceMessageBuilder {
+	b.setOriginator(p)
+	return b
+}
+
+// OriginatorFI sets {5100} OriginatorFI
+func (b *ServiceMessageBuilder) OriginatorFI(fi FinancialInstitution) *ServiceMessageBuilder {
+	b.setOriginatorFI(fi)
+	return b
+}
+
+// InstructingFI sets {5200} InstructingFI
+func (b *ServiceMessageBuilder) InstructingFI(fi FinancialInstitution) *ServiceMessageBuilder {
+	b.setInstructingFI(fi)
+	return b
+}
+
+// OriginatorToBeneficiary sets {6000} OriginatorToBeneficiary
+func (b *ServiceMessageBuilder) OriginatorToBeneficiary(tag *OriginatorToBeneficiary) *ServiceMessageBuilder {
+	CloneTag(&b.fwm.OriginatorToBeneficiary, tag)
+	return b
+}
+
+// FIReceiverFI sets {6100} FIReceiverFI
+func (b *ServiceMessageBuilder) FIReceiverFI(tag *FIReceiverFI) *ServiceMessageBuilder {
+	CloneTag(&b.fwm.FIReceiverFI, tag)
+	return b
+}
+
+// FIIntermediaryFI sets {6200} FIIntermediaryFI
+func (b *ServiceMessageBuilder) FIIntermediaryFI(tag *FIIntermediaryFI) *ServiceMessageBuilder {
+	CloneTag(&b.fwm.FIIntermediaryFI, tag)
+	return b
+}
+
+// FIIntermediaryFIAdvice sets {6210} FIIntermediaryFIAdvice
+func (b *ServiceMessageBuilder) FIIntermediaryFIAdvice(tag *FIIntermediaryFIAdvice) *ServiceMessageBuilder {
+	CloneTag(&b.fwm.FIIntermediaryFIAdvice, tag)
+	return b
+}
+
+// FIBeneficiaryFI sets {6300} FIBeneficiaryFI
+func (b *ServiceMessageBuilder) FIBeneficiaryFI(tag *FIBeneficiaryFI) *ServiceMessageBuilder {
+	CloneTag(&b.fwm.FIBeneficiaryFI, tag)
+	return b
+}
+
+// FIBeneficiaryFIAdvice sets {6310} FIBeneficiaryFIAdvice
+func (b *ServiceMessageBuilder) FIBeneficiaryFIAdvice(tag *FIBeneficiaryFIAdvice) *ServiceMessageBuilder {
+	CloneTag(&b.fwm.FIBeneficiaryFIAdvice, tag)
+	return b
+}
+
+// FIBeneficiary sets {6400} FIBeneficiary
+func (b *ServiceMessageBuilder) FIBeneficiary(tag *FIBeneficiary) *ServiceMessageBuilder {
+	CloneTag(&b.fwm.FIBeneficiary, tag)
+	return b
+}
+
+// FIBeneficiaryAdvice sets {6410} FIBeneficiaryAdvice
+func (b *ServiceMessageBuilder) FIBeneficiaryAdvice(tag *FIBeneficiaryAdvice) *ServiceMessageBuilder {
+	CloneTag(&b.fwm.FIBeneficiaryAdvice, tag)
+	return b
+}
+
+// FIPaymentMethodToBeneficiary sets {6420} FIPaymentMethodToBeneficiary
+func (b *ServiceMessageBuilder) FIPaymentMethodToBeneficiary(tag *FIPaymentMethodToBeneficiary) *ServiceMessageBuilder {
+	CloneTag(&b.fwm.FIPaymentMethodToBeneficiary, tag)
+	return b
+}
+
+// FIAdditionalFIToFI sets {6500} FIAdditionalFIToFI
+func (b *ServiceMessageBuilder) FIAdditionalFIToFI(tag *FIAdditionalFIToFI) *ServiceMessageBuilder {
+	CloneTag(&b.fwm.FIAdditionalFIToFI, tag)
+	return b
+}
+
+// ServiceMessage sets {9000} ServiceMessage
+func (b *ServiceMessageBuilder) ServiceMessage(tag *ServiceMessage) *ServiceMessageBuilder {
+	CloneTag(&b.fwm.ServiceMessage, tag)
+	return b
+}
+
+// Build returns the message when it passes Validate, otherwise the errors of the setters and of Validate in a
+// base.ErrorList
+func (b *ServiceMessageBuilder) Build() (*FEDWireMessage, error) {
+	return b.build()
+}
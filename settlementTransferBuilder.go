@@ -0,0 +1,201 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+// SettlementTransferBuilder builds a CKS check same day settlement, DEP deposit to sender's account, FFR fed funds
+// returned or FFS fed funds sold, exposing only the tags they permit
+type SettlementTransferBuilder struct {
+	messageBuilder
+}
+
+// NewCheckSameDaySettlementBuilder returns a builder of a CKS check same day settlement with a default {1500}
+// SenderSupplied and {1510} TypeSubType 1600, a basic settlement transfer
+func NewCheckSameDaySettlementBuilder() *SettlementTransferBuilder {
+	b := &SettlementTransferBuilder{newMessageBuilder(CheckSameDaySettlement, SettlementTransfer, BasicFundsTransfer)}
+	return b
+}
+
+// NewDepositSendersAccountBuilder returns a builder of a DEP deposit to sender's account with a default {1500}
+// SenderSupplied and {1510} TypeSubType 1600, a basic settlement transfer
+func NewDepositSendersAccountBuilder() *SettlementTransferBuilder {
+	b := &SettlementTransferBuilder{newMessageBuilder(DepositSendersAccount, SettlementTransfer, BasicFundsTransfer)}
+	return b
+}
+
+// NewFEDFundsReturnedBuilder returns a builder of a FFR fed funds returned with a default {1500} SenderSupplied
+// and {1510} TypeSubType 1600, a basic settlement transfer
+func NewFEDFundsReturnedBuilder() *SettlementTransferBuilder {
+	b := &SettlementTransferBuilder{newMessageBuilder(FEDFundsReturned, SettlementTransfer, BasicFundsTransfer)}
+	return b
+}
+
+// NewFEDFundsSoldBuilder returns a builder of a FFS fed funds sold with a default {1500} SenderSupplied and
+// {1510} TypeSubType 1600, a basic settlement transfer
+func NewFEDFundsSoldBuilder() *SettlementTransferBuilder {
+	b := &SettlementTransferBuilder{newMessageBuilder(FEDFundsSold, SettlementTransfer, BasicFundsTransfer)}
+	return b
+}
+
+// SenderSupplied sets {1500} SenderSupplied
+func (b *SettlementTransferBuilder) SenderSupplied(tag *SenderSupplied) *SettlementTransferBuilder {
+	CloneTag(&b.fwm.SenderSupplied, tag)
+	return b
+}
+
+// UserRequestCorrelation sets the UserRequestCorrelation of {1500} SenderSupplied
+func (b *SettlementTransferBuilder) UserRequestCorrelation(id string) *SettlementTransferBuilder {
+	b.setUserRequestCorrelation(id)
+	return b
+}
+
+// TypeSubType sets {1510} TypeSubType to typeCode and subTypeCode
+func (b *SettlementTransferBuilder) TypeSubType(typeCode, subTypeCode string) *SettlementTransferBuilder {
+	b.setTypeSubType(typeCode, subTypeCode)
+	return b
+}
+
+// IMAD sets {1520} InputMessageAccountabilityData
+func (b *SettlementTransferBuilder) IMAD(tag *InputMessageAccountabilityData) *SettlementTransferBuilder {
+	CloneTag(&b.fwm.InputMessageAccountabilityData, tag)
+	return b
+}
+
+// Amount sets {2000} Amount in cents
+func (b *SettlementTransferBuilder) Amount(cents int64) *SettlementTransferBuilder {
+	b.setAmount(cents)
+	return b
+}
+
+// Sender sets {3100} SenderDepositoryInstitution to the ABA routing number and short name of the sender
+func (b *SettlementTransferBuilder) Sender(aba, shortName string) *SettlementTransferBuilder {
+	b.setSender(aba, shortName)
+	return b
+}
+
+// Receiver sets {3400} ReceiverDepositoryInstitution to the ABA routing number and short name of the receiver
+func (b *SettlementTransferBuilder) Receiver(aba, shortName string) *SettlementTransferBuilder {
+	b.setReceiver(aba, shortName)
+	return b
+}
+
+// SenderReference sets {3320} SenderReference
+func (b *SettlementTransferBuilder) SenderReference(ref string) *SettlementTransferBuilder {
+	b.setSenderReference(ref)
+	return b
+}
+
+// PreviousMessageIdentifier sets {3500} PreviousMessageIdentifier to the IMAD of the previous message
+func (b *SettlementTransferBuilder) PreviousMessageIdentifier(imad string) *SettlementTransferBuilder {
+	b.setPreviousMessageIdentifier(imad)
+	return b
+}
+
+// BeneficiaryIntermediaryFI sets {4000} BeneficiaryIntermediaryFI
+func (b *SettlementTransferBuilder) BeneficiaryIntermediaryFI(fi FinancialInstitution) *SettlementTransferBuilder {
+	b.setBeneficiaryIntermediaryFI(fi)
+	return b
+}
+
+// BeneficiaryFI sets {4100} BeneficiaryFI
+func (b *SettlementTransferBuilder) BeneficiaryFI(fi FinancialInstitution) *SettlementTransferBuilder {
+	b.setBeneficiaryFI(fi)
+	return b
+}
+
+// Beneficiary sets {4200} Beneficiary
+func (b *SettlementTransferBuilder) Beneficiary(p Personal) *SettlementTransferBuilder {
+	b.setBeneficiary(p)
+	return b
+}
+
+// BeneficiaryReference sets {4320} BeneficiaryReference
+func (b *SettlementTransferBuilder) BeneficiaryReference(ref string) *SettlementTransferBuilder {
+	b.setBeneficiaryReference(ref)
+	return b
+}
+
+// Originator sets {5000} Originator
+func (b *SettlementTransferBuilder) Originator(p Personal) *SettlementTransferBuilder {
+	b.setOriginator(p)
+	return b
+}
+
+// OriginatorFI sets {5100} OriginatorFI
+func (b *SettlementTransferBuilder) OriginatorFI(fi FinancialInstitution) *SettlementTransferBuilder {
+	b.setOriginatorFI(fi)
+	return b
+}
+
+// InstructingFI sets {5200} InstructingFI
+func (b *SettlementTransferBuilder) InstructingFI(fi FinancialInstitution) *SettlementTransferBuilder {
+	b.setInstructingFI(fi)
+	return b
+}
+
+// OriginatorToBeneficiary sets {6000} OriginatorToBeneficiary
+func (b *SettlementTransferBuilder) OriginatorToBeneficiary(tag *OriginatorToBeneficiary) *SettlementTransferBuilder {
+	CloneTag(&b.fwm.OriginatorToBeneficiary, tag)
+	return b
+}
+
+// FIReceiverFI sets {6100} FIReceiverFI
+func (b *SettlementTransferBuilder) FIReceiverFI(tag *FIReceiverFI) *SettlementTransferBuilder {
+	CloneTag(&b.fwm.FIReceiverFI, tag)
+	return b
+}
+
+// FIIntermediaryFI sets {6200} FIIntermediaryFI
+func (b *SettlementTransferBuilder) FIIntermediaryFI(tag *FIIntermediaryFI) *SettlementTransferBuilder {
+	CloneTag(&b.fwm.FIIntermediaryFI, tag)
+	return b
+}
+
+// FIIntermediaryFIAdvice sets {6210} FIIntermediaryFIAdvice
+func (b *SettlementTransferBuilder) FIIntermediaryFIAdvice(tag *FIIntermediaryFIAdvice) *SettlementTransferBuilder {
+	CloneTag(&b.fwm.FIIntermediaryFIAdvice, tag)
+	return b
+}
+
+// FIBeneficiaryFI sets {6300} FIBeneficiaryFI
+func (b *SettlementTransferBuilder) FIBeneficiaryFI(tag *FIBeneficiaryFI) *SettlementTransferBuilder {
+	CloneTag(&b.fwm.FIBeneficiaryFI, tag)
+	return b
+}
+
+// FIBeneficiaryFIAdvice sets {6310} FIBeneficiaryFIAdvice
+func (b *SettlementTransferBuilder) FIBeneficiaryFIAdvice(tag *FIBeneficiaryFIAdvice) *SettlementTransferBuilder {
+	CloneTag(&b.fwm.FIBeneficiaryFIAdvice, tag)
+	return b
+}
+
+// FIBeneficiary sets {6400} FIBeneficiary
+func (b *SettlementTransferBuilder) FIBeneficiary(tag *FIBeneficiary) *SettlementTransferBuilder {
+	CloneTag(&b.fwm.FIBeneficiary, tag)
+	return b
+}
+
+// FIBeneficiaryAdvice sets {6410} FIBeneficiaryAdvice
+func (b *SettlementTransferBuilder) FIBeneficiaryAdvice(tag *FIBeneficiaryAdvice) *SettlementTransferBuilder {
+	CloneTag(&b.fwm.FIBeneficiaryAdvice, tag)
+	return b
+}
+
+// FIPaymentMethodToBeneficiary sets {6420} FIPaymentMethodToBeneficiary
+func (b *SettlementTransferBuilder) FIPaymentMethodToBeneficiary(tag *FIPaymentMethodToBeneficiary) *SettlementTransferBuilder {
+	CloneTag(&b.fwm.FIPaymentMethodToBeneficiary, tag)
+	return b
+}
+
+// FIAdditionalFIToFI sets {6500} FIAdditionalFIToFI
+func (b *SettlementTransferBuilder) FIAdditionalFIToFI(tag *FIAdditionalFIToFI) *SettlementTransferBuilder {
+	CloneTag(&b.fwm.FIAdditionalFIToFI, tag)
+	return b
+}
+
+// Build returns the message when it passes Validate, otherwise the errors of the setters and of Validate in a
+// base.ErrorList
+func (b *SettlementTransferBuilder) Build() (*FEDWireMessage, error) {
+	return b.build()
+}
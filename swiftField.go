@@ -0,0 +1,421 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	bicRegex  = regexp.MustCompile(`^[A-Z]{6}[A-Z2-9][A-NP-Z0-9]([A-Z0-9]{3})?$`)
+	ibanRegex = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}$`)
+)
+
+// swiftParty is a party or financial institution decoded from the lines of a SWIFT field
+// (e.g. 50K, 52A, 57D, 59F) as carried by the {7xxx} CoverPayment tags.
+type swiftParty struct {
+	// Account is the account number from a leading "/account" line
+	Account string
+	// ClearingCode is a two character national clearing code (e.g. FW, CH) from a leading "//FW123456789" line
+	ClearingCode string
+	// ClearingID is the member identifier following ClearingCode
+	ClearingID string
+	// PartyIdentifier is a 50F/59F party identifier which is not an account (e.g. CCPT/US/123456789)
+	PartyIdentifier string
+	// BIC is the SWIFT Bank Identifier Code of an option A field
+	BIC string
+	// Name of the party
+	Name string
+	// AddressLines holds unstructured address lines
+	AddressLines []string
+	// Country is the ISO 3166 country code of a 50F/59F "3/" line
+	Country string
+	// Town is the town of a 50F/59F "3/" line
+	Town string
+}
+
+// swiftFieldOption splits a SWIFT field tag such as "50K" or ":59F:" into its number and option letter.
+func swiftFieldOption(tag string) (field, option string) {
+	tag = strings.ToUpper(strings.Trim(strings.TrimSpace(tag), ":"))
+	if len(tag) < 2 {
+		return tag, ""
+	}
+	return tag[:2], tag[2:]
+}
+
+// decodeSwiftParty decodes the lines of a SWIFT party or institution field according to its option.
+func decodeSwiftParty(tag string, lines []string) swiftParty {
+	var p swiftParty
+	_, option := swiftFieldOption(tag)
+
+	var remaining []string
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			remaining = append(remaining, line)
+		}
+	}
+	if len(remaining) > 0 {
+		first := remaining[0]
+		switch {
+		case strings.HasPrefix(first, "//") && len(first) > 4:
+			p.ClearingCode = first[2:4]
+			p.ClearingID = first[4:]
+			remaining = remaining[1:]
+		case strings.HasPrefix(first, "/"):
+			p.Account = strings.TrimPrefix(first, "/")
+			remaining = remaining[1:]
+		case option == "F" && !strings.HasPrefix(first, "1/"):
+			p.PartyIdentifier = first
+			remaining = remaining[1:]
+		}
+	}
+
+	switch option {
+	case "A":
+		if len(remaining) > 0 {
+			p.BIC = remaining[0]
+		}
+	case "F":
+		for _, line := range remaining {
+			if len(line) < 2 || line[1] != '/' {
+				p.AddressLines = append(p.AddressLines, line)
+				continue
+			}
+			value := line[2:]
+			switch line[:1] {
+			case OptionFName:
+				p.Name = strings.TrimSpace(p.Name + " " + value)
+			case OptionFAddress:
+				p.AddressLines = append(p.AddressLines, value)
+			case OptionFCountryTown:
+				if idx := strings.Index(value, "/"); idx >= 0 {
+					p.Country = value[:idx]
+					p.Town = value[idx+1:]
+				} else {
+					p.Country = value
+				}
+			default:
+				p.AddressLines = append(p.AddressLines, line)
+			}
+		}
+	default:
+		if len(remaining) > 0 {
+			p.Name = remaining[0]
+			p.AddressLines = append(p.AddressLines, remaining[1:]...)
+		}
+	}
+	return p
+}
+
+// identification returns the Fedwire identification code and identifier of the party: a BIC (B or T), a Fed routing
+// number (F) or CHIPS identifier (C, U) clearing code, an account (D) or a party identifier, which is mapped to a
+// personal identification code when it has one and is otherwise other identification (9). A party with another
+// clearing code has no identification.
+func (p swiftParty) identification() (code, identifier string) {
+	switch {
+	case p.BIC != "":
+		if p.Account != "" {
+			return SWIFTBICORBEIANDAccountNumber, p.BIC + "/" + p.Account
+		}
+		return SWIFTBankIdentifierCode, p.BIC
+	case p.ClearingCode == "FW":
+		return FEDRoutingNumber, p.ClearingID
+	case p.ClearingCode == "CP":
+		return CHIPSParticipant, p.ClearingID
+	case p.ClearingCode == "CH":
+		return CHIPSIdentifier, p.ClearingID
+	case p.ClearingCode != "":
+		return "", ""
+	case p.Account != "":
+		return DemandDepositAccountNumber, p.Account
+	case p.PartyIdentifier != "":
+		for fedCode, swiftCode := range swiftPartyIdentifierCodes {
+			if strings.HasPrefix(p.PartyIdentifier, swiftCode+"/") {
+				return fedCode, strings.TrimPrefix(p.PartyIdentifier, swiftCode+"/")
+			}
+		}
+		return OtherIdentification, p.PartyIdentifier
+	}
+	return "", ""
+}
+
+// encode returns the SWIFT field tag and lines for the party using the most specific option
+// available for the given field number (e.g. "50", "52", "59").
+func (p swiftParty) encode(field string) (string, []string) {
+	var lines []string
+	switch {
+	case p.ClearingCode != "":
+		lines = append(lines, "//"+p.ClearingCode+p.ClearingID)
+	case p.Account != "":
+		lines = append(lines, "/"+p.Account)
+	}
+
+	isParty := field == "50" || field == "59"
+	switch {
+	case p.BIC != "":
+		return field + "A", append(lines, p.BIC)
+	case isParty && (p.Country != "" || p.Town != "" || p.PartyIdentifier != ""):
+		if p.PartyIdentifier != "" && len(lines) == 0 {
+			lines = append(lines, p.PartyIdentifier)
+		}
+		if p.Name != "" {
+			lines = append(lines, OptionFName+"/"+p.Name)
+		}
+		for _, line := range p.AddressLines {
+			lines = append(lines, OptionFAddress+"/"+line)
+		}
+		if p.Country != "" {
+			lines = append(lines, OptionFCountryTown+"/"+strings.TrimSuffix(p.Country+"/"+p.Town, "/"))
+		}
+		return field + "F", lines
+	}
+
+	if p.Name != "" {
+		lines = append(lines, p.Name)
+	}
+	lines = append(lines, p.AddressLines...)
+	switch field {
+	case "50":
+		return field + "K", lines
+	case "59":
+		return field, lines
+	}
+	return field + "D", lines
+}
+
+// coverPaymentLines returns the non-empty SWIFT lines of a CoverPayment in order
+func coverPaymentLines(cp CoverPayment) []string {
+	var lines []string
+	for _, line := range []string{cp.SwiftLineOne, cp.SwiftLineTwo, cp.SwiftLineThree,
+		cp.SwiftLineFour, cp.SwiftLineFive, cp.SwiftLineSix} {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// newCoverPayment builds a CoverPayment from a SWIFT field tag and up to max lines
+func newCoverPayment(tag string, lines []string, max int) CoverPayment {
+	cp := CoverPayment{SwiftFieldTag: tag}
+	targets := []*string{&cp.SwiftLineOne, &cp.SwiftLineTwo, &cp.SwiftLineThree,
+		&cp.SwiftLineFour, &cp.SwiftLineFive, &cp.SwiftLineSix}
+	for i := 0; i < len(lines) && i < max && i < len(targets); i++ {
+		*targets[i] = lines[i]
+	}
+	return cp
+}
+
+// swiftFieldOptions lists the SWIFT field options permitted in the SwiftFieldTag of each cover payment tag
+var swiftFieldOptions = map[string][]string{
+	TagOrderingCustomer:        {"50A", "50F", "50K"},
+	TagOrderingInstitution:     {"52A", "52D"},
+	TagIntermediaryInstitution: {"56A", "56D"},
+	TagInstitutionAccount:      {"57A", "57B", "57D"},
+	TagBeneficiaryCustomer:     {"59", "59A", "59F"},
+	TagRemittance:              {"70"},
+	TagSenderToReceiver:        {"72"},
+}
+
+var (
+	// swiftCountryTownRegex matches a 50F/59F "3/" line, e.g. 3/US/NEW YORK
+	swiftCountryTownRegex = regexp.MustCompile(`^3/[A-Z]{2}(/.+)?$`)
+	// swiftDateOfBirthRegex matches a 50F "4/" line, e.g. 4/19800101
+	swiftDateOfBirthRegex = regexp.MustCompile(`^4/[0-9]{8}$`)
+	// swiftCountryLineRegex matches a 50F "5/", "6/" or "7/" line, e.g. 5/US/POTTSTOWN
+	swiftCountryLineRegex = regexp.MustCompile(`^[5-7]/[A-Z]{2}/.+$`)
+	// swiftCodeWordRegex matches the /8c/ code word starting a field 72 line, e.g. /ACC/
+	swiftCodeWordRegex = regexp.MustCompile(`^/[A-Z0-9]{1,8}/`)
+)
+
+// swiftLine is a non-empty SWIFT line of a CoverPayment and the name of the field holding it
+type swiftLine struct {
+	field string
+	value string
+}
+
+// coverPaymentFieldLines returns the non-empty SWIFT lines of a CoverPayment along with their field names
+func coverPaymentFieldLines(cp CoverPayment) []swiftLine {
+	var lines []swiftLine
+	for _, line := range []swiftLine{
+		{"SwiftLineOne", cp.SwiftLineOne},
+		{"SwiftLineTwo", cp.SwiftLineTwo},
+		{"SwiftLineThree", cp.SwiftLineThree},
+		{"SwiftLineFour", cp.SwiftLineFour},
+		{"SwiftLineFive", cp.SwiftLineFive},
+		{"SwiftLineSix", cp.SwiftLineSix},
+	} {
+		if line.value = strings.TrimSpace(line.value); line.value != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// swiftLineError returns ErrSwiftFieldLine for the line
+func swiftLineError(line swiftLine) error {
+	return fieldError(line.field, ErrSwiftFieldLine, line.value)
+}
+
+// isSwiftAccountLine reports if the line is a "/account" or "//clearing code" party identifier line
+func isSwiftAccountLine(s string) bool {
+	return strings.HasPrefix(s, "/") && strings.TrimLeft(s, "/") != ""
+}
+
+// isSwiftFieldOption checks the SWIFT field tag is one of the options permitted for the cover payment tag
+func (v *validator) isSwiftFieldOption(tag, swiftFieldTag string) error {
+	swiftFieldTag = strings.Trim(strings.TrimSpace(swiftFieldTag), ":")
+	for _, permitted := range swiftFieldOptions[tag] {
+		if swiftFieldTag == permitted {
+			return nil
+		}
+	}
+	return ErrSwiftFieldOption
+}
+
+// validateCoverPayment validates the SwiftFieldTag of a cover payment tag is a SWIFT field option permitted
+// for the tag and that the SWIFT lines follow the line structure of the option:
+//
+// Option A: [/account or //clearing code] followed by a BIC, e.g. 52A, 59A
+// Option B: [/account] followed by a location, i.e. 57B
+// Option D, K and 59: [/account] followed by up to four name and address lines
+// Option F: a party identifier for 50F, or [/account] for 59F, followed by numbered lines starting with 1/name
+// Field 70: remittance information
+// Field 72: lines starting with a /code word/ or a // continuation
+func (v *validator) validateCoverPayment(tag string, cp CoverPayment) error {
+	lines := coverPaymentFieldLines(cp)
+	if strings.TrimSpace(cp.SwiftFieldTag) == "" {
+		if len(lines) > 0 {
+			return fieldError("SwiftFieldTag", ErrFieldRequired, cp.SwiftFieldTag)
+		}
+		return nil
+	}
+	if err := v.isSwiftFieldOption(tag, cp.SwiftFieldTag); err != nil {
+		return fieldError("SwiftFieldTag", err, cp.SwiftFieldTag)
+	}
+	if len(lines) == 0 {
+		return fieldError("SwiftLineOne", ErrFieldRequired, cp.SwiftLineOne)
+	}
+
+	field, option := swiftFieldOption(cp.SwiftFieldTag)
+	switch {
+	case field == "70":
+		return nil
+	case field == "72":
+		return validateSwiftCodeWordLines(lines)
+	case option == "A":
+		return validateSwiftOptionA(lines)
+	case option == "B":
+		return validateSwiftOptionB(lines)
+	case option == "F":
+		return v.validateSwiftOptionF(field, lines)
+	}
+	return validateSwiftNameAddress(lines)
+}
+
+// validateSwiftOptionA validates [/account or //clearing code] followed by a BIC
+func validateSwiftOptionA(lines []swiftLine) error {
+	if len(lines) > 2 {
+		return swiftLineError(lines[2])
+	}
+	if len(lines) == 2 && !isSwiftAccountLine(lines[0].value) {
+		return swiftLineError(lines[0])
+	}
+	if bic := lines[len(lines)-1]; !bicRegex.MatchString(bic.value) {
+		return swiftLineError(bic)
+	}
+	return nil
+}
+
+// validateSwiftOptionB validates [/account] followed by an optional location
+func validateSwiftOptionB(lines []swiftLine) error {
+	if len(lines) > 2 {
+		return swiftLineError(lines[2])
+	}
+	if len(lines) == 2 && !isSwiftAccountLine(lines[0].value) {
+		return swiftLineError(lines[0])
+	}
+	return nil
+}
+
+// validateSwiftNameAddress validates [/account] followed by one to four name and address lines
+func validateSwiftNameAddress(lines []swiftLine) error {
+	names := lines
+	if isSwiftAccountLine(lines[0].value) {
+		names = lines[1:]
+	}
+	if len(names) == 0 {
+		return swiftLineError(lines[0])
+	}
+	if len(names) > 4 {
+		return swiftLineError(names[4])
+	}
+	return nil
+}
+
+// validateSwiftOptionF validates the numbered lines of 50F and 59F. 50F starts with a party identifier and
+// permits line codes 1 to 8, 59F starts with an optional /account and permits line codes 1 to 3. Line codes
+// must be in ascending order, with the first being the 1/ name.
+func (v *validator) validateSwiftOptionF(field string, lines []swiftLine) error {
+	numbered := lines
+	switch {
+	case field == "50":
+		if err := v.validatePartyIdentifier(lines[0].value); err != nil {
+			return swiftLineError(lines[0])
+		}
+		numbered = lines[1:]
+	case isSwiftAccountLine(lines[0].value):
+		numbered = lines[1:]
+	}
+	if len(numbered) == 0 {
+		return swiftLineError(lines[len(lines)-1])
+	}
+	if err := v.validateOptionFName(numbered[0].value); err != nil {
+		return swiftLineError(numbered[0])
+	}
+
+	previous := OptionFName
+	for _, line := range numbered[1:] {
+		if err := v.validateOptionFLine(line.value); err != nil {
+			return swiftLineError(line)
+		}
+		code := line.value[:1]
+		if code < previous || (field == "59" && code > OptionFCountryTown) {
+			return swiftLineError(line)
+		}
+		switch code {
+		case OptionFCountryTown:
+			if !swiftCountryTownRegex.MatchString(line.value) {
+				return swiftLineError(line)
+			}
+		case OptionFDOB:
+			if !swiftDateOfBirthRegex.MatchString(line.value) {
+				return swiftLineError(line)
+			}
+		case OptionFBirthPlace, OptionFCustomerIdentificationNumber, OptionFNationalIdentityNumber:
+			if !swiftCountryLineRegex.MatchString(line.value) {
+				return swiftLineError(line)
+			}
+		}
+		previous = code
+	}
+	return nil
+}
+
+// validateSwiftCodeWordLines validates field 72 lines: the first starts with a /code word/ and the
+// remaining lines start with either a /code word/ or a // continuation
+func validateSwiftCodeWordLines(lines []swiftLine) error {
+	for i, line := range lines {
+		if swiftCodeWordRegex.MatchString(line.value) {
+			continue
+		}
+		if i > 0 && strings.HasPrefix(line.value, "//") && len(line.value) > 2 {
+			continue
+		}
+		return swiftLineError(line)
+	}
+	return nil
+}
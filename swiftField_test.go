@@ -0,0 +1,125 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSwiftFieldOption(t *testing.T) {
+	field, option := swiftFieldOption(":50K:")
+	require.Equal(t, "50", field)
+	require.Equal(t, "K", option)
+
+	field, option = swiftFieldOption("59")
+	require.Equal(t, "59", field)
+	require.Equal(t, "", option)
+}
+
+func TestDecodeSwiftParty(t *testing.T) {
+	p := decodeSwiftParty("50F", []string{"/123456789", "1/Jane Doe", "2/100 Main Street", "3/US/New York"})
+	require.Equal(t, "123456789", p.Account)
+	require.Equal(t, "Jane Doe", p.Name)
+	require.Equal(t, []string{"100 Main Street"}, p.AddressLines)
+	require.Equal(t, "US", p.Country)
+	require.Equal(t, "New York", p.Town)
+
+	p = decodeSwiftParty("50F", []string{"CCPT/US/123456789", "1/Jane Doe"})
+	require.Equal(t, "CCPT/US/123456789", p.PartyIdentifier)
+	require.Equal(t, "Jane Doe", p.Name)
+
+	p = decodeSwiftParty("52A", []string{"//FW121042882", "WFBIUS6S"})
+	require.Equal(t, "FW", p.ClearingCode)
+	require.Equal(t, "121042882", p.ClearingID)
+	require.Equal(t, "WFBIUS6S", p.BIC)
+
+	p = decodeSwiftParty("57D", []string{"Deutsche Bank AG", "Frankfurt am Main"})
+	require.Equal(t, "Deutsche Bank AG", p.Name)
+	require.Equal(t, []string{"Frankfurt am Main"}, p.AddressLines)
+}
+
+func TestSwiftPartyEncode(t *testing.T) {
+	tag, lines := swiftParty{Account: "123", Name: "Jane Doe", AddressLines: []string{"100 Main Street"}}.encode("50")
+	require.Equal(t, "50K", tag)
+	require.Equal(t, []string{"/123", "Jane Doe", "100 Main Street"}, lines)
+
+	tag, lines = swiftParty{Name: "Jane Doe", Country: "US", Town: "New York"}.encode("59")
+	require.Equal(t, "59F", tag)
+	require.Equal(t, []string{"1/Jane Doe", "3/US/New York"}, lines)
+
+	tag, lines = swiftParty{BIC: "DEUTDEFF"}.encode("57")
+	require.Equal(t, "57A", tag)
+	require.Equal(t, []string{"DEUTDEFF"}, lines)
+
+	tag, lines = swiftParty{Name: "Deutsche Bank AG"}.encode("56")
+	require.Equal(t, "56D", tag)
+	require.Equal(t, []string{"Deutsche Bank AG"}, lines)
+
+	cp := newCoverPayment(tag, []string{"One", "Two", "Three"}, 2)
+	require.Equal(t, []string{"One", "Two"}, coverPaymentLines(cp))
+}
+
+func TestValidateCoverPayment(t *testing.T) {
+	v := &validator{}
+	valid := []struct {
+		tag string
+		cp  CoverPayment
+	}{
+		{TagOrderingCustomer, newCoverPayment("50A", []string{"/123456789", "WFBIUS6S"}, 5)},
+		{TagOrderingCustomer, newCoverPayment("50F", []string{"/123456789", "1/Jane Doe", "1/Smith", "2/100 Main Street", "3/US/New York"}, 5)},
+		{TagOrderingCustomer, newCoverPayment("50F", []string{"CCPT/US/123456789", "1/Jane Doe", "4/19800101", "5/US/Pottstown"}, 5)},
+		{TagOrderingCustomer, newCoverPayment("50K", []string{"/123456789", "Jane Doe", "100 Main Street"}, 5)},
+		{TagOrderingInstitution, newCoverPayment("52A", []string{"//FW121042882", "WFBIUS6SXXX"}, 5)},
+		{TagOrderingInstitution, newCoverPayment("52D", []string{"Wells Fargo NA"}, 5)},
+		{TagInstitutionAccount, newCoverPayment("57B", []string{"/123456789", "New York"}, 5)},
+		{TagBeneficiaryCustomer, newCoverPayment("59", []string{"/123456789", "John Doe"}, 5)},
+		{TagBeneficiaryCustomer, newCoverPayment("59F", []string{"/123456789", "1/John Doe", "2/Taunusanlage 12", "3/DE/Frankfurt"}, 5)},
+		{TagRemittance, newCoverPayment("70", []string{"/INV/2019-0410"}, 4)},
+		{TagSenderToReceiver, newCoverPayment("72", []string{"/ACC/Line One", "//Line Two"}, 6)},
+		{TagSenderToReceiver, CoverPayment{}},
+	}
+	for _, tt := range valid {
+		require.NoError(t, v.validateCoverPayment(tt.tag, tt.cp), tt.cp.SwiftFieldTag)
+	}
+
+	invalid := []struct {
+		tag   string
+		cp    CoverPayment
+		field string
+		err   error
+	}{
+		{TagOrderingCustomer, newCoverPayment("50D", []string{"Jane Doe"}, 5), "SwiftFieldTag", ErrSwiftFieldOption},
+		{TagOrderingInstitution, newCoverPayment("57A", []string{"WFBIUS6S"}, 5), "SwiftFieldTag", ErrSwiftFieldOption},
+		{TagBeneficiaryCustomer, newCoverPayment("59K", []string{"John Doe"}, 5), "SwiftFieldTag", ErrSwiftFieldOption},
+		{TagRemittance, newCoverPayment("72", []string{"/ACC/Line One"}, 4), "SwiftFieldTag", ErrSwiftFieldOption},
+		{TagOrderingCustomer, CoverPayment{SwiftLineOne: "Jane Doe"}, "SwiftFieldTag", ErrFieldRequired},
+		{TagRemittance, CoverPayment{SwiftFieldTag: "70"}, "SwiftLineOne", ErrFieldRequired},
+		{TagOrderingCustomer, newCoverPayment("50A", []string{"WELLS FARGO"}, 5), "SwiftLineOne", ErrSwiftFieldLine},
+		{TagOrderingCustomer, newCoverPayment("50A", []string{"123456789", "WFBIUS6S"}, 5), "SwiftLineOne", ErrSwiftFieldLine},
+		{TagIntermediaryInstitution, newCoverPayment("56A", []string{"/123", "CHASUS33", "New York"}, 5), "SwiftLineThree", ErrSwiftFieldLine},
+		{TagOrderingCustomer, newCoverPayment("50F", []string{"1/Jane Doe"}, 5), "SwiftLineOne", ErrSwiftFieldLine},
+		{TagOrderingCustomer, newCoverPayment("50F", []string{"/123456789", "2/100 Main Street"}, 5), "SwiftLineTwo", ErrSwiftFieldLine},
+		{TagOrderingCustomer, newCoverPayment("50F", []string{"/123456789", "1/Jane Doe", "3/US/New York", "2/100 Main Street"}, 5), "SwiftLineFour", ErrSwiftFieldLine},
+		{TagOrderingCustomer, newCoverPayment("50F", []string{"/123456789", "1/Jane Doe", "3/New York"}, 5), "SwiftLineThree", ErrSwiftFieldLine},
+		{TagOrderingCustomer, newCoverPayment("50F", []string{"/123456789", "1/Jane Doe", "4/1980-01-01"}, 5), "SwiftLineThree", ErrSwiftFieldLine},
+		{TagOrderingCustomer, newCoverPayment("50F", []string{"/123456789", "1/Jane Doe", "9/Other"}, 5), "SwiftLineThree", ErrSwiftFieldLine},
+		{TagBeneficiaryCustomer, newCoverPayment("59F", []string{"1/John Doe", "4/19800101"}, 5), "SwiftLineTwo", ErrSwiftFieldLine},
+		{TagOrderingInstitution, newCoverPayment("52D", []string{"/123456789"}, 5), "SwiftLineOne", ErrSwiftFieldLine},
+		{TagBeneficiaryCustomer, newCoverPayment("59", []string{"One", "Two", "Three", "Four", "Five"}, 5), "SwiftLineFive", ErrSwiftFieldLine},
+		{TagInstitutionAccount, newCoverPayment("57B", []string{"New York", "Frankfurt"}, 5), "SwiftLineOne", ErrSwiftFieldLine},
+		{TagSenderToReceiver, newCoverPayment("72", []string{"Line One"}, 6), "SwiftLineOne", ErrSwiftFieldLine},
+		{TagSenderToReceiver, newCoverPayment("72", []string{"/ACC/Line One", "Line Two"}, 6), "SwiftLineTwo", ErrSwiftFieldLine},
+	}
+	for _, tt := range invalid {
+		err := v.validateCoverPayment(tt.tag, tt.cp)
+		require.Error(t, err, tt.cp)
+		require.True(t, strings.HasPrefix(err.Error(), tt.field+" "), err.Error())
+		require.True(t, errors.Is(err, tt.err), err.Error())
+	}
+}
@@ -0,0 +1,451 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+)
+
+const (
+	// MT103 is the SWIFT single customer credit transfer
+	MT103 = "103"
+	// MT202 is the SWIFT general financial institution transfer
+	MT202 = "202"
+	// MT202COV is the SWIFT general financial institution transfer for the cover of a customer credit transfer
+	MT202COV = "202COV"
+
+	// swiftNoReference is used for mandatory SWIFT references that are not present in the Fedwire message
+	swiftNoReference = "NONREF"
+)
+
+var (
+	// ErrSwiftMessageType is returned when a SWIFT message type has no mapping to a Fedwire message
+	ErrSwiftMessageType = errors.New("is an unsupported SWIFT message type")
+	// ErrSwiftBusinessFunctionCode is returned when a FEDWireMessage business function code has no mapping to the requested SWIFT message
+	ErrSwiftBusinessFunctionCode = errors.New("has no mapping to the requested SWIFT message")
+	// ErrSwiftCurrency is returned when a SWIFT settlement amount is not in US dollars
+	ErrSwiftCurrency = errors.New("is not a USD settlement amount")
+	// ErrSwiftField is returned when a SWIFT field is malformed
+	ErrSwiftField = errors.New("is not a valid SWIFT field")
+)
+
+// SwiftField is a field of the text block of a SWIFT MT message
+type SwiftField struct {
+	// Tag is the field tag and option, e.g. 20, 32A or 50K
+	Tag string `json:"tag"`
+	// Lines are the lines of the field content
+	Lines []string `json:"lines"`
+}
+
+// SwiftMessage is the text block (block 4) of a SWIFT MT103, MT202 or MT202COV message
+type SwiftMessage struct {
+	// MessageType is MT103, MT202 or MT202COV
+	MessageType string `json:"messageType"`
+	// Fields are the fields of the message, for MT202COV the fields of sequence A
+	Fields []SwiftField `json:"fields"`
+	// SequenceB are the fields of MT202COV sequence B, the underlying customer credit transfer
+	SequenceB []SwiftField `json:"sequenceB,omitempty"`
+}
+
+// SwiftUnmapped is a Fedwire tag or SWIFT field which could not be carried across a conversion
+type SwiftUnmapped struct {
+	// Field is the Fedwire tag or element (e.g. ServiceMessage, Originator.Personal.Name) or the SWIFT field tag (e.g. 23E)
+	Field string `json:"field"`
+	// Value is the content which was not mapped
+	Value string `json:"value"`
+}
+
+// String writes SwiftUnmapped
+func (u SwiftUnmapped) String() string {
+	return u.Field + ": " + u.Value
+}
+
+// ParseSwiftMessage reads the text block of a SWIFT message of the given type.
+//
+// Fields start with a ":tag:" line and continue until the next one, lines may be separated by CRLF or LF. An
+// enclosing "{4:" and "-}" are ignored. For MT202COV the fields from the first 50a field on are sequence B.
+func ParseSwiftMessage(messageType, text string) (*SwiftMessage, error) {
+	switch messageType {
+	case MT103, MT202, MT202COV:
+	default:
+		return nil, fieldError("MessageType", ErrSwiftMessageType, messageType)
+	}
+	msg := &SwiftMessage{MessageType: messageType}
+	text = strings.TrimSpace(strings.Replace(text, "\r\n", "\n", -1))
+	text = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(text, "{4:"), "-}"))
+
+	var fields []SwiftField
+	for _, line := range strings.Split(text, "\n") {
+		if strings.HasPrefix(line, ":") {
+			if idx := strings.Index(line[1:], ":"); idx > 0 {
+				fields = append(fields, SwiftField{Tag: line[1 : idx+1], Lines: []string{line[idx+2:]}})
+				continue
+			}
+		}
+		if len(fields) == 0 {
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+			return nil, fieldError("Fields", ErrSwiftField, line)
+		}
+		fields[len(fields)-1].Lines = append(fields[len(fields)-1].Lines, line)
+	}
+
+	for i, f := range fields {
+		if messageType == MT202COV && swiftTagMatches(f.Tag, "50a") {
+			msg.Fields, msg.SequenceB = fields[:i], fields[i:]
+			return msg, nil
+		}
+	}
+	msg.Fields = fields
+	return msg, nil
+}
+
+// String writes the text block of a SwiftMessage with CRLF line endings
+func (msg *SwiftMessage) String() string {
+	var buf strings.Builder
+	for _, f := range append(append([]SwiftField{}, msg.Fields...), msg.SequenceB...) {
+		buf.WriteString(":" + f.Tag + ":")
+		buf.WriteString(strings.Join(f.Lines, "\r\n"))
+		buf.WriteString("\r\n")
+	}
+	return buf.String()
+}
+
+// ToSwift converts a FEDWireMessage into the SWIFT message for its business function code: an MT202COV for a CTP
+// with local instrument COVS, an MT103 for other CTR and CTP messages and an MT202 for a BTR. The Fedwire tags
+// which have no SWIFT field are returned as unmapped.
+func (fwm *FEDWireMessage) ToSwift() (*SwiftMessage, []SwiftUnmapped, error) {
+	if fwm.BusinessFunctionCode == nil {
+		return nil, nil, fieldError("BusinessFunctionCode", ErrFieldRequired)
+	}
+	switch fwm.BusinessFunctionCode.BusinessFunctionCode {
+	case BankTransfer:
+		return fwm.ToMT202()
+	case CustomerTransferPlus:
+		if fwm.LocalInstrument != nil && fwm.LocalInstrument.LocalInstrumentCode == SequenceBCoverPaymentStructured {
+			return fwm.ToMT202COV()
+		}
+	}
+	return fwm.ToMT103()
+}
+
+// FEDWireMessageFromSwift converts an MT103, MT202 or MT202COV into a FEDWireMessage. The SWIFT fields which have
+// no Fedwire tag are returned as unmapped.
+func FEDWireMessageFromSwift(msg *SwiftMessage) (*FEDWireMessage, []SwiftUnmapped, error) {
+	if msg == nil {
+		return nil, nil, fieldError("SwiftMessage", ErrFieldRequired)
+	}
+	switch msg.MessageType {
+	case MT103:
+		return FEDWireMessageFromMT103(msg)
+	case MT202:
+		return FEDWireMessageFromMT202(msg)
+	case MT202COV:
+		return FEDWireMessageFromMT202COV(msg)
+	}
+	return nil, nil, fieldError("MessageType", ErrSwiftMessageType, msg.MessageType)
+}
+
+// Field returns the first field matching tag, a lowercase "a" option (e.g. 50a) matches every option of the field.
+// It returns nil when the message has no such field.
+func (msg *SwiftMessage) Field(tag string) *SwiftField {
+	return swiftFieldByTag(msg.Fields, tag)
+}
+
+// swiftFieldByTag returns the first field matching tag, see SwiftMessage.Field
+func swiftFieldByTag(fields []SwiftField, tag string) *SwiftField {
+	for i := range fields {
+		if swiftTagMatches(fields[i].Tag, tag) {
+			return &fields[i]
+		}
+	}
+	return nil
+}
+
+// swiftTagMatches reports whether a field tag matches tag, a lowercase "a" option matches every option
+func swiftTagMatches(fieldTag, tag string) bool {
+	if strings.HasSuffix(tag, "a") {
+		number, _ := swiftFieldOption(fieldTag)
+		return number == strings.TrimSuffix(tag, "a")
+	}
+	return fieldTag == tag
+}
+
+// swiftUnmappedFields reports every field whose tag does not match one of the mapped tags
+func swiftUnmappedFields(fields []SwiftField, mapped ...string) []SwiftUnmapped {
+	var unmapped []SwiftUnmapped
+	for _, f := range fields {
+		found := false
+		for _, tag := range mapped {
+			if swiftTagMatches(f.Tag, tag) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unmapped = append(unmapped, SwiftUnmapped{Field: f.Tag, Value: strings.Join(f.Lines, "\n")})
+		}
+	}
+	return unmapped
+}
+
+// swiftUnmappedTags reports every tag of a FEDWireMessage which is not one of the mapped tags
+func (fwm *FEDWireMessage) swiftUnmappedTags(mapped ...string) []SwiftUnmapped {
+	var unmapped []SwiftUnmapped
+	v := reflect.ValueOf(fwm).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if f.Kind() != reflect.Ptr || f.IsNil() {
+			continue
+		}
+		name := v.Type().Field(i).Name
+		found := false
+		for _, m := range mapped {
+			if m == name {
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+		value := ""
+		if s, ok := f.Interface().(interface{ String() string }); ok {
+			value = s.String()
+		}
+		unmapped = append(unmapped, SwiftUnmapped{Field: name, Value: value})
+	}
+	return unmapped
+}
+
+// swiftValueDateAmount returns the 32A value date, currency and amount of a FEDWireMessage
+func (fwm *FEDWireMessage) swiftValueDateAmount() (SwiftField, error) {
+	if fwm.InputMessageAccountabilityData == nil || len(fwm.InputMessageAccountabilityData.InputCycleDate) != 8 {
+		return SwiftField{}, fieldError("InputMessageAccountabilityData", ErrFieldRequired)
+	}
+	if fwm.Amount == nil {
+		return SwiftField{}, fieldError("Amount", ErrFieldRequired)
+	}
+	date := fwm.InputMessageAccountabilityData.InputCycleDate[2:]
+	amount := commaFromISODecimal(isoDecimalFromImplied(fwm.Amount.Amount))
+	return SwiftField{Tag: "32A", Lines: []string{date + isoCurrencyUSD + amount}}, nil
+}
+
+// setFromSwiftValueDateAmount populates {1520} IMAD cycle date and {2000} Amount from a 32A field
+func (fwm *FEDWireMessage) setFromSwiftValueDateAmount(f *SwiftField) error {
+	if f == nil {
+		return fieldError("32A", ErrFieldRequired)
+	}
+	value := strings.TrimSpace(strings.Join(f.Lines, ""))
+	if len(value) < 10 {
+		return fieldError("32A", ErrSwiftField, value)
+	}
+	if currency := value[6:9]; currency != isoCurrencyUSD {
+		return fieldError("32A", ErrSwiftCurrency, currency)
+	}
+	amount, err := impliedFromISODecimal(isoDecimalFromComma(value[9:]))
+	if err != nil {
+		return fieldError("32A", err, value)
+	}
+	fwm.InputMessageAccountabilityData = NewInputMessageAccountabilityData()
+	fwm.InputMessageAccountabilityData.InputCycleDate = "20" + value[:6]
+	fwm.Amount = NewAmount()
+	fwm.Amount.Amount = amount
+	return nil
+}
+
+// swiftSenderReference returns the 20 field of a FEDWireMessage, NONREF without {3320} SenderReference
+func (fwm *FEDWireMessage) swiftSenderReference() SwiftField {
+	ref := swiftNoReference
+	if fwm.SenderReference != nil && strings.TrimSpace(fwm.SenderReference.SenderReference) != "" {
+		ref = strings.TrimSpace(fwm.SenderReference.SenderReference)
+	}
+	return SwiftField{Tag: "20", Lines: []string{ref}}
+}
+
+// setFromSwiftSenderReference populates {3320} SenderReference from a 20 field
+func (fwm *FEDWireMessage) setFromSwiftSenderReference(f *SwiftField) {
+	if ref := swiftFieldValue(f); ref != "" && ref != swiftNoReference {
+		fwm.SenderReference = NewSenderReference()
+		fwm.SenderReference.SenderReference = ref
+	}
+}
+
+// swiftFieldValue returns the lines of a single line field, it returns an empty string for a nil field
+func swiftFieldValue(f *SwiftField) string {
+	if f == nil {
+		return ""
+	}
+	return strings.TrimSpace(strings.Join(f.Lines, ""))
+}
+
+// swiftTextField returns a narrative field (e.g. 70, 72) from lines, nil without lines
+func swiftTextField(tag string, lines []string) *SwiftField {
+	lines = nonEmpty(lines...)
+	if len(lines) == 0 {
+		return nil
+	}
+	return &SwiftField{Tag: tag, Lines: lines}
+}
+
+// swiftTextLines fills targets from the lines of a narrative field and reports the lines which do not fit
+func swiftTextLines(f *SwiftField, targets []*string) []SwiftUnmapped {
+	if f == nil {
+		return nil
+	}
+	lines := nonEmpty(f.Lines...)
+	setLines(targets, lines)
+	if len(lines) > len(targets) {
+		return []SwiftUnmapped{{Field: f.Tag, Value: strings.Join(lines[len(targets):], "\n")}}
+	}
+	return nil
+}
+
+// swiftPartyIdentifierCodes maps the Fedwire personal identification codes to the SWIFT 50F/59F party identifier codes
+var swiftPartyIdentifierCodes = map[string]string{
+	PassportNumber:          PartyIdentifierPassportNumber,
+	TaxIdentificationNumber: PartyIdentifierTaxIdentificationNumber,
+	DriversLicenseNumber:    PartyIdentifierDriversLicenseNumber,
+	AlienRegistrationNumber: PartyIdentifierAlienRegistrationNumber,
+	CorporateIdentification: PartyIdentifierCustomerIdentificationNumber,
+}
+
+// swiftFieldFromIdentified encodes a Fedwire identified party or financial institution as a SWIFT field.
+//
+// A BIC (B or T) is encoded as option A, a Fed routing number (F) or CHIPS identifier (C, U) as a "//FW", "//CP" or
+// "//CH" clearing code and an account (D) as a "/account" line. The personal identification codes of an ordering
+// customer are encoded as a 50F party identifier, other fields have no party identifier and report it as unmapped.
+// The name and address are not carried by option A and are reported as unmapped.
+func swiftFieldFromIdentified(name, field, code, identifier, partyName string, addr Address) (*SwiftField, []SwiftUnmapped) {
+	p := swiftParty{
+		Name:         strings.TrimSpace(partyName),
+		AddressLines: nonEmpty(addr.AddressLineOne, addr.AddressLineTwo, addr.AddressLineThree),
+	}
+	identifier = strings.TrimSpace(identifier)
+	var unmapped []SwiftUnmapped
+	switch code {
+	case SWIFTBankIdentifierCode:
+		p.BIC = identifier
+	case SWIFTBICORBEIANDAccountNumber:
+		if idx := strings.Index(identifier, "/"); idx >= 0 {
+			p.BIC, p.Account = identifier[:idx], identifier[idx+1:]
+		} else {
+			p.BIC = identifier
+		}
+	case FEDRoutingNumber:
+		p.ClearingCode, p.ClearingID = "FW", identifier
+	case CHIPSParticipant:
+		p.ClearingCode, p.ClearingID = "CP", identifier
+	case CHIPSIdentifier:
+		p.ClearingCode, p.ClearingID = "CH", identifier
+	case DemandDepositAccountNumber:
+		p.Account = identifier
+	case "":
+	default:
+		if c, ok := swiftPartyIdentifierCodes[code]; ok && field == "50" {
+			p.PartyIdentifier = c + "/" + identifier
+		} else {
+			unmapped = append(unmapped, SwiftUnmapped{Field: name + ".Identifier", Value: code + identifier})
+		}
+	}
+	if p.BIC != "" {
+		if p.Name != "" {
+			unmapped = append(unmapped, SwiftUnmapped{Field: name + ".Name", Value: p.Name})
+		}
+		if len(p.AddressLines) > 0 {
+			unmapped = append(unmapped, SwiftUnmapped{Field: name + ".Address", Value: strings.Join(p.AddressLines, "\n")})
+		}
+	}
+	if p.BIC == "" && p.Account == "" && p.ClearingCode == "" && p.PartyIdentifier == "" && p.Name == "" && len(p.AddressLines) == 0 {
+		return nil, unmapped
+	}
+	tag, lines := p.encode(field)
+	return &SwiftField{Tag: tag, Lines: lines}, unmapped
+}
+
+// identifiedFromSwiftField is the inverse of swiftFieldFromIdentified, it reports the parts of the field without
+// a Fedwire element.
+func identifiedFromSwiftField(f *SwiftField) (code, identifier, name string, addr Address, unmapped []SwiftUnmapped) {
+	p := decodeSwiftParty(f.Tag, f.Lines)
+	code, identifier = p.identification()
+	if p.ClearingCode != "" && code != FEDRoutingNumber && code != CHIPSParticipant && code != CHIPSIdentifier {
+		unmapped = append(unmapped, SwiftUnmapped{Field: f.Tag, Value: "//" + p.ClearingCode + p.ClearingID})
+	}
+
+	lines := p.AddressLines
+	if p.Country != "" {
+		lines = append(lines, strings.TrimSuffix(p.Country+"/"+p.Town, "/"))
+	}
+	setLines([]*string{&addr.AddressLineOne, &addr.AddressLineTwo, &addr.AddressLineThree}, lines)
+	if len(lines) > 3 {
+		unmapped = append(unmapped, SwiftUnmapped{Field: f.Tag, Value: strings.Join(lines[3:], "\n")})
+	}
+	return code, identifier, p.Name, addr, unmapped
+}
+
+// swiftFieldFromFI encodes a Fedwire financial institution as a SWIFT field, see swiftFieldFromIdentified
+func swiftFieldFromFI(name, field string, fi FinancialInstitution) (*SwiftField, []SwiftUnmapped) {
+	return swiftFieldFromIdentified(name, field, fi.IdentificationCode, fi.Identifier, fi.Name, fi.Address)
+}
+
+// fiFromSwiftField is the inverse of swiftFieldFromFI
+func fiFromSwiftField(f *SwiftField) (FinancialInstitution, []SwiftUnmapped) {
+	code, identifier, name, addr, unmapped := identifiedFromSwiftField(f)
+	return FinancialInstitution{IdentificationCode: code, Identifier: identifier, Name: name, Address: addr}, unmapped
+}
+
+// swiftFieldFromPersonal encodes a Fedwire originator or beneficiary as a SWIFT field, see swiftFieldFromIdentified
+func swiftFieldFromPersonal(name, field string, p Personal) (*SwiftField, []SwiftUnmapped) {
+	return swiftFieldFromIdentified(name, field, p.IdentificationCode, p.Identifier, p.Name, p.Address)
+}
+
+// personalFromSwiftField is the inverse of swiftFieldFromPersonal
+func personalFromSwiftField(f *SwiftField) (Personal, []SwiftUnmapped) {
+	code, identifier, name, addr, unmapped := identifiedFromSwiftField(f)
+	return Personal{IdentificationCode: code, Identifier: identifier, Name: name, Address: addr}, unmapped
+}
+
+// appendSwiftField appends f to fields when it is not nil
+func appendSwiftField(fields []SwiftField, f *SwiftField) []SwiftField {
+	if f == nil {
+		return fields
+	}
+	return append(fields, *f)
+}
+
+// swiftOptionFPersonal reports whether a 50F field holds only what a {5000} Originator carries: an account or a
+// known party identifier, a name and up to three address lines.
+func swiftOptionFPersonal(f *SwiftField) bool {
+	lines := nonEmpty(f.Lines...)
+	if len(lines) == 0 {
+		return false
+	}
+	if !strings.HasPrefix(lines[0], "/") {
+		known := false
+		for _, code := range swiftPartyIdentifierCodes {
+			if strings.HasPrefix(lines[0], code+"/") {
+				known = true
+			}
+		}
+		if !known {
+			return false
+		}
+	}
+	names, addresses := 0, 0
+	for _, line := range lines[1:] {
+		switch {
+		case strings.HasPrefix(line, OptionFName+"/"):
+			names++
+		case strings.HasPrefix(line, OptionFAddress+"/"):
+			addresses++
+		default:
+			return false
+		}
+	}
+	return names == 1 && addresses <= 3
+}
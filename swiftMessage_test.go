@@ -0,0 +1,56 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSwiftMessage(t *testing.T) {
+	msg, err := ParseSwiftMessage(MT202COV, ":20:REF\n:21:RELATED\n:32A:190410USD1,00\n:50K:/123\nJANE DOE\n:59:/456\nJOHN DOE\n:72:/ACC/ONE\n")
+	require.NoError(t, err)
+	require.Len(t, msg.Fields, 3)
+	require.Len(t, msg.SequenceB, 3)
+	require.Equal(t, []string{"/123", "JANE DOE"}, msg.SequenceB[0].Lines)
+	require.Equal(t, ":20:REF\r\n:21:RELATED\r\n:32A:190410USD1,00\r\n:50K:/123\r\nJANE DOE\r\n:59:/456\r\nJOHN DOE\r\n:72:/ACC/ONE\r\n", msg.String())
+
+	_, err = ParseSwiftMessage(MT103, "20:REF")
+	require.True(t, errors.Is(err, ErrSwiftField))
+	_, err = ParseSwiftMessage("940", ":20:REF")
+	require.True(t, errors.Is(err, ErrSwiftMessageType))
+}
+
+func TestSwiftMessage_Field(t *testing.T) {
+	msg := &SwiftMessage{Fields: []SwiftField{{Tag: "71A", Lines: []string{"SHA"}}, {Tag: "71F", Lines: []string{"USD1,00"}}, {Tag: "57D"}}}
+	require.Equal(t, "71F", msg.Field("71F").Tag)
+	require.Equal(t, "57D", msg.Field("57a").Tag)
+	require.Nil(t, msg.Field("57A"))
+	require.Nil(t, msg.Field("59a"))
+}
+
+func TestSwiftFieldFromIdentified(t *testing.T) {
+	f, unmapped := swiftFieldFromIdentified("BeneficiaryFI", "57", SWIFTBankIdentifierCode, "DEUTDEFF", "Deutsche Bank", Address{})
+	require.Equal(t, SwiftField{Tag: "57A", Lines: []string{"DEUTDEFF"}}, *f)
+	require.Equal(t, []SwiftUnmapped{{Field: "BeneficiaryFI.Name", Value: "Deutsche Bank"}}, unmapped)
+
+	f, unmapped = swiftFieldFromIdentified("Beneficiary", "59", DriversLicenseNumber, "1234", "Jane Doe", Address{AddressLineOne: "New York"})
+	require.Equal(t, SwiftField{Tag: "59", Lines: []string{"Jane Doe", "New York"}}, *f)
+	require.Equal(t, []SwiftUnmapped{{Field: "Beneficiary.Identifier", Value: "31234"}}, unmapped)
+
+	code, identifier, name, _, unmapped := identifiedFromSwiftField(&SwiftField{Tag: "52A", Lines: []string{"//CH123456", "WFBIUS6S"}})
+	require.Equal(t, SWIFTBankIdentifierCode, code)
+	require.Equal(t, "WFBIUS6S", identifier)
+	require.Equal(t, "", name)
+	require.Equal(t, []SwiftUnmapped{{Field: "52A", Value: "//CH123456"}}, unmapped)
+
+	code, identifier, _, addr, unmapped := identifiedFromSwiftField(&SwiftField{Tag: "50F", Lines: []string{"TXID/123-45-6789", "1/Jane Doe", "3/US/New York"}})
+	require.Equal(t, TaxIdentificationNumber, code)
+	require.Equal(t, "123-45-6789", identifier)
+	require.Equal(t, "US/New York", addr.AddressLineOne)
+	require.Empty(t, unmapped)
+}
@@ -0,0 +1,274 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/moov-io/base"
+)
+
+// TravelRuleThreshold is the amount in cents of a transmittal of funds from which 31 CFR 1010.410 requires the
+// originator and beneficiary information to be kept and passed on: $3,000
+const TravelRuleThreshold int64 = 300000
+
+// TravelRuleRequirement is an item of information the travel rule requires
+type TravelRuleRequirement string
+
+const (
+	// TravelRuleAmount is an amount which can be compared with the threshold
+	TravelRuleAmount TravelRuleRequirement = "amount"
+	// TravelRuleOriginatorName is the name of the originator
+	TravelRuleOriginatorName TravelRuleRequirement = "originatorName"
+	// TravelRuleOriginatorAddress is the address of the originator
+	TravelRuleOriginatorAddress TravelRuleRequirement = "originatorAddress"
+	// TravelRuleOriginatorAccount is the account number of the originator
+	TravelRuleOriginatorAccount TravelRuleRequirement = "originatorAccount"
+	// TravelRuleOriginatorFI is the identity of the originator's financial institution
+	TravelRuleOriginatorFI TravelRuleRequirement = "originatorFI"
+	// TravelRuleBeneficiaryName is the name of the beneficiary
+	TravelRuleBeneficiaryName TravelRuleRequirement = "beneficiaryName"
+	// TravelRuleBeneficiaryIdentifier is the account number or other identifier of the beneficiary
+	TravelRuleBeneficiaryIdentifier TravelRuleRequirement = "beneficiaryIdentifier"
+)
+
+// travelRuleBusinessFunctions are the business function codes of transmittals of funds for a customer, which the
+// travel rule applies to. Bank transfers are between financial institutions for their own account.
+var travelRuleBusinessFunctions = map[string]bool{
+	CustomerTransfer:     true,
+	CustomerTransferPlus: true,
+	DrawdownResponse:     true,
+}
+
+// travelRuleAccountCodes are the identification codes of an originator identifier which is an account number
+var travelRuleAccountCodes = map[string]bool{
+	DemandDepositAccountNumber:    true,
+	SWIFTBICORBEIANDAccountNumber: true,
+}
+
+// TravelRuleOptions are the options of CheckTravelRule
+type TravelRuleOptions struct {
+	// Threshold is the amount in cents from which a message is checked, which is TravelRuleThreshold when nil. A
+	// threshold of 0 checks messages of any amount.
+	Threshold *int64 `json:"threshold,omitempty"`
+}
+
+// TravelRuleGap is an item of information the travel rule requires which is missing from a message or is not
+// well formed
+type TravelRuleGap struct {
+	// Requirement which is not met
+	Requirement TravelRuleRequirement `json:"requirement"`
+	// Tag which holds, or is expected to hold, the information, e.g. {5000}
+	Tag string `json:"tag"`
+	// Path is the JSON path of the field, e.g. originator.personal.name
+	Path string `json:"path"`
+	// Message describes the gap
+	Message string `json:"message"`
+	// Err is the FieldError of the field, e.g. ErrFieldRequired when it is blank
+	Err error `json:"-"`
+}
+
+// Error returns the tag, requirement and error of the gap
+func (g TravelRuleGap) Error() string {
+	return fmt.Sprintf("%s %s: %s", g.Tag, g.Requirement, g.Message)
+}
+
+// Unwrap returns the FieldError of the gap
+func (g TravelRuleGap) Unwrap() error {
+	return g.Err
+}
+
+// TravelRuleReport is the result of CheckTravelRule
+type TravelRuleReport struct {
+	// Amount of the message in cents
+	Amount int64 `json:"amount"`
+	// Threshold in cents the message was checked against
+	Threshold int64 `json:"threshold"`
+	// Applies is true when the message is a transmittal of funds for a customer of at least the threshold, or when
+	// its amount cannot be read
+	Applies bool `json:"applies"`
+	// Gaps are the requirements which are not met, in the order of the requirements
+	Gaps []TravelRuleGap `json:"gaps,omitempty"`
+}
+
+// Passed returns true when the message has no gaps
+func (r TravelRuleReport) Passed() bool {
+	return len(r.Gaps) == 0
+}
+
+// Err returns the gaps in a base.ErrorList, or nil when the message passed, so the report can gate the release of
+// a message
+func (r TravelRuleReport) Err() error {
+	if r.Passed() {
+		return nil
+	}
+	var errs base.ErrorList
+	for _, gap := range r.Gaps {
+		errs.Add(gap)
+	}
+	return errs
+}
+
+// CheckTravelRule checks a customer transfer, customer transfer plus or drawdown payment of at least the threshold
+// has the originator and beneficiary information 31 CFR 1010.410 requires, and that it is well formed:
+//   - the name, address and account number of the originator, from {5000} Originator or {5010} OriginatorOptionF
+//   - the originator's financial institution, from {5100} OriginatorFI or else the {3100} sender
+//   - the name and identifier of the beneficiary, from {4200} Beneficiary
+//
+// Other messages, and messages below the threshold, are reported as not applying and have no gaps.
+func (fwm *FEDWireMessage) CheckTravelRule(opts TravelRuleOptions) TravelRuleReport {
+	report := TravelRuleReport{Threshold: TravelRuleThreshold}
+	if opts.Threshold != nil {
+		report.Threshold = *opts.Threshold
+	}
+	if fwm.BusinessFunctionCode == nil || !travelRuleBusinessFunctions[fwm.BusinessFunctionCode.BusinessFunctionCode] {
+		return report
+	}
+
+	c := &travelRuleCheck{}
+	if fwm.Amount == nil {
+		c.check(TravelRuleAmount, TagAmount, "amount.amount", "", nil)
+	} else if cents, err := fwm.Amount.Cents(); err != nil {
+		c.gap(TravelRuleAmount, TagAmount, "amount.amount", err)
+	} else {
+		report.Amount = cents
+	}
+	if c.gaps == nil && report.Amount < report.Threshold {
+		return report
+	}
+	report.Applies = true
+
+	c.checkOriginator(fwm)
+	c.checkOriginatorFI(fwm)
+	c.checkBeneficiary(fwm)
+	report.Gaps = c.gaps
+	return report
+}
+
+// travelRuleCheck collects the gaps of a message
+type travelRuleCheck struct {
+	validator
+	gaps []TravelRuleGap
+}
+
+// gap adds a gap for the error err of the field at path
+func (c *travelRuleCheck) gap(requirement TravelRuleRequirement, tag, path string, err error) {
+	c.gaps = append(c.gaps, TravelRuleGap{Requirement: requirement, Tag: tag, Path: path, Message: err.Error(), Err: err})
+}
+
+// check adds a gap when value is blank, or when validate returns an error
+func (c *travelRuleCheck) check(requirement TravelRuleRequirement, tag, path, value string, validate func(string) error) {
+	if strings.TrimSpace(value) == "" {
+		c.gap(requirement, tag, path, fieldError(path, ErrFieldRequired, value))
+		return
+	}
+	if validate != nil {
+		if err := validate(value); err != nil {
+			c.gap(requirement, tag, path, fieldError(path, err, value))
+		}
+	}
+}
+
+// checkAddress adds a gap when none of the address lines of a tag are given, and for each line which is not valid.
+// lines holds the value of each path.
+func (c *travelRuleCheck) checkAddress(requirement TravelRuleRequirement, tag string, paths, lines []string, validate func(string) error) {
+	if len(nonEmpty(lines...)) == 0 {
+		c.gap(requirement, tag, paths[0], fieldError(paths[0], ErrFieldRequired, lines[0]))
+		return
+	}
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+		if err := validate(line); err != nil {
+			c.gap(requirement, tag, paths[i], fieldError(paths[i], err, line))
+		}
+	}
+}
+
+// checkOriginator checks the name, address and account of the originator
+func (c *travelRuleCheck) checkOriginator(fwm *FEDWireMessage) {
+	switch {
+	case fwm.Originator != nil:
+		p := fwm.Originator.Personal
+		c.check(TravelRuleOriginatorName, TagOriginator, "originator.personal.name", p.Name, c.isAlphanumeric)
+		c.checkAddress(TravelRuleOriginatorAddress, TagOriginator,
+			[]string{"originator.personal.address.addressLineOne", "originator.personal.address.addressLineTwo", "originator.personal.address.addressLineThree"},
+			[]string{p.Address.AddressLineOne, p.Address.AddressLineTwo, p.Address.AddressLineThree}, c.isAlphanumeric)
+		if code := strings.TrimSpace(p.IdentificationCode); !travelRuleAccountCodes[code] {
+			c.gap(TravelRuleOriginatorAccount, TagOriginator, "originator.personal.identificationCode",
+				fieldError("originator.personal.identificationCode", ErrTravelRuleAccount, code))
+		} else {
+			c.check(TravelRuleOriginatorAccount, TagOriginator, "originator.personal.identifier", p.Identifier, c.isAlphanumeric)
+		}
+
+	case fwm.OriginatorOptionF != nil:
+		oof := fwm.OriginatorOptionF
+		c.check(TravelRuleOriginatorName, TagOriginatorOptionF, "originatorOptionF.name", oof.Name, c.validateOptionFName)
+		// the address is given by "2/" address lines and a "3/" country and town line
+		paths := []string{"originatorOptionF.lineOne", "originatorOptionF.lineTwo", "originatorOptionF.lineThree"}
+		var lines []string
+		for _, line := range []string{oof.LineOne, oof.LineTwo, oof.LineThree} {
+			if line = strings.TrimSpace(line); strings.HasPrefix(line, OptionFAddress+"/") || strings.HasPrefix(line, OptionFCountryTown+"/") {
+				lines = append(lines, line)
+			} else {
+				lines = append(lines, "")
+			}
+		}
+		c.checkAddress(TravelRuleOriginatorAddress, TagOriginatorOptionF, paths, lines, c.validateOptionFLine)
+		if id := strings.TrimSpace(oof.PartyIdentifier); id != "" && !strings.HasPrefix(id, "/") {
+			c.gap(TravelRuleOriginatorAccount, TagOriginatorOptionF, "originatorOptionF.partyIdentifier",
+				fieldError("originatorOptionF.partyIdentifier", ErrTravelRuleAccount, id))
+		} else {
+			c.check(TravelRuleOriginatorAccount, TagOriginatorOptionF, "originatorOptionF.partyIdentifier", id, c.validatePartyIdentifier)
+		}
+
+	default:
+		c.check(TravelRuleOriginatorName, TagOriginator, "originator.personal.name", "", nil)
+		c.check(TravelRuleOriginatorAddress, TagOriginator, "originator.personal.address.addressLineOne", "", nil)
+		c.check(TravelRuleOriginatorAccount, TagOriginator, "originator.personal.identifier", "", nil)
+	}
+}
+
+// checkOriginatorFI checks the originator's financial institution is identified
+func (c *travelRuleCheck) checkOriginatorFI(fwm *FEDWireMessage) {
+	switch {
+	case fwm.OriginatorFI != nil:
+		fi := fwm.OriginatorFI.FinancialInstitution
+		c.check(TravelRuleOriginatorFI, TagOriginatorFI, "originatorFI.financialInstitution.identificationCode", fi.IdentificationCode, c.isIdentificationCode)
+		c.check(TravelRuleOriginatorFI, TagOriginatorFI, "originatorFI.financialInstitution.identifier", fi.Identifier, c.isAlphanumeric)
+
+	case fwm.SenderDepositoryInstitution != nil:
+		// without {5100} the sender is the originator's financial institution
+		c.check(TravelRuleOriginatorFI, TagSenderDepositoryInstitution, "senderDepositoryInstitution.senderABANumber",
+			fwm.SenderDepositoryInstitution.SenderABANumber, c.isRoutingNumber)
+
+	default:
+		c.check(TravelRuleOriginatorFI, TagOriginatorFI, "originatorFI.financialInstitution.identifier", "", nil)
+	}
+}
+
+// checkBeneficiary checks the name and identifier of the beneficiary
+func (c *travelRuleCheck) checkBeneficiary(fwm *FEDWireMessage) {
+	var p Personal
+	if fwm.Beneficiary != nil {
+		p = fwm.Beneficiary.Personal
+	}
+	c.check(TravelRuleBeneficiaryName, TagBeneficiary, "beneficiary.personal.name", p.Name, c.isAlphanumeric)
+	c.check(TravelRuleBeneficiaryIdentifier, TagBeneficiary, "beneficiary.personal.identificationCode", p.IdentificationCode, c.isIdentificationCode)
+	c.check(TravelRuleBeneficiaryIdentifier, TagBeneficiary, "beneficiary.personal.identifier", p.Identifier, c.isAlphanumeric)
+}
+
+// isRoutingNumber checks s is a 9 digit routing number
+func (c *travelRuleCheck) isRoutingNumber(s string) error {
+	if err := c.isNumeric(s); err != nil {
+		return err
+	}
+	if len(s) != 9 {
+		return NewFieldWrongLengthErr(9, len(s))
+	}
+	return nil
+}
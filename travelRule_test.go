@@ -0,0 +1,113 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/moov-io/base"
+	"github.com/stretchr/testify/require"
+)
+
+// travelRuleGapPaths returns the requirement and path of each gap of the report
+func travelRuleGapPaths(report TravelRuleReport) []string {
+	var paths []string
+	for _, gap := range report.Gaps {
+		paths = append(paths, string(gap.Requirement)+" "+gap.Path)
+	}
+	return paths
+}
+
+func TestFEDWireMessage_CheckTravelRule(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	fwm.Originator.Personal.IdentificationCode = DemandDepositAccountNumber
+
+	report := fwm.CheckTravelRule(TravelRuleOptions{})
+	require.Equal(t, TravelRuleReport{Amount: 1234567, Threshold: TravelRuleThreshold, Applies: true}, report)
+	require.True(t, report.Passed())
+	require.NoError(t, report.Err())
+
+	// below the threshold
+	require.NoError(t, fwm.Amount.SetCents(299999))
+	report = fwm.CheckTravelRule(TravelRuleOptions{})
+	require.False(t, report.Applies)
+	fwm.Originator = nil
+	require.True(t, fwm.CheckTravelRule(TravelRuleOptions{}).Passed())
+
+	// a configurable threshold
+	threshold := int64(100000)
+	report = fwm.CheckTravelRule(TravelRuleOptions{Threshold: &threshold})
+	require.True(t, report.Applies)
+	require.Equal(t, threshold, report.Threshold)
+	require.Equal(t, []string{
+		"originatorName originator.personal.name",
+		"originatorAddress originator.personal.address.addressLineOne",
+		"originatorAccount originator.personal.identifier",
+	}, travelRuleGapPaths(report))
+
+	// a threshold of 0 checks any amount
+	threshold = 0
+	require.NoError(t, fwm.Amount.SetCents(1))
+	report = fwm.CheckTravelRule(TravelRuleOptions{Threshold: &threshold})
+	require.True(t, report.Applies)
+	require.Equal(t, int64(0), report.Threshold)
+
+	// bank transfers are not checked
+	bank := readFEDWireMessage(t, "fedWireMessage-BankTransfer.txt")
+	require.False(t, bank.CheckTravelRule(TravelRuleOptions{Threshold: &threshold}).Applies)
+}
+
+func TestFEDWireMessage_CheckTravelRuleGaps(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	fwm.Originator.Personal.Address = Address{}
+	fwm.OriginatorFI = nil
+	fwm.SenderDepositoryInstitution.SenderABANumber = "12104288"
+	fwm.Beneficiary.Personal.Name = "Jöhn"
+	fwm.Beneficiary.Personal.Identifier = ""
+
+	report := fwm.CheckTravelRule(TravelRuleOptions{})
+	require.Equal(t, []string{
+		"originatorAddress originator.personal.address.addressLineOne",
+		"originatorAccount originator.personal.identificationCode",
+		"originatorFI senderDepositoryInstitution.senderABANumber",
+		"beneficiaryName beneficiary.personal.name",
+		"beneficiaryIdentifier beneficiary.personal.identifier",
+	}, travelRuleGapPaths(report))
+	require.Equal(t, TagOriginator, report.Gaps[0].Tag)
+	require.True(t, errors.Is(report.Gaps[0], ErrFieldRequired))
+	require.True(t, errors.Is(report.Gaps[1], ErrTravelRuleAccount))
+	require.True(t, errors.Is(report.Gaps[3], ErrNonAlphanumeric))
+
+	// the report gates a release
+	err := report.Err()
+	require.Error(t, err)
+	require.Len(t, err.(base.ErrorList), 5)
+	require.Contains(t, err.Error(), "{4200} beneficiaryName: beneficiary.personal.name")
+
+	// the amount must be readable
+	fwm.Amount.Amount = "ABC"
+	require.Equal(t, TravelRuleAmount, fwm.CheckTravelRule(TravelRuleOptions{}).Gaps[0].Requirement)
+}
+
+func TestFEDWireMessage_CheckTravelRuleOptionF(t *testing.T) {
+	// {5010} TXID/123-45-6789*1/Name*1/1234*2/1000 Colonial Farm Rd*5/Pottstown*
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransferPlusCOVS.txt")
+	fwm.Originator = nil
+
+	report := fwm.CheckTravelRule(TravelRuleOptions{})
+	require.Equal(t, []string{"originatorAccount originatorOptionF.partyIdentifier"}, travelRuleGapPaths(report))
+	require.True(t, errors.Is(report.Gaps[0], ErrTravelRuleAccount))
+
+	fwm.OriginatorOptionF.PartyIdentifier = "/123456789"
+	require.True(t, fwm.CheckTravelRule(TravelRuleOptions{}).Passed())
+
+	// the address is given by 2/ and 3/ lines
+	fwm.OriginatorOptionF.LineTwo = "4/19800101"
+	report = fwm.CheckTravelRule(TravelRuleOptions{})
+	require.Equal(t, []string{"originatorAddress originatorOptionF.lineOne"}, travelRuleGapPaths(report))
+	fwm.OriginatorOptionF.LineThree = "3/US/Pottstown"
+	require.True(t, fwm.CheckTravelRule(TravelRuleOptions{}).Passed())
+}
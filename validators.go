@@ -75,11 +75,7 @@ func (v *validator) isAmountImplied(s string) error {
 
 // isTypeCode ensures tag {1510} TypeCode is valid
 func (v *validator) isTypeCode(code string) error {
-	switch code {
-	case
-		FundsTransfer,
-		ForeignTransfer,
-		SettlementTransfer:
+	if TypeCode(code).IsValid() {
 		return nil
 	}
 	return ErrTypeCode
@@ -87,36 +83,14 @@ func (v *validator) isTypeCode(code string) error {
 
 // isSubTypeCode ensures tag {1510} SubTypeCode is valid
 func (v *validator) isSubTypeCode(code string) error {
-	switch code {
-	case
-		BasicFundsTransfer,
-		RequestReversal,
-		ReversalTransfer,
-		RequestReversalPriorDayTransfer,
-		ReversalPriorDayTransfer,
-		RequestCredit,
-		FundsTransferRequestCredit,
-		RefusalRequestCredit,
-		SSIServiceMessage:
+	if SubTypeCode(code).IsValid() {
 		return nil
 	}
 	return ErrSubTypeCode
 }
 
 func (v *validator) isLocalInstrumentCode(code string) error {
-	switch code {
-	case
-		ANSIX12format,
-		SequenceBCoverPaymentStructured,
-		GeneralXMLformat,
-		ISO20022XMLformat,
-		NarrativeText,
-		ProprietaryLocalInstrumentCode,
-		RemittanceInformationStructured,
-		RelatedRemittanceInformation,
-		STP820format,
-		SWIFTfield70,
-		UNEDIFACTformat:
+	if LocalInstrumentCode(code).IsValid() {
 		return nil
 	}
 	return ErrLocalInstrumentCode
@@ -143,29 +117,14 @@ func (v *validator) isMessageDuplicationCode(code string) error {
 }
 
 func (v *validator) isBusinessFunctionCode(code string) error {
-	switch code {
-	case
-		BankTransfer,
-		CheckSameDaySettlement,
-		CustomerTransferPlus,
-		CustomerTransfer,
-		DepositSendersAccount,
-		BankDrawDownRequest,
-		CustomerCorporateDrawdownRequest,
-		DrawdownResponse,
-		FEDFundsReturned,
-		FEDFundsSold,
-		BFCServiceMessage:
+	if BusinessFunction(code).IsValid() {
 		return nil
 	}
 	return ErrBusinessFunctionCode
 }
 
 func (v *validator) isChargeDetails(code string) error {
-	switch code {
-	case
-		CDBeneficiary,
-		CDShared:
+	if ChargeDetails(code).IsValid() {
 		return nil
 	}
 	return ErrChargeDetails
@@ -181,160 +140,70 @@ func (v *validator) isTransactionTypeCode(code string) error {
 }
 
 func (v *validator) isIdentificationCode(code string) error {
-	switch code {
-	case
-		"",
-		SWIFTBankIdentifierCode,
-		CHIPSParticipant,
-		DemandDepositAccountNumber,
-		FEDRoutingNumber,
-		SWIFTBICORBEIANDAccountNumber,
-		CHIPSIdentifier,
-		PassportNumber,
-		TaxIdentificationNumber,
-		DriversLicenseNumber,
-		AlienRegistrationNumber,
-		CorporateIdentification,
-		OtherIdentification:
+	if code == "" || IdentificationCode(code).IsValid() {
 		return nil
 	}
 	return ErrIdentificationCode
 }
 
 func (v *validator) isAdviceCode(code string) error {
-	switch code {
-	case
-		AdviceCodeHold,
-		AdviceCodeLetter,
-		AdviceCodePhone,
-		AdviceCodeTelex,
-		AdviceCodeWire:
+	if AdviceCode(code).IsValid() {
 		return nil
 	}
 	return ErrAdviceCode
 }
 
 func (v *validator) isAddressType(code string) error {
-	switch code {
-	case
-		CompletePostalAddress,
-		HomeAddress,
-		BusinessAddress,
-		MailAddress,
-		DeliveryAddress,
-		PostOfficeBox:
+	if AddressType(code).IsValid() {
 		return nil
 	}
 	return ErrAddressType
 }
 
 func (v *validator) isRemittanceLocationMethod(code string) error {
-	switch code {
-	case
-		RLMElectronicDataExchange,
-		RLMEmail,
-		RLMFax,
-		RLMPostalService,
-		RLMSMSM,
-		RLMURI:
+	if RemittanceLocationMethod(code).IsValid() {
 		return nil
 	}
 	return ErrRemittanceLocationMethod
 }
 
 func (v *validator) isIdentificationType(code string) error {
-	switch code {
-	case
-		OrganizationID,
-		PrivateID:
+	if IdentificationType(code).IsValid() {
 		return nil
 	}
 	return ErrIdentificationType
 }
 
 func (v *validator) isOrganizationIdentificationCode(code string) error {
-	switch code {
-	case
-		OICBankPartyIdentification,
-		OICCustomerNumber,
-		OICDataUniversalNumberSystem,
-		OICEmployerIdentificationNumber,
-		OICGlobalLocationNumber,
-		OICProprietaryIdentificationNumber,
-		OICSWIFTBICORBEI,
-		OICTaxIdentificationNumber:
+	if OrganizationIdentificationCode(code).IsValid() {
 		return nil
 	}
 	return ErrOrganizationIdentificationCode
 }
 
 func (v *validator) isPrivateIdentificationCode(code string) error {
-	switch code {
-	case
-		PICAlienRegistrationNumber,
-		PICPassportNumber,
-		PICCustomerNumber,
-		PICDateBirthPlace,
-		PICEmployeeIdentificationNumber,
-		PICNationalIdentityNumber,
-		PICProprietaryIdentificationNumber,
-		PICSocialSecurityNumber,
-		PICTaxIdentificationNumber:
+	if PrivateIdentificationCode(code).IsValid() {
 		return nil
 	}
 	return ErrPrivateIdentificationCode
 }
 
 func (v *validator) isDocumentTypeCode(code string) error {
-	switch code {
-	case
-		AccountsReceivableOpenItem,
-		BillLadingShippingNotice,
-		CommercialInvoice,
-		CommercialContract,
-		CreditNoteRelatedFinancialAdjustment,
-		CreditNote,
-		DebitNote,
-		DispatchAdvice,
-		DebitNoteRelatedFinancialAdjustment,
-		HireInvoice,
-		MeteredServiceInvoice,
-		ProprietaryDocumentType,
-		PurchaseOrder,
-		SelfBilledInvoice,
-		StatementAccount,
-		TradeServicesUtilityTransaction,
-		Voucher:
+	if DocumentTypeCode(code).IsValid() {
 		return nil
 	}
 	return ErrDocumentTypeCode
 }
 
 func (v *validator) isCreditDebitIndicator(code string) error {
-	switch code {
-	case
-		CreditIndicator,
-		DebitIndicator:
+	if CreditDebitIndicator(code).IsValid() {
 		return nil
 	}
 	return ErrCreditDebitIndicator
 }
 
 func (v *validator) isAdjustmentReasonCode(code string) error {
-	switch code {
-	case
-		PricingError,
-		ExtensionError,
-		ItemNotAcceptedDamaged,
-		ItemNotAcceptedQuality,
-		QuantityContested,
-		IncorrectProduct,
-		ReturnsDamaged,
-		ReturnsQuality,
-		ItemNotReceived,
-		TotalOrderNotReceived,
-		CreditAgreed,
-		CoveredCreditMemo:
+	if AdjustmentReasonCode(code).IsValid() {
 		return nil
 	}
 	return ErrAdjustmentReasonCode
@@ -484,17 +353,7 @@ func (v *validator) validateUIDPartyIdentifier(s string) error {
 		return ErrPartyIdentifier
 	}
 	uid := s[:4]
-	switch uid {
-	case
-		PartyIdentifierAlienRegistrationNumber,
-		PartyIdentifierPassportNumber,
-		PartyIdentifierCustomerIdentificationNumber,
-		PartyIdentifierDriversLicenseNumber,
-		PartyIdentifierEmployerNumber,
-		PartyIdentifierNationalIdentifyNumber,
-		PartyIdentifierSocialSecurityNumber,
-		PartyIdentifierTaxIdentificationNumber:
-	default:
+	if !PartyIdentifierCode(uid).IsValid() {
 		return ErrPartyIdentifier
 	}
 	if s[4:5] != "/" {
@@ -533,17 +392,7 @@ func (v *validator) validateOptionFLine(s string) error {
 	if utf8.RuneCountInString(s) < 3 {
 		return ErrOptionFLine
 	}
-	switch s[:1] {
-	case
-		OptionFName,
-		OptionFAddress,
-		OptionFCountryTown,
-		OptionFDOB,
-		OptionFBirthPlace,
-		OptionFCustomerIdentificationNumber,
-		OptionFNationalIdentityNumber,
-		OptionFAdditionalInformation:
-	default:
+	if !OptionFLineCode(s[:1]).IsValid() {
 		return ErrOptionFLine
 	}
 	if s[1:2] != "/" {
@@ -0,0 +1,366 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	texttemplate "text/template"
+)
+
+// WireAdvice is a readable view of a FEDWireMessage which is rendered by an AdviceTemplate
+type WireAdvice struct {
+	// Amount formatted as US dollars, e.g. $12,345.67
+	Amount string
+	// BusinessFunctionCode {3600}
+	BusinessFunctionCode string
+	// BusinessFunction describes BusinessFunctionCode
+	BusinessFunction string
+	// TypeCode {1510}
+	TypeCode string
+	// Type describes TypeCode
+	Type string
+	// SubTypeCode {1510}
+	SubTypeCode string
+	// SubType describes SubTypeCode
+	SubType string
+	// IMAD is the Input Message Accountability Data {1520}
+	IMAD string
+	// OMAD is the Output Message Accountability Data {1120} appended by the Fed
+	OMAD string
+	// SenderReference {3320}
+	SenderReference string
+	// BeneficiaryReference {4320}
+	BeneficiaryReference string
+	// Originator {5000} or {5010}
+	Originator *AdviceParty
+	// Beneficiary {4200}
+	Beneficiary *AdviceParty
+	// FIChain lists the financial institutions in the order the funds move, from the instructing FI {5200} to the
+	// beneficiary's FI {4100}
+	FIChain []AdviceParty
+	// OriginatorToBeneficiary {6000}
+	OriginatorToBeneficiary []string
+	// Remittance holds the remittance details of {7070} and the {8xxx} tags
+	Remittance []AdviceDetail
+}
+
+// AdviceParty is a party or financial institution of a WireAdvice
+type AdviceParty struct {
+	// Role of the party, e.g. Originator or Beneficiary FI
+	Role string
+	// IdentificationCode of Identifier
+	IdentificationCode string
+	// IdentificationType describes IdentificationCode
+	IdentificationType string
+	// Identifier such as an account or routing number
+	Identifier string
+	// Name of the party
+	Name string
+	// Address lines of the party
+	Address []string
+}
+
+// AdviceDetail is a labeled value of a WireAdvice
+type AdviceDetail struct {
+	Label string
+	Value string
+}
+
+// AdviceTemplate renders a WireAdvice. Both *text/template.Template and *html/template.Template are AdviceTemplates.
+type AdviceTemplate interface {
+	Execute(w io.Writer, data interface{}) error
+}
+
+// AdviceTextTemplate is the text/template of the plain text advice
+const AdviceTextTemplate = `{{define "party"}}{{.Role}}:
+{{- if .Name}}
+  {{.Name}}{{end}}
+{{- if .Identifier}}
+  {{if .IdentificationType}}{{.IdentificationType}}: {{end}}{{.Identifier}}{{end}}
+{{- range .Address}}
+  {{.}}{{end}}
+{{end -}}
+WIRE TRANSFER ADVICE
+
+Amount:                {{.Amount}}
+Business Function:     {{.BusinessFunctionCode}} {{.BusinessFunction}}
+Type:                  {{.TypeCode}}{{.SubTypeCode}} {{.Type}}, {{.SubType}}
+IMAD:                  {{.IMAD}}
+{{- if .OMAD}}
+OMAD:                  {{.OMAD}}{{end}}
+{{- if .SenderReference}}
+Sender Reference:      {{.SenderReference}}{{end}}
+{{- if .BeneficiaryReference}}
+Beneficiary Reference: {{.BeneficiaryReference}}{{end}}
+{{with .Originator}}
+{{template "party" .}}{{end}}
+{{- with .Beneficiary}}
+{{template "party" .}}{{end}}
+{{- if .FIChain}}
+FINANCIAL INSTITUTIONS
+{{range .FIChain}}
+{{template "party" .}}{{end}}{{end}}
+{{- if .OriginatorToBeneficiary}}
+ORIGINATOR TO BENEFICIARY
+{{range .OriginatorToBeneficiary}}  {{.}}
+{{end}}{{end}}
+{{- if .Remittance}}
+REMITTANCE
+{{range .Remittance}}  {{.Label}}: {{.Value}}
+{{end}}{{end}}`
+
+// AdviceHTMLTemplate is the html/template of the HTML advice
+const AdviceHTMLTemplate = `{{define "party"}}<tr><th>{{.Role}}</th><td>
+{{- if .Name}}{{.Name}}<br>{{end}}
+{{- if .Identifier}}{{if .IdentificationType}}{{.IdentificationType}}: {{end}}{{.Identifier}}<br>{{end}}
+{{- range .Address}}{{.}}<br>{{end}}</td></tr>
+{{end -}}
+<div class="wire-advice">
+<h1>Wire Transfer Advice</h1>
+<table>
+<tr><th>Amount</th><td>{{.Amount}}</td></tr>
+<tr><th>Business Function</th><td>{{.BusinessFunctionCode}} {{.BusinessFunction}}</td></tr>
+<tr><th>Type</th><td>{{.TypeCode}}{{.SubTypeCode}} {{.Type}}, {{.SubType}}</td></tr>
+<tr><th>IMAD</th><td>{{.IMAD}}</td></tr>
+{{if .OMAD}}<tr><th>OMAD</th><td>{{.OMAD}}</td></tr>
+{{end}}{{if .SenderReference}}<tr><th>Sender Reference</th><td>{{.SenderReference}}</td></tr>
+{{end}}{{if .BeneficiaryReference}}<tr><th>Beneficiary Reference</th><td>{{.BeneficiaryReference}}</td></tr>
+{{end}}{{with .Originator}}{{template "party" .}}{{end}}{{with .Beneficiary}}{{template "party" .}}{{end -}}
+</table>
+{{if .FIChain}}<h2>Financial Institutions</h2>
+<table>
+{{range .FIChain}}{{template "party" .}}{{end}}</table>
+{{end}}{{if .OriginatorToBeneficiary}}<h2>Originator to Beneficiary</h2>
+<p>{{range $i, $line := .OriginatorToBeneficiary}}{{if $i}}<br>{{end}}{{$line}}{{end}}</p>
+{{end}}{{if .Remittance}}<h2>Remittance</h2>
+<table>
+{{range .Remittance}}<tr><th>{{.Label}}</th><td>{{.Value}}</td></tr>
+{{end}}</table>
+{{end}}</div>
+`
+
+var (
+	// DefaultAdviceText renders AdviceTextTemplate
+	DefaultAdviceText = texttemplate.Must(texttemplate.New("advice").Parse(AdviceTextTemplate))
+	// DefaultAdviceHTML renders AdviceHTMLTemplate
+	DefaultAdviceHTML = htmltemplate.Must(htmltemplate.New("advice").Parse(AdviceHTMLTemplate))
+)
+
+// WriteAdvice renders the WireAdvice of fwm with tmpl, which may be DefaultAdviceText, DefaultAdviceHTML or a
+// custom template of WireAdvice.
+func WriteAdvice(w io.Writer, fwm *FEDWireMessage, tmpl AdviceTemplate) error {
+	return tmpl.Execute(w, NewWireAdvice(fwm))
+}
+
+// AdviceText returns the plain text advice of the FEDWireMessage
+func (fwm *FEDWireMessage) AdviceText() (string, error) {
+	var buf bytes.Buffer
+	if err := WriteAdvice(&buf, fwm, DefaultAdviceText); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// AdviceHTML returns the HTML advice of the FEDWireMessage
+func (fwm *FEDWireMessage) AdviceHTML() (string, error) {
+	var buf bytes.Buffer
+	if err := WriteAdvice(&buf, fwm, DefaultAdviceHTML); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// NewWireAdvice returns the WireAdvice of a FEDWireMessage
+func NewWireAdvice(fwm *FEDWireMessage) *WireAdvice {
+	advice := &WireAdvice{}
+	if fwm.Amount != nil {
+		advice.Amount = formatAdviceAmount(fwm.Amount.Amount)
+	}
+	if fwm.BusinessFunctionCode != nil {
+		advice.BusinessFunctionCode = fwm.BusinessFunctionCode.BusinessFunctionCode
+		advice.BusinessFunction = BusinessFunction(advice.BusinessFunctionCode).Description()
+	}
+	if fwm.TypeSubType != nil {
+		advice.TypeCode = fwm.TypeSubType.TypeCode
+		advice.Type = TypeCode(advice.TypeCode).Description()
+		advice.SubTypeCode = fwm.TypeSubType.SubTypeCode
+		advice.SubType = SubTypeCode(advice.SubTypeCode).Description()
+	}
+	if imad := fwm.InputMessageAccountabilityData; imad != nil {
+		advice.IMAD = imad.InputCycleDate + imad.InputSource + imad.InputSequenceNumber
+	}
+	if omad := fwm.OutputMessageAccountabilityData; omad != nil {
+		advice.OMAD = omad.OutputCycleDate + omad.OutputDestinationID + omad.OutputSequenceNumber +
+			omad.OutputDate + omad.OutputTime + omad.OutputFRBApplicationIdentification
+	}
+	if fwm.SenderReference != nil {
+		advice.SenderReference = fwm.SenderReference.SenderReference
+	}
+	if fwm.BeneficiaryReference != nil {
+		advice.BeneficiaryReference = fwm.BeneficiaryReference.BeneficiaryReference
+	}
+
+	switch {
+	case fwm.Originator != nil:
+		advice.Originator = newAdvicePersonal("Originator", fwm.Originator.Personal)
+	case fwm.OriginatorOptionF != nil:
+		advice.Originator = &AdviceParty{
+			Role:       "Originator",
+			Identifier: fwm.OriginatorOptionF.PartyIdentifier,
+			Name:       strings.TrimPrefix(fwm.OriginatorOptionF.Name, OptionFName+"/"),
+			Address: adviceLines(fwm.OriginatorOptionF.LineOne, fwm.OriginatorOptionF.LineTwo,
+				fwm.OriginatorOptionF.LineThree),
+		}
+	}
+	if fwm.Beneficiary != nil {
+		advice.Beneficiary = newAdvicePersonal("Beneficiary", fwm.Beneficiary.Personal)
+	}
+
+	advice.FIChain = adviceFIChain(fwm)
+	if otb := fwm.OriginatorToBeneficiary; otb != nil {
+		advice.OriginatorToBeneficiary = adviceLines(otb.LineOne, otb.LineTwo, otb.LineThree, otb.LineFour)
+	}
+	advice.Remittance = adviceRemittance(fwm)
+	return advice
+}
+
+// newAdvicePersonal returns the AdviceParty of a party
+func newAdvicePersonal(role string, p Personal) *AdviceParty {
+	return &AdviceParty{
+		Role:               role,
+		IdentificationCode: p.IdentificationCode,
+		IdentificationType: IdentificationCode(p.IdentificationCode).Description(),
+		Identifier:         p.Identifier,
+		Name:               p.Name,
+		Address:            adviceLines(p.Address.AddressLineOne, p.Address.AddressLineTwo, p.Address.AddressLineThree),
+	}
+}
+
+// newAdviceFI returns the AdviceParty of a financial institution
+func newAdviceFI(role string, fi FinancialInstitution) AdviceParty {
+	return AdviceParty{
+		Role:               role,
+		IdentificationCode: fi.IdentificationCode,
+		IdentificationType: IdentificationCode(fi.IdentificationCode).Description(),
+		Identifier:         fi.Identifier,
+		Name:               fi.Name,
+		Address:            adviceLines(fi.Address.AddressLineOne, fi.Address.AddressLineTwo, fi.Address.AddressLineThree),
+	}
+}
+
+// adviceFIChain returns the financial institutions of the message in the order the funds move
+func adviceFIChain(fwm *FEDWireMessage) []AdviceParty {
+	var chain []AdviceParty
+	if fwm.InstructingFI != nil {
+		chain = append(chain, newAdviceFI("Instructing FI", fwm.InstructingFI.FinancialInstitution))
+	}
+	if fwm.OriginatorFI != nil {
+		chain = append(chain, newAdviceFI("Originator FI", fwm.OriginatorFI.FinancialInstitution))
+	}
+	if sdi := fwm.SenderDepositoryInstitution; sdi != nil {
+		chain = append(chain, AdviceParty{Role: "Sender", IdentificationCode: FEDRoutingNumber,
+			IdentificationType: IdentificationCode(FEDRoutingNumber).Description(), Identifier: sdi.SenderABANumber,
+			Name: sdi.SenderShortName})
+	}
+	if rdi := fwm.ReceiverDepositoryInstitution; rdi != nil {
+		chain = append(chain, AdviceParty{Role: "Receiver", IdentificationCode: FEDRoutingNumber,
+			IdentificationType: IdentificationCode(FEDRoutingNumber).Description(), Identifier: rdi.ReceiverABANumber,
+			Name: rdi.ReceiverShortName})
+	}
+	if fwm.BeneficiaryIntermediaryFI != nil {
+		chain = append(chain, newAdviceFI("Intermediary FI", fwm.BeneficiaryIntermediaryFI.FinancialInstitution))
+	}
+	if fwm.BeneficiaryFI != nil {
+		chain = append(chain, newAdviceFI("Beneficiary FI", fwm.BeneficiaryFI.FinancialInstitution))
+	}
+	return chain
+}
+
+// adviceRemittance returns the remittance details of {7070} and the {8xxx} tags
+func adviceRemittance(fwm *FEDWireMessage) []AdviceDetail {
+	var details []AdviceDetail
+	add := func(label string, values ...string) {
+		if value := strings.Join(adviceLines(values...), " "); value != "" {
+			details = append(details, AdviceDetail{Label: label, Value: value})
+		}
+	}
+	if rr := fwm.RelatedRemittance; rr != nil {
+		add("Remittance Identification", rr.RemittanceIdentification)
+		add("Remittance Location", rr.RemittanceLocationElectronicAddress)
+	}
+	if ro := fwm.RemittanceOriginator; ro != nil {
+		add("Remittance Originator", ro.RemittanceData.Name)
+	}
+	if rb := fwm.RemittanceBeneficiary; rb != nil {
+		add("Remittance Beneficiary", rb.RemittanceData.Name)
+	}
+	if prd := fwm.PrimaryRemittanceDocument; prd != nil {
+		add("Document", prd.DocumentTypeCode, prd.ProprietaryDocumentTypeCode, prd.DocumentIdentificationNumber)
+	}
+	if drd := fwm.DateRemittanceDocument; drd != nil {
+		add("Document Date", formatAdviceDate(drd.DateRemittanceDocument))
+	}
+	if gard := fwm.GrossAmountRemittanceDocument; gard != nil {
+		add("Amount Due", gard.RemittanceAmount.CurrencyCode, gard.RemittanceAmount.Amount)
+	}
+	if nd := fwm.AmountNegotiatedDiscount; nd != nil {
+		add("Discount", nd.RemittanceAmount.CurrencyCode, nd.RemittanceAmount.Amount)
+	}
+	if aap := fwm.ActualAmountPaid; aap != nil {
+		add("Amount Paid", aap.RemittanceAmount.CurrencyCode, aap.RemittanceAmount.Amount)
+	}
+	if srd := fwm.SecondaryRemittanceDocument; srd != nil {
+		add("Reference", srd.DocumentTypeCode, srd.ProprietaryDocumentTypeCode, srd.DocumentIdentificationNumber)
+	}
+	if rft := fwm.RemittanceFreeText; rft != nil {
+		add("Remittance Information", rft.LineOne, rft.LineTwo, rft.LineThree)
+	}
+	if ri := fwm.Remittance; ri != nil {
+		add("Remittance Information", coverPaymentLines(ri.CoverPayment)...)
+	}
+	if ua := fwm.UnstructuredAddenda; ua != nil {
+		add("Addenda", ua.Addenda)
+	}
+	return details
+}
+
+// adviceLines returns the non-empty values trimmed of spaces
+func adviceLines(values ...string) []string {
+	var lines []string
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			lines = append(lines, value)
+		}
+	}
+	return lines
+}
+
+// formatAdviceAmount formats a 12 digit implied decimal amount as US dollars, e.g. 000001234567 as $12,345.67
+func formatAdviceAmount(s string) string {
+	if strings.TrimSpace(s) == "" {
+		return ""
+	}
+	decimal := isoDecimalFromImplied(s)
+	whole, cents := decimal[:len(decimal)-3], decimal[len(decimal)-3:]
+	var buf strings.Builder
+	for i := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte(whole[i])
+	}
+	return "$" + buf.String() + cents
+}
+
+// formatAdviceDate formats a CCYYMMDD date as CCYY-MM-DD
+func formatAdviceDate(s string) string {
+	if len(s) != 8 {
+		return s
+	}
+	return s[:4] + "-" + s[4:6] + "-" + s[6:]
+}
@@ -0,0 +1,94 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	texttemplate "text/template"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewWireAdvice builds the advice of a customer transfer
+func TestNewWireAdvice(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	advice := NewWireAdvice(&fwm)
+
+	require.Equal(t, "Customer Transfer", advice.BusinessFunction)
+	require.Equal(t, "Funds Transfer", advice.Type)
+	require.Equal(t, "Basic Funds Transfer", advice.SubType)
+	require.NotEmpty(t, advice.IMAD)
+	require.Equal(t, "Originator", advice.Originator.Role)
+	require.Equal(t, fwm.Beneficiary.Personal.Name, advice.Beneficiary.Name)
+	require.NotEmpty(t, advice.FIChain)
+	require.Equal(t, "Instructing FI", advice.FIChain[0].Role)
+	require.Equal(t, "Beneficiary FI", advice.FIChain[len(advice.FIChain)-1].Role)
+
+	var roles []string
+	for _, fi := range advice.FIChain {
+		roles = append(roles, fi.Role)
+	}
+	require.Equal(t, []string{"Instructing FI", "Originator FI", "Sender", "Receiver", "Intermediary FI", "Beneficiary FI"}, roles)
+}
+
+// TestFormatAdviceAmount formats implied decimal amounts as US dollars
+func TestFormatAdviceAmount(t *testing.T) {
+	require.Equal(t, "$0.00", formatAdviceAmount("000000000000"))
+	require.Equal(t, "$0.99", formatAdviceAmount("000000000099"))
+	require.Equal(t, "$123.45", formatAdviceAmount("000000012345"))
+	require.Equal(t, "$12,345.67", formatAdviceAmount("000001234567"))
+	require.Equal(t, "$1,234,567,890.12", formatAdviceAmount("123456789012"))
+	require.Equal(t, "", formatAdviceAmount(""))
+}
+
+// TestFEDWireMessage_AdviceText renders the plain text advice
+func TestFEDWireMessage_AdviceText(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	text, err := fwm.AdviceText()
+	require.NoError(t, err)
+	require.Contains(t, text, "Amount:                "+formatAdviceAmount(fwm.Amount.Amount)+"\n")
+	require.Contains(t, text, "Business Function:     CTR Customer Transfer\n")
+	require.Contains(t, text, "IMAD:                  "+NewWireAdvice(&fwm).IMAD+"\n")
+	require.Contains(t, text, "Beneficiary:\n  "+fwm.Beneficiary.Personal.Name+"\n")
+	require.Contains(t, text, "FINANCIAL INSTITUTIONS")
+}
+
+// TestFEDWireMessage_AdviceRemittance renders the remittance details of structured remittance tags
+func TestFEDWireMessage_AdviceRemittance(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransferPlusStructuredRemittance.txt")
+	advice := NewWireAdvice(&fwm)
+	require.NotEmpty(t, advice.Remittance)
+
+	text, err := fwm.AdviceText()
+	require.NoError(t, err)
+	require.Contains(t, text, "REMITTANCE\n")
+	require.Contains(t, text, "  Document Date: "+formatAdviceDate(fwm.DateRemittanceDocument.DateRemittanceDocument)+"\n")
+}
+
+// TestFEDWireMessage_AdviceHTML escapes the values of the HTML advice
+func TestFEDWireMessage_AdviceHTML(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	fwm.Beneficiary.Personal.Name = "<Smith & Sons>"
+
+	html, err := fwm.AdviceHTML()
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(html, `<div class="wire-advice">`))
+	require.Contains(t, html, "&lt;Smith &amp; Sons&gt;")
+	require.NotContains(t, html, "<Smith")
+}
+
+// TestWriteAdvice_CustomTemplate renders a custom template
+func TestWriteAdvice_CustomTemplate(t *testing.T) {
+	fwm := readFEDWireMessage(t, "fedWireMessage-CustomerTransfer.txt")
+	tmpl := texttemplate.Must(texttemplate.New("notification").Parse(
+		"Your wire of {{.Amount}} to {{.Beneficiary.Name}} was sent ({{.IMAD}})"))
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteAdvice(&buf, &fwm, tmpl))
+	advice := NewWireAdvice(&fwm)
+	require.Equal(t, "Your wire of "+advice.Amount+" to "+advice.Beneficiary.Name+" was sent ("+advice.IMAD+")", buf.String())
+}